@@ -0,0 +1,62 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+const accessPointsEndpoint string = "access_points"
+
+// AccessPointsResults holds the results of an access points request.
+type AccessPointsResults struct {
+	AccessPoints []types.AccessPoint `json:"access_points"`
+	Paging       Paging              `json:"links"`
+	Pagination   Pagination          `json:"pagination"`
+	Logging      `json:"-"`
+	session      *Session
+}
+
+// Count returns the number of access points available in an AccessPointsResults
+func (apr *AccessPointsResults) Count() int {
+	return len(apr.AccessPoints)
+}
+
+// TotalAvailable returns the total number of access points available across all pages.
+func (apr *AccessPointsResults) TotalAvailable() int {
+	return apr.Pagination.total(len(apr.AccessPoints))
+}
+
+func (apr *AccessPointsResults) paging() Paging {
+	return apr.Paging
+}
+
+func (apr *AccessPointsResults) newPage() pagedResults {
+	return &AccessPointsResults{session: apr.session}
+}
+
+func (apr *AccessPointsResults) merge(page pagedResults) {
+	next := page.(*AccessPointsResults)
+	apr.AccessPoints = append(apr.AccessPoints, next.AccessPoints...)
+	apr.Paging = next.Paging
+}
+
+// AccessPoints lists the entrances and exits of the stations of the region.
+func (scope *Scope) AccessPoints(ctx context.Context, req CollectionRequest) (*AccessPointsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + accessPointsEndpoint
+
+	results := &AccessPointsResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// StopPointAccessPoints lists the entrances and exits leading to a stop point, along with the pathway to it.
+func (scope *Scope) StopPointAccessPoints(ctx context.Context, id types.ID, req CollectionRequest) (*AccessPointsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopPointsEndpoint + "/" + string(id) + "/" + accessPointsEndpoint
+
+	results := &AccessPointsResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
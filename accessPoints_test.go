@@ -0,0 +1,57 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_AccessPointsResults_Unmarshal tests unmarshalling for AccessPointsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_AccessPointsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["access_points"], reflect.TypeOf(AccessPointsResults{}))
+}
+
+// Test_Scope_StopPointAccessPoints checks the request path and the decoding of the pathways.
+func Test_Scope_StopPointAccessPoints(t *testing.T) {
+	fixture := testData["access_points"].correct["montparnasse.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/stop_points/stop_point:OIF:SP:59:5046720/access_points"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").StopPointAccessPoints(context.Background(), "stop_point:OIF:SP:59:5046720", CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in StopPointAccessPoints: %v", err)
+	}
+
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 access points, got %d", res.Count())
+	}
+	entrance := res.AccessPoints[0]
+	if !entrance.IsEntrance || entrance.IsExit {
+		t.Errorf("expected %s to be an entrance only", entrance.ID)
+	}
+	if entrance.Mode != types.PathwayModeEscalator || entrance.TraversalTime != 160*time.Second {
+		t.Errorf("unexpected pathway for %s: %+v", entrance.ID, entrance.Pathway)
+	}
+	if entrance.Coord.Longitude != 2.321203 {
+		t.Errorf("unexpected coordinates for %s: %v", entrance.ID, entrance.Coord)
+	}
+	if stairs := res.AccessPoints[1].StairCount; stairs != 24 {
+		t.Errorf("unexpected stair count: %d", stairs)
+	}
+}
@@ -0,0 +1,32 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+// addressResults holds the results of a reverse geocoding request
+type addressResults struct {
+	Address *types.Address `json:"address"`
+	Logging `json:"-"`
+}
+
+// AddressAt retrieves the address closest to the given coordinates, such as for naming the origin of a journey starting from a GPS fix.
+//
+// If there is no address around the coordinates, a *RemoteError with a 404 status code is returned.
+func (scope *Scope) AddressAt(ctx context.Context, coords types.Coordinates) (*types.Address, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/coords/" + coords.String()
+
+	results := &addressResults{}
+	err := scope.session.requestURL(ctx, reqURL, results)
+	if err != nil {
+		return nil, err
+	}
+
+	if results.Address == nil {
+		return nil, notFound("address at", coords.ID())
+	}
+	return results.Address, nil
+}
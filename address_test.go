@@ -0,0 +1,47 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_AddressAt checks the request path, and that a response without an address is reported as not found.
+func Test_Scope_AddressAt(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/coords/2.3774;48.8472":
+			_, _ = w.Write([]byte(`{
+				"regions": ["fr-idf"],
+				"address": {
+					"id": "2.37715;48.846781",
+					"name": "Rue de Bercy",
+					"label": "20 Rue de Bercy (Paris)",
+					"house_number": 20,
+					"coord": {"lon": "2.37715", "lat": "48.846781"}
+				}
+			}`))
+		case "/coverage/fr-idf/coords/2;49":
+			_, _ = w.Write([]byte(`{"regions": ["fr-idf"]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	scope := session.Scope("fr-idf")
+
+	addr, err := scope.AddressAt(context.Background(), types.Coordinates{Longitude: 2.3774, Latitude: 48.8472})
+	if err != nil {
+		t.Fatalf("error in AddressAt: %v", err)
+	}
+	if addr.FullStreet() != "20 Rue de Bercy" {
+		t.Errorf("unexpected address: %q", addr.FullStreet())
+	}
+
+	_, err = scope.AddressAt(context.Background(), types.Coordinates{Longitude: 2, Latitude: 49})
+	if remoteErr, ok := err.(*RemoteError); !ok || remoteErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found *RemoteError, got %T: %v", err, err)
+	}
+}
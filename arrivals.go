@@ -0,0 +1,61 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+)
+
+// ArrivalsResults holds the results of an arrivals request.
+type ArrivalsResults struct {
+	Arrivals   []types.Arrival `json:"arrivals"`
+	Paging     Paging          `json:"links"`
+	Pagination Pagination      `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of results available in an ArrivalsResults
+func (ar *ArrivalsResults) Count() int {
+	return len(ar.Arrivals)
+}
+
+// TotalAvailable returns the total number of arrivals available across all pages.
+func (ar *ArrivalsResults) TotalAvailable() int {
+	return ar.Pagination.total(len(ar.Arrivals))
+}
+
+// ArrivalsRequest contain the parameters needed to make an arrivals request.
+// It has the same parameters as a DeparturesRequest.
+type ArrivalsRequest DeparturesRequest
+
+// path returns the path of the object whose arrivals are requested, followed by a slash, if any
+func (req ArrivalsRequest) path() string {
+	return DeparturesRequest(req).path()
+}
+
+func (req ArrivalsRequest) toURL() (url.Values, error) {
+	return DeparturesRequest(req).toURL()
+}
+
+// arrivals is the internal function used by Arrivals functions
+func (s *Session) arrivals(ctx context.Context, url string, req ArrivalsRequest) (*ArrivalsResults, error) {
+	var results *ArrivalsResults
+	err := s.withRealtimeFallback(req.Freshness, func(freshness types.DataFreshness) (bool, error) {
+		req.Freshness = freshness
+		results = &ArrivalsResults{session: s}
+		err := s.request(ctx, url, req, results)
+		return len(results.Arrivals) == 0, err
+	})
+	return results, err
+}
+
+// Arrivals computes a list of Arrivals according to the parameters given in a specific scope,
+// such as the upcoming arrivals at a terminus stop area or stop point.
+func (scope *Scope) Arrivals(ctx context.Context, req ArrivalsRequest) (*ArrivalsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + arrivalsEndpoint
+
+	return scope.session.arrivals(ctx, reqURL, req)
+}
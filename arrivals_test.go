@@ -0,0 +1,89 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_ArrivalsResults_Unmarshal tests unmarshalling for ArrivalsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_ArrivalsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["arrivals"], reflect.TypeOf(ArrivalsResults{}))
+}
+
+// Test_Scope_Arrivals checks that the arrivals at a stop area are requested with the same parameters as departures
+func Test_Scope_Arrivals(t *testing.T) {
+	fixture := testData["arrivals"].correct["shannon.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const stopArea = "stop_area:OEA:SA:CTP8400B6350301"
+	from := time.Date(2017, 4, 27, 17, 0, 0, 0, time.UTC)
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/ie/stop_areas/" + stopArea + "/arrivals"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if count := r.URL.Query().Get("count"); count != "10" {
+			t.Errorf("unexpected count: %q", count)
+		}
+		if dt := r.URL.Query().Get("from_datetime"); dt != "20170427T170000" {
+			t.Errorf("unexpected from_datetime: %q", dt)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := ArrivalsRequest{StopArea: stopArea, From: from, Count: 10}
+	res, err := session.Scope("ie").Arrivals(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in Arrivals: %v", err)
+	}
+	if res.Count() != 10 {
+		t.Fatalf("expected 10 arrivals, got %d", res.Count())
+	}
+	if arrival := res.Arrivals[0].StopDateTime.Arrival; arrival.Before(from) {
+		t.Errorf("expected an arrival after %s, got %s", from, arrival)
+	}
+}
+
+// Test_Scope_Arrivals_PreferRealtime checks that a session preferring realtime falls back to the base schedule arrivals
+// in a region without realtime
+func Test_Scope_Arrivals_PreferRealtime(t *testing.T) {
+	fixture := testData["arrivals"].correct["shannon.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	var freshnesses []string
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		freshness := r.URL.Query().Get("data_freshness")
+		freshnesses = append(freshnesses, freshness)
+		if freshness == string(types.DataFreshnessRealTime) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_api", "message": "no realtime data available"}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	}), WithPreferRealtime())
+
+	res, err := session.Scope("ie").Arrivals(context.Background(), ArrivalsRequest{StopArea: "stop_area:OEA:SA:CTP8400B6350301"})
+	if err != nil {
+		t.Fatalf("error in Arrivals: %v", err)
+	}
+	if res.Count() != 10 {
+		t.Errorf("expected 10 base schedule arrivals, got %d", res.Count())
+	}
+
+	expected := []string{string(types.DataFreshnessRealTime), string(types.DataFreshnessBaseSchedule)}
+	if !reflect.DeepEqual(freshnesses, expected) {
+		t.Errorf("unexpected requested freshnesses: got %v, expected %v", freshnesses, expected)
+	}
+}
@@ -0,0 +1,265 @@
+package navitia
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A Cache stores responses, keyed by their canonicalised request URL, see WithCache.
+// Implementations must be safe for concurrent use, and may be backed by an external store such as Redis.
+type Cache interface {
+	// Get returns the value stored for key, if it is there and hasn't expired.
+	Get(key string) ([]byte, bool)
+
+	// Set stores the value for key, for the duration of ttl.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// MemoryCache is an in-memory Cache, expired entries being evicted when looked up or by Purge.
+type MemoryCache struct {
+	mu      sync.Mutex
+	entries map[string]memoryCacheEntry
+}
+
+type memoryCacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a new empty MemoryCache.
+func NewMemoryCache() *MemoryCache {
+	return &MemoryCache{entries: make(map[string]memoryCacheEntry)}
+}
+
+// Get returns the value stored for key, if it is there and hasn't expired.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+// Set stores the value for key, for the duration of ttl.
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = memoryCacheEntry{value: value, expires: time.Now().Add(ttl)}
+}
+
+// Purge evicts the expired entries.
+func (c *MemoryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for key, entry := range c.entries {
+		if !now.Before(entry.expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache, including the expired ones not yet evicted.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// defaultCacheTTLs are the durations responses are cached for by DefaultCacheTTL, by endpoint
+var defaultCacheTTLs = map[string]time.Duration{
+	// The coverage barely changes
+	regionEndpoint: 6 * time.Hour,
+
+	// The network's objects change with the data updates, at most a few times a day
+	linesEndpoint:           time.Hour,
+	routesEndpoint:          time.Hour,
+	stopAreasEndpoint:       time.Hour,
+	stopPointsEndpoint:      time.Hour,
+	networksEndpoint:        time.Hour,
+	companiesEndpoint:       time.Hour,
+	commercialModesEndpoint: time.Hour,
+	physicalModesEndpoint:   time.Hour,
+	poisEndpoint:            time.Hour,
+	poiTypesEndpoint:        time.Hour,
+	accessPointsEndpoint:    time.Hour,
+	calendarsEndpoint:       time.Hour,
+	contributorsEndpoint:    time.Hour,
+	datasetsEndpoint:        time.Hour,
+	placesEndpoint:          time.Hour,
+	placesNearbyEndpoint:    time.Hour,
+	ptObjectsEndpoint:       time.Hour,
+
+	// Anything depending on realtime data is only worth caching briefly
+	departuresEndpoint:          30 * time.Second,
+	arrivalsEndpoint:            30 * time.Second,
+	stopSchedulesEndpoint:       30 * time.Second,
+	routeSchedulesEndpoint:      30 * time.Second,
+	journeysEndpoint:            30 * time.Second,
+	isochronesEndpoint:          30 * time.Second,
+	vehicleJourneysEndpoint:     30 * time.Second,
+	disruptionsEndpoint:         30 * time.Second,
+	trafficReportsEndpoint:      30 * time.Second,
+	equipmentReportsEndpoint:    30 * time.Second,
+	freeFloatingsNearbyEndpoint: 30 * time.Second,
+}
+
+// DefaultCacheTTL returns how long responses of an endpoint (such as "departures" or "stop_areas") are cached by default:
+// hours for the coverage, an hour for the network's objects & seconds for anything depending on realtime data.
+// Unknown endpoints aren't cached.
+func DefaultCacheTTL(endpoint string) time.Duration {
+	return defaultCacheTTLs[endpoint]
+}
+
+// cacheKey canonicalises a request URL, so that requests differing only by the order of their parameters share their entry,
+// as JourneyRequest.CacheKey does for journeys.
+// It also returns the endpoint requested, which is the last known endpoint of the path,
+// as in ".../lines/line:A/departures" or ".../stop_areas/stop_area:X".
+func cacheKey(rawURL string) (key string, endpoint string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL, ""
+	}
+	query := u.Query()
+	for _, vals := range query {
+		sort.Strings(vals)
+	}
+	u.RawQuery = query.Encode()
+	u.Fragment = ""
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if _, ok := defaultCacheTTLs[segments[i]]; ok {
+			endpoint = segments[i]
+			break
+		}
+	}
+	return u.String(), endpoint
+}
+
+// A cachedResponse is a response as stored in a Cache.
+//
+// Once stale, a response with validators is kept for as long again, so that it may be revalidated with a conditional request
+// rather than fetched anew.
+type cachedResponse struct {
+	Expires      time.Time       `json:"expires"`
+	ETag         string          `json:"etag,omitempty"`
+	LastModified string          `json:"last_modified,omitempty"`
+	Body         json.RawMessage `json:"body,omitempty"`
+}
+
+// newCachedResponse creates the cached response of a body received with the given headers, fresh for ttl
+func newCachedResponse(body []byte, h http.Header, ttl time.Duration) cachedResponse {
+	return cachedResponse{
+		Expires:      time.Now().Add(ttl),
+		ETag:         h.Get("ETag"),
+		LastModified: h.Get("Last-Modified"),
+		Body:         body,
+	}
+}
+
+// fresh reports whether the response may be used without revalidating it
+func (cr *cachedResponse) fresh() bool {
+	return time.Now().Before(cr.Expires)
+}
+
+// revalidable reports whether the response has validators
+func (cr *cachedResponse) revalidable() bool {
+	return cr.ETag != "" || cr.LastModified != ""
+}
+
+// conditions returns the headers making a request conditional on the response having changed
+func (cr *cachedResponse) conditions() http.Header {
+	h := make(http.Header)
+	if cr.ETag != "" {
+		h.Set("If-None-Match", cr.ETag)
+	}
+	if cr.LastModified != "" {
+		h.Set("If-Modified-Since", cr.LastModified)
+	}
+	return h
+}
+
+// revalidate makes the response fresh for ttl again, following a 304 Not Modified response with the given headers
+func (cr *cachedResponse) revalidate(h http.Header, ttl time.Duration) {
+	cr.Expires = time.Now().Add(ttl)
+	if etag := h.Get("ETag"); etag != "" {
+		cr.ETag = etag
+	}
+	if lastModified := h.Get("Last-Modified"); lastModified != "" {
+		cr.LastModified = lastModified
+	}
+}
+
+// cached looks up the response to a request URL in the session's cache.
+// It returns the key & time to live of the response, ttl being zero if it isn't to be cached,
+// and the response, nil if not found. The latter may be stale.
+func (s *Session) cached(url string) (key string, ttl time.Duration, cr *cachedResponse) {
+	if s.cache == nil {
+		return "", 0, nil
+	}
+	key, endpoint := cacheKey(url)
+	ttl = s.cacheTTL(endpoint)
+	if ttl <= 0 {
+		return key, 0, nil
+	}
+
+	value, ok := s.cache.Get(key)
+	if !ok {
+		return key, ttl, nil
+	}
+	cr = &cachedResponse{}
+	if err := json.Unmarshal(value, cr); err != nil || len(cr.Body) == 0 {
+		// Unreadable entries are treated as missing, they will be overwritten
+		return key, ttl, nil
+	}
+	return key, ttl, cr
+}
+
+// store stores a response in the session's cache
+func (s *Session) store(key string, ttl time.Duration, cr cachedResponse) {
+	// The body is appended as is, as json.Marshal would reformat it
+	body := cr.Body
+	cr.Body = nil
+	value, err := json.Marshal(cr)
+	if err != nil {
+		return
+	}
+	value = append(value[:len(value)-1], `,"body":`...)
+	value = append(value, body...)
+	value = append(value, '}')
+
+	if cr.revalidable() {
+		ttl *= 2
+	}
+	s.cache.Set(key, value, ttl)
+}
+
+// decodeCached decodes a cached response body in res
+func (s *Session) decodeCached(body []byte, res results) error {
+	if err := json.Unmarshal(body, res); err != nil {
+		return errors.Wrap(err, "JSON decoding of cached response failed")
+	}
+	if s.keepRaw {
+		res.keepRaw(body)
+	}
+	res.parsing()
+	return nil
+}
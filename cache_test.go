@@ -0,0 +1,188 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Test_cacheKey checks that request URLs are canonicalised & their endpoint found
+func Test_cacheKey(t *testing.T) {
+	tests := []struct {
+		url      string
+		key      string
+		endpoint string
+	}{
+		{
+			url:      "https://api.navitia.io/v1/coverage?count=10&depth=2",
+			key:      "https://api.navitia.io/v1/coverage?count=10&depth=2",
+			endpoint: regionEndpoint,
+		},
+		{
+			url:      "https://api.navitia.io/v1/coverage/fr-idf/lines/line:A/departures?to=b&forbidden_uris%5B%5D=y&from=a&forbidden_uris%5B%5D=x#top",
+			key:      "https://api.navitia.io/v1/coverage/fr-idf/lines/line:A/departures?forbidden_uris%5B%5D=x&forbidden_uris%5B%5D=y&from=a&to=b",
+			endpoint: departuresEndpoint,
+		},
+		{
+			url:      "https://api.navitia.io/v1/coverage/fr-idf/stop_areas/stop_area:X",
+			key:      "https://api.navitia.io/v1/coverage/fr-idf/stop_areas/stop_area:X",
+			endpoint: stopAreasEndpoint,
+		},
+		{
+			url:      "https://example.com/unknown",
+			key:      "https://example.com/unknown",
+			endpoint: "",
+		},
+	}
+
+	for _, test := range tests {
+		key, endpoint := cacheKey(test.url)
+		if key != test.key {
+			t.Errorf("cacheKey(%q): unexpected key %q, expected %q", test.url, key, test.key)
+		}
+		if endpoint != test.endpoint {
+			t.Errorf("cacheKey(%q): unexpected endpoint %q, expected %q", test.url, endpoint, test.endpoint)
+		}
+	}
+}
+
+// Test_MemoryCache checks that entries expire
+func Test_MemoryCache(t *testing.T) {
+	cache := NewMemoryCache()
+	cache.Set("short", []byte("a"), 10*time.Millisecond)
+	cache.Set("long", []byte("b"), time.Hour)
+
+	if body, ok := cache.Get("short"); !ok || string(body) != "a" {
+		t.Errorf("expected the entry to be there, got %q, %t", body, ok)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := cache.Get("short"); ok {
+		t.Errorf("expected the entry to have expired")
+	}
+
+	cache.Set("short", []byte("a"), 10*time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	cache.Purge()
+	if cache.Len() != 1 {
+		t.Errorf("expected only the unexpired entry to be left, got %d entries", cache.Len())
+	}
+}
+
+// Test_WithCache checks that identical requests are answered from the cache, unless their endpoint isn't cached
+func Test_WithCache(t *testing.T) {
+	requests := make(map[string]int)
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.URL.Path]++
+		switch r.URL.Path {
+		case "/coverage":
+			_, _ = w.Write([]byte(`{"regions": [{"id": "fr-idf"}]}`))
+		case "/stop_areas/stop_area:X/departures":
+			_, _ = w.Write([]byte(`{"departures": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "unknown"}`))
+		}
+	}), WithCache(NewMemoryCache(), func(endpoint string) time.Duration {
+		if endpoint == departuresEndpoint {
+			return 0
+		}
+		return DefaultCacheTTL(endpoint)
+	}))
+
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		res, err := session.Regions(ctx, RegionRequest{})
+		if err != nil {
+			t.Fatalf("error in Regions: %v", err)
+		}
+		if len(res.Regions) != 1 || res.Regions[0].ID != "fr-idf" {
+			t.Fatalf("unexpected regions: %+v", res.Regions)
+		}
+	}
+	if requests["/coverage"] != 1 {
+		t.Errorf("expected a single request for the coverage, got %d", requests["/coverage"])
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := session.Departures(ctx, DeparturesRequest{StopArea: "stop_area:X"}); err != nil {
+			t.Fatalf("error in Departures: %v", err)
+		}
+	}
+	if n := requests["/stop_areas/stop_area:X/departures"]; n != 2 {
+		t.Errorf("expected uncached departures to be requested twice, got %d", n)
+	}
+}
+
+// Test_WithCache_Revalidation checks that stale responses with validators are revalidated with conditional requests
+func Test_WithCache_Revalidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator string // Response header holding the validator
+		condition string // Request header expected to hold it
+	}{
+		{name: "etag", validator: "ETag", condition: "If-None-Match"},
+		{name: "last modified", validator: "Last-Modified", condition: "If-Modified-Since"},
+	}
+
+	const validator = `"v1"`
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var requests, revalidations int
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set(test.validator, validator)
+				if r.Header.Get(test.condition) == validator {
+					revalidations++
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				_, _ = w.Write([]byte(`{"regions": [{"id": "fr-idf"}]}`))
+			}), WithCache(NewMemoryCache(), func(string) time.Duration { return 20 * time.Millisecond }))
+
+			ctx := context.Background()
+			get := func() {
+				t.Helper()
+				res, err := session.Regions(ctx, RegionRequest{})
+				if err != nil {
+					t.Fatalf("error in Regions: %v", err)
+				}
+				if len(res.Regions) != 1 || res.Regions[0].ID != "fr-idf" {
+					t.Fatalf("unexpected regions: %+v", res.Regions)
+				}
+			}
+
+			get()
+			time.Sleep(30 * time.Millisecond)
+			get() // Stale, revalidated
+			get() // Fresh again
+			if requests != 2 || revalidations != 1 {
+				t.Errorf("expected 2 requests of which 1 revalidation, got %d & %d", requests, revalidations)
+			}
+		})
+	}
+}
+
+// Test_WithCache_NoValidators checks that stale responses without validators are fetched anew
+func Test_WithCache_NoValidators(t *testing.T) {
+	var requests int
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") != "" || r.Header.Get("If-Modified-Since") != "" {
+			t.Errorf("unexpected conditional request")
+		}
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithCache(NewMemoryCache(), func(string) time.Duration { return 20 * time.Millisecond }))
+
+	for i := 0; i < 2; i++ {
+		if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+			t.Fatalf("error in Regions: %v", err)
+		}
+		time.Sleep(30 * time.Millisecond)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
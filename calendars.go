@@ -0,0 +1,82 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const calendarsEndpoint string = "calendars"
+
+// CalendarsResults holds the results of a calendars request.
+type CalendarsResults struct {
+	Calendars  []types.Calendar `json:"calendars"`
+	Paging     Paging           `json:"links"`
+	Pagination Pagination       `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of calendars available in a CalendarsResults
+func (cr *CalendarsResults) Count() int {
+	return len(cr.Calendars)
+}
+
+// TotalAvailable returns the total number of calendars available across all pages.
+func (cr *CalendarsResults) TotalAvailable() int {
+	return cr.Pagination.total(len(cr.Calendars))
+}
+
+// CalendarsRequest contains the optional parameters for a Calendars request.
+type CalendarsRequest struct {
+	// Line restricts the calendars to those used by a line
+	Line types.ID
+
+	// StartDate and EndDate restrict the calendars to those active in that period, only their date is used
+	StartDate time.Time `param:"start_date,date"`
+	EndDate   time.Time `param:"end_date,date"`
+
+	// Maximum amount of calendars
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+}
+
+// path returns the path of the line whose calendars are requested, followed by a slash, if any
+func (req CalendarsRequest) path() string {
+	if req.Line == "" {
+		return ""
+	}
+	return linesEndpoint + "/" + string(req.Line) + "/"
+}
+
+func (req CalendarsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// calendars is the internal function used by Calendars functions
+func (s *Session) calendars(ctx context.Context, url string, req CalendarsRequest) (*CalendarsResults, error) {
+	results := &CalendarsResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// Calendars lists the service calendars of the region, or of a single line if req.Line is set.
+// Use types.Calendar.ActiveOn to know whether a calendar is active on a given day.
+func (scope *Scope) Calendars(ctx context.Context, req CalendarsRequest) (*CalendarsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + calendarsEndpoint
+
+	return scope.session.calendars(ctx, reqURL, req)
+}
@@ -0,0 +1,63 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// Test_CalendarsResults_Unmarshal tests unmarshalling for CalendarsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_CalendarsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["calendars"], reflect.TypeOf(CalendarsResults{}))
+}
+
+// Test_Scope_Calendars checks the line-scoped path and the date parameters.
+func Test_Scope_Calendars(t *testing.T) {
+	fixture := testData["calendars"].correct["weekdays.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/lines/line:OIF:100110014:14OIF439/calendars"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query.Get("start_date"); got != "20170501" {
+			t.Errorf("unexpected start_date: got %q, expected %q", got, "20170501")
+		}
+		if got := query.Get("end_date"); got != "" {
+			t.Errorf("expected no end_date, got %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := CalendarsRequest{
+		Line:      "line:OIF:100110014:14OIF439",
+		StartDate: time.Date(2017, time.May, 1, 0, 0, 0, 0, time.UTC),
+	}
+	res, err := session.Scope("fr-idf").Calendars(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in Calendars: %v", err)
+	}
+
+	if res.Count() != 2 || res.TotalAvailable() != 2 {
+		t.Fatalf("expected 2 calendars, got %d (%d available)", res.Count(), res.TotalAvailable())
+	}
+	cal := res.Calendars[0]
+	if cal.ID != "Semaine" {
+		t.Errorf("unexpected calendar: %q", cal.ID)
+	}
+	if !cal.ActiveOn(time.Date(2017, time.May, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected calendar %s to be active on a regular tuesday", cal.ID)
+	}
+	if cal.ActiveOn(time.Date(2017, time.May, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected calendar %s not to be active on May 1st", cal.ID)
+	}
+}
@@ -0,0 +1,120 @@
+package navitia
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+)
+
+// idCollections maps the types of IDs to the collection their objects are looked up in, see Scope.CheckID.
+// Administrative regions & addresses have no collection of their own, but are resolved as places.
+var idCollections = map[string]string{
+	types.IDTypeNetwork:        networksEndpoint,
+	types.IDTypeLine:           linesEndpoint,
+	types.IDTypeRoute:          routesEndpoint,
+	types.IDTypeStopArea:       stopAreasEndpoint,
+	types.IDTypeStopPoint:      stopPointsEndpoint,
+	types.IDTypeCommercialMode: commercialModesEndpoint,
+	types.IDTypePhysicalMode:   physicalModesEndpoint,
+	types.IDTypeCompany:        companiesEndpoint,
+	types.IDTypeAdmin:          placesEndpoint,
+	types.IDTypeAddress:        placesEndpoint,
+	types.IDTypePOI:            poisEndpoint,
+	types.IDTypePOIType:        poiTypesEndpoint,
+	types.IDTypeVehicleJourney: vehicleJourneysEndpoint,
+	types.IDTypeDisruption:     disruptionsEndpoint,
+	types.IDTypeCoord:          "coords",
+}
+
+// An IDCheck reports whether an ID refers to an object of a region, see Scope.CheckID.
+type IDCheck struct {
+	// Type of the object the ID refers to, see types.ParseID
+	Type string
+
+	// Exists is false if there's no such object in the region
+	Exists bool
+
+	// ID & Name of the object the ID resolves to.
+	// The ID may differ from the checked one, such as for coordinates which resolve to the closest address.
+	ID   types.ID
+	Name string
+}
+
+// idCheckResults holds the response of a request for a single object, keyed by collection
+type idCheckResults struct {
+	objects map[string]json.RawMessage
+	Logging `json:"-"`
+}
+
+// idCheckObject is the part of an object needed to tell what an ID resolves to
+type idCheckObject struct {
+	ID   types.ID `json:"id"`
+	Name string   `json:"name"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for idCheckResults, keeping the collections as is
+func (r *idCheckResults) UnmarshalJSON(b []byte) error {
+	return json.Unmarshal(b, &r.objects)
+}
+
+// CheckID resolves an ID against the region, such as one given by a user or stored for later use, before building a request with it.
+// The object is looked up in the collection matching the type of the ID, see types.ParseID.
+//
+// An ID not known by the region isn't an error, it is reported by IDCheck.Exists.
+// An error is returned if the type of the ID can't be found, or if it has no collection to be looked up in, such as trips.
+// It is context aware.
+func (scope *Scope) CheckID(ctx context.Context, id types.ID) (*IDCheck, error) {
+	typ, components, err := types.ParseID(id)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't check ID")
+	}
+	collection, ok := idCollections[typ]
+	if !ok {
+		return nil, errors.Errorf("can't check ID %q, as there's no collection of objects of type %q", id, typ)
+	}
+
+	// Coordinates are resolved to the closest address
+	path, key := collection+"/"+string(id), collection
+	if typ == types.IDTypeCoord {
+		path, key = collection+"/"+strings.Join(components, ";"), "address"
+	}
+
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + path
+
+	check := &IDCheck{Type: typ}
+	results := &idCheckResults{}
+	err = scope.session.requestURL(ctx, reqURL, results)
+	if remoteNotFound(err) {
+		return check, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	raw := results.objects[key]
+	if len(raw) == 0 {
+		return check, nil
+	}
+
+	var objects []idCheckObject
+	if key == collection {
+		err = json.Unmarshal(raw, &objects)
+	} else {
+		objects = make([]idCheckObject, 1)
+		err = json.Unmarshal(raw, &objects[0])
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while decoding the %s resolved from %q", key, id)
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if len(objects) == 0 {
+		return check, nil
+	}
+	check.Exists, check.ID, check.Name = true, objects[0].ID, objects[0].Name
+	return check, nil
+}
@@ -0,0 +1,54 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_CheckID checks that IDs are looked up in the collection of their type, coordinates resolving to an address,
+// and that unknown ones are reported as such rather than as errors.
+func Test_Scope_CheckID(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/stop_areas/stop_area:RAT:SA:NATIO":
+			_, _ = w.Write([]byte(`{"stop_areas": [{"id": "stop_area:RAT:SA:NATIO", "name": "Nation"}]}`))
+		case "/coverage/fr-idf/lines/line:RAT:M15":
+			_, _ = w.Write([]byte(`{"lines": []}`))
+		case "/coverage/fr-idf/coords/2.37715;48.846781":
+			_, _ = w.Write([]byte(`{"address": {"id": "2.37715;48.846781", "name": "Rue de Bercy"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}}`))
+		}
+	}))
+	scope := session.Scope("fr-idf")
+
+	tests := []struct {
+		id       types.ID
+		expected IDCheck
+	}{
+		{"stop_area:RAT:SA:NATIO", IDCheck{Type: types.IDTypeStopArea, Exists: true, ID: "stop_area:RAT:SA:NATIO", Name: "Nation"}},
+		{"stop_area:RAT:SA:UNKNOWN", IDCheck{Type: types.IDTypeStopArea}},
+		{"line:RAT:M15", IDCheck{Type: types.IDTypeLine}},
+		{"coord:2.37715:48.846781", IDCheck{Type: types.IDTypeCoord, Exists: true, ID: "2.37715;48.846781", Name: "Rue de Bercy"}},
+	}
+	for _, test := range tests {
+		check, err := scope.CheckID(context.Background(), test.id)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.id, err)
+			continue
+		}
+		if *check != test.expected {
+			t.Errorf("%s: got %+v, expected %+v", test.id, *check, test.expected)
+		}
+	}
+
+	for _, id := range []types.ID{"RATRM1REGA4213", "trip:RATRM1REGA4213"} {
+		if _, err := scope.CheckID(context.Background(), id); err == nil {
+			t.Errorf("%s: expected an error", id)
+		}
+	}
+}
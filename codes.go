@@ -0,0 +1,72 @@
+package navitia
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const (
+	stopAreasEndpoint  string = "stop_areas"
+	stopPointsEndpoint string = "stop_points"
+)
+
+// codeCollections lists the collections searched when resolving an external code, along with the
+// navitia-side name of the object they hold.
+var codeCollections = [...]struct {
+	endpoint string
+	object   string
+}{
+	{stopAreasEndpoint, types.EmbeddedStopArea},
+	{stopPointsEndpoint, types.EmbeddedStopPoint},
+}
+
+// codeResults holds the results of an external code lookup on a collection.
+type codeResults struct {
+	StopAreas  []types.StopArea  `json:"stop_areas"`
+	StopPoints []types.StopPoint `json:"stop_points"`
+
+	Logging `json:"-"`
+}
+
+// places returns the places held by a codeResults.
+func (cr *codeResults) places() []types.Place {
+	places := make([]types.Place, 0, len(cr.StopAreas)+len(cr.StopPoints))
+	for i := range cr.StopAreas {
+		places = append(places, &cr.StopAreas[i])
+	}
+	for i := range cr.StopPoints {
+		places = append(places, &cr.StopPoints[i])
+	}
+	return places
+}
+
+// codeRequest is the query used to look up objects by their external code.
+type codeRequest struct {
+	// Object is the navitia-side name of the object searched, eg "stop_area"
+	Object string
+
+	// System is the type of the code, eg "source" or "gtfs_stop_code"
+	System string
+
+	// Value is the code itself
+	Value string
+}
+
+// filter returns the ptref filter selecting objects having the requested code.
+func (req codeRequest) filter() string {
+	return fmt.Sprintf("%s.has_code(%s, %s)", req.Object, strconv.Quote(req.System), strconv.Quote(req.Value))
+}
+
+// toURL formats a code request to url
+func (req codeRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+
+	rb.AddString("filter", req.filter())
+	rb.AddString("disable_geojson", "true")
+
+	return rb.Values(), nil
+}
@@ -0,0 +1,68 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_codeResults_Unmarshal tests unmarshalling for codeResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_codeResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["codes"], reflect.TypeOf(codeResults{}))
+}
+
+func Test_codeRequest_toURL(t *testing.T) {
+	t.Parallel()
+
+	req := codeRequest{Object: types.EmbeddedStopArea, System: "gtfs_stop_code", Value: "1757"}
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in codeRequest.toURL: %v", err)
+	}
+
+	const expected = `stop_area.has_code("gtfs_stop_code", "1757")`
+	if got := values.Get("filter"); got != expected {
+		t.Errorf("unexpected filter: got %q, expected %q", got, expected)
+	}
+}
+
+// Test_ResolveCode checks that a GTFS code borne by a single stop area resolves to that stop area only.
+func Test_ResolveCode(t *testing.T) {
+	fixture := testData["codes"].correct["gtfs_stop_area.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/"+stopAreasEndpoint) {
+			_, _ = w.Write(fixture)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}`))
+	}))
+
+	places, err := session.ResolveCode(context.Background(), "sandbox", "gtfs_stop_code", "1757")
+	if err != nil {
+		t.Fatalf("error in ResolveCode: %v", err)
+	}
+	if len(places) != 1 {
+		t.Fatalf("expected exactly one place, got %d: %#v", len(places), places)
+	}
+
+	sa, ok := places[0].(*types.StopArea)
+	if !ok {
+		t.Fatalf("expected a *types.StopArea, got %T", places[0])
+	}
+	if sa.ID != "stop_area:RAT:SA:RDBAC" {
+		t.Errorf("unexpected stop area resolved: %s", sa.ID)
+	}
+}
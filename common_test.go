@@ -2,6 +2,8 @@ package navitia
 
 import (
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
 )
@@ -64,3 +66,23 @@ func testUnmarshal(t *testing.T, data typeTestData, resultsType reflect.Type) {
 	t.Run("correct", sub(data.correct, true))
 	t.Run("incorrect", sub(data.incorrect, false))
 }
+
+// newMockSession creates a Session with the given options, whose requests are all answered by handler through a local test server.
+// The server is closed once the test completes.
+func newMockSession(t *testing.T, handler http.Handler, opts ...Option) *Session {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	s, err := NewCustom("", srv.URL, srv.Client(), opts...)
+	if err != nil {
+		t.Fatalf("error while creating mock session: %v", err)
+	}
+	return s
+}
+
+// uintPtr returns a pointer to n, for the parameters whose zero value is meaningful
+func uintPtr(n uint) *uint {
+	return &n
+}
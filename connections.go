@@ -22,23 +22,31 @@ type Connection struct {
 // ConnectionsResults holds the results of a departures or arrivals request.
 type ConnectionsResults struct {
 	Connections []Connection
-	Paging      Paging `json:"links"`
+	Paging      Paging     `json:"links"`
+	Pagination  Pagination `json:"pagination"`
 	Logging     `json:"-"`
 }
 
+// TotalAvailable returns the total number of connections available across all pages.
+func (cr *ConnectionsResults) TotalAvailable() int {
+	return cr.Pagination.total(len(cr.Connections))
+}
+
 // UnmarshalJSON implements unmarshalling for ConnectionsResults.
 func (cr *ConnectionsResults) UnmarshalJSON(b []byte) error {
 	// First let's create the analogous structure
 	// We define some of the value as pointers to the real values, allowing us to bypass copying in cases where we don't need to process the data
 	data := &struct {
 		// Pointers to the corresponding real values
-		Paging *Paging `json:"links"`
+		Paging     *Paging     `json:"links"`
+		Pagination *Pagination `json:"pagination"`
 
 		// Value to process
 		Departures *[]Connection `json:"departures"`
 		Arrivals   *[]Connection `json:"arrivals"`
 	}{
-		Paging: &cr.Paging,
+		Paging:     &cr.Paging,
+		Pagination: &cr.Pagination,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -62,45 +70,36 @@ func (cr *ConnectionsResults) UnmarshalJSON(b []byte) error {
 // ConnectionsRequest contains the optional parameters for a Departures request.
 type ConnectionsRequest struct {
 	// From what time on do you want to see the results ?
-	From time.Time
+	From time.Time `param:"datetime"`
 
 	// Maximum duration between From and the retrieved results (default 24h)
-	Duration time.Duration
+	Duration time.Duration `param:"duration,seconds"`
 
 	// The maximum amount of results (default 10)
-	Count uint
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
 
 	// ForbiddenURIs
-	Forbidden []types.ID
+	Forbidden []types.ID `param:"forbidden_uris[]"`
 
 	// Freshness of the data
-	Freshness types.DataFreshness
+	Freshness types.DataFreshness `param:"data_freshness"`
 
 	// Enables GeoJSON data in the reply. GeoJSON objects can be VERY large ! >1MB.
-	Geo bool
+	Geo bool `param:"disable_geojson,negate"`
 }
 
 func (req ConnectionsRequest) toURL() (url.Values, error) {
-	rb := utils.NewRequestBuilder()
-
-	rb.AddDateTime("datetime", req.From)
-
-	// If count is defined don't bother with the minimimal and maximum amount of items to return
-	if req.Count != 0 {
-		rb.AddUInt("count", req.Count)
+	if err := checkEnums("", req.Freshness); err != nil {
+		return nil, err
 	}
 
-	// Deal with the forbidden URIs
-	rb.AddIDSlice("forbidden_uris[]", req.Forbidden)
-
-	// Set the freshness
-	rb.AddString("data_freshness", string(req.Freshness))
-
-	// Add GEO
-	if !req.Geo {
-		rb.AddString("disable_geojson", "true")
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
 	}
-
 	return rb.Values(), nil
 }
 
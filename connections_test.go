@@ -2,13 +2,71 @@ package navitia
 
 import (
 	"context"
+	"net/http"
+	"net/url"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/govitia/navitia/types"
 )
 
+func Test_ConnectionsRequest_toURL(t *testing.T) {
+	t.Parallel()
+
+	req := ConnectionsRequest{
+		From:      time.Date(2018, 5, 1, 8, 30, 0, 0, time.UTC),
+		Duration:  90 * time.Minute,
+		Forbidden: []types.ID{"line:RAT:M1", "line:RAT:M4"},
+		Geo:       true,
+	}
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in ConnectionsRequest.toURL: %v", err)
+	}
+
+	for key, want := range map[string]string{
+		"datetime":        "20180501T083000",
+		"duration":        "5400",
+		"disable_geojson": "false",
+	} {
+		if got := values.Get(key); got != want {
+			t.Errorf("unexpected %s: got %q, want %q", key, got, want)
+		}
+	}
+	if got := values["forbidden_uris[]"]; !reflect.DeepEqual(got, []string{"line:RAT:M1", "line:RAT:M4"}) {
+		t.Errorf("unexpected forbidden URIs: %v", got)
+	}
+	if _, ok := values["count"]; ok {
+		t.Errorf("count given while unset: %v", values)
+	}
+}
+
+// Test_Scope_DeparturesSA_Duration checks that the duration of a connections request is sent, in seconds
+func Test_Scope_DeparturesSA_Duration(t *testing.T) {
+	var query url.Values
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"departures": []}`))
+	}))
+
+	req := ConnectionsRequest{Duration: 2 * time.Hour}
+	if _, err := session.Scope("shannon").DeparturesSA(context.Background(), req, "stop_area:OEA:SA:1"); err != nil {
+		t.Fatalf("error in DeparturesSA: %v", err)
+	}
+	if got := query.Get("duration"); got != "7200" {
+		t.Errorf("unexpected duration: got %q, want %q", got, "7200")
+	}
+
+	if _, err := session.Scope("shannon").DeparturesSA(context.Background(), ConnectionsRequest{}, "stop_area:OEA:SA:1"); err != nil {
+		t.Fatalf("error in DeparturesSA: %v", err)
+	}
+	if got, ok := query["duration"]; ok {
+		t.Errorf("duration given while unset: %v", got)
+	}
+}
+
 func TestConnectionsSA(t *testing.T) {
 	if *apiKey == "" {
 		t.Skip(skipNoKey)
@@ -0,0 +1,111 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+const (
+	contributorsEndpoint string = "contributors"
+	datasetsEndpoint     string = "datasets"
+)
+
+// ContributorsResults holds the results of a contributors request.
+type ContributorsResults struct {
+	Contributors []types.Contributor `json:"contributors"`
+	Paging       Paging              `json:"links"`
+	Pagination   Pagination          `json:"pagination"`
+	Logging      `json:"-"`
+	session      *Session
+}
+
+// Count returns the number of contributors available in a ContributorsResults
+func (cr *ContributorsResults) Count() int {
+	return len(cr.Contributors)
+}
+
+// TotalAvailable returns the total number of contributors available across all pages.
+func (cr *ContributorsResults) TotalAvailable() int {
+	return cr.Pagination.total(len(cr.Contributors))
+}
+
+func (cr *ContributorsResults) paging() Paging {
+	return cr.Paging
+}
+
+func (cr *ContributorsResults) newPage() pagedResults {
+	return &ContributorsResults{session: cr.session}
+}
+
+func (cr *ContributorsResults) merge(page pagedResults) {
+	next := page.(*ContributorsResults)
+	cr.Contributors = append(cr.Contributors, next.Contributors...)
+	cr.Paging = next.Paging
+}
+
+// DatasetsResults holds the results of a datasets request.
+type DatasetsResults struct {
+	Datasets   []types.Dataset `json:"datasets"`
+	Paging     Paging          `json:"links"`
+	Pagination Pagination      `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of datasets available in a DatasetsResults
+func (dr *DatasetsResults) Count() int {
+	return len(dr.Datasets)
+}
+
+// TotalAvailable returns the total number of datasets available across all pages.
+func (dr *DatasetsResults) TotalAvailable() int {
+	return dr.Pagination.total(len(dr.Datasets))
+}
+
+func (dr *DatasetsResults) paging() Paging {
+	return dr.Paging
+}
+
+func (dr *DatasetsResults) newPage() pagedResults {
+	return &DatasetsResults{session: dr.session}
+}
+
+func (dr *DatasetsResults) merge(page pagedResults) {
+	next := page.(*DatasetsResults)
+	dr.Datasets = append(dr.Datasets, next.Datasets...)
+	dr.Paging = next.Paging
+}
+
+// ContributorDatasets lists the datasets loaded from a given contributor in a region, with their validity periods and realtime level.
+//
+// If the contributor is unknown, a *RemoteError with a 404 status code is returned.
+// It is context aware.
+func (s *Session) ContributorDatasets(ctx context.Context, region types.ID, contributorID types.ID) (*DatasetsResults, error) {
+	// Build the URL
+	reqURL := s.APIURL + "/" + regionEndpoint + "/" + string(region) + "/" + contributorsEndpoint + "/" + string(contributorID) + "/" + datasetsEndpoint
+
+	results := &DatasetsResults{session: s}
+	err := s.requestURL(ctx, reqURL, results)
+	return results, err
+}
+
+// Contributors lists the producers of the data feeding the region, such as transit agencies.
+func (scope *Scope) Contributors(ctx context.Context, req CollectionRequest) (*ContributorsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + contributorsEndpoint
+
+	results := &ContributorsResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// Datasets lists the datasets feeding the region, whatever their contributor, with their validity periods and realtime level.
+func (scope *Scope) Datasets(ctx context.Context, req CollectionRequest) (*DatasetsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + datasetsEndpoint
+
+	results := &DatasetsResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
@@ -0,0 +1,94 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_DatasetsResults_Unmarshal tests unmarshalling for DatasetsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_DatasetsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["datasets"], reflect.TypeOf(DatasetsResults{}))
+}
+
+func Test_ContributorDatasets(t *testing.T) {
+	fixture := testData["datasets"].correct["two_datasets.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/coverage/fr-idf/contributors/fr-idf:OIF/datasets" {
+			_, _ = w.Write(fixture)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}`))
+	}))
+
+	res, err := session.ContributorDatasets(context.Background(), "fr-idf", "fr-idf:OIF")
+	if err != nil {
+		t.Fatalf("error in ContributorDatasets: %v", err)
+	}
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 datasets, got %d", res.Count())
+	}
+
+	levels := []types.DataFreshness{res.Datasets[0].RealtimeLevel, res.Datasets[1].RealtimeLevel}
+	if !reflect.DeepEqual(levels, []types.DataFreshness{types.DataFreshnessBaseSchedule, types.DataFreshnessRealTime}) {
+		t.Errorf("unexpected realtime levels: %v", levels)
+	}
+	if ds := res.Datasets[0]; ds.Contributor.ID != "fr-idf:OIF" || ds.Start.IsZero() || ds.End.Before(ds.Start) {
+		t.Errorf("unexpected dataset: %#v", ds)
+	}
+
+	// Unknown contributor
+	_, err = session.ContributorDatasets(context.Background(), "fr-idf", "fr-idf:UNKNOWN")
+	if remoteErr, ok := err.(*RemoteError); !ok || remoteErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found *RemoteError, got %T: %v", err, err)
+	}
+}
+
+// Test_Scope_Datasets checks that the datasets and contributors of a region are requested at the coverage level.
+func Test_Scope_Datasets(t *testing.T) {
+	fixture := testData["datasets"].correct["two_datasets.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/datasets":
+			_, _ = w.Write(fixture)
+		case "/coverage/fr-idf/contributors":
+			_, _ = w.Write([]byte(`{"contributors": [{"id": "fr-idf:OIF", "name": "OIF", "license": "ODbL", "website": "https://opendata.stif.info"}], "links": [], "pagination": {"total_result": 1, "items_on_page": 1}}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	scope := session.Scope("fr-idf")
+
+	datasets, err := scope.Datasets(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in Datasets: %v", err)
+	}
+	if datasets.Count() != 2 {
+		t.Errorf("expected 2 datasets, got %d", datasets.Count())
+	}
+
+	contributors, err := scope.Contributors(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in Contributors: %v", err)
+	}
+	if contributors.Count() != 1 || contributors.Contributors[0].License != "ODbL" {
+		t.Errorf("unexpected contributors: %+v", contributors.Contributors)
+	}
+}
@@ -2,6 +2,7 @@ package navitia
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/govitia/navitia/types"
 	"github.com/govitia/navitia/utils"
@@ -10,6 +11,7 @@ import (
 type DeparturesResults struct {
 	Departures []types.Departure `json:"departures"`
 	Paging     Paging            `json:"links"`
+	Pagination Pagination        `json:"pagination"`
 	Logging    `json:"-"`
 	session    *Session
 }
@@ -19,15 +21,51 @@ func (dr *DeparturesResults) Count() int {
 	return len(dr.Departures)
 }
 
-// DeparturesRequest contain the parameters needed to make a departures
+// TotalAvailable returns the total number of departures available across all pages.
+func (dr *DeparturesResults) TotalAvailable() int {
+	return dr.Pagination.total(len(dr.Departures))
+}
+
+// DeparturesRequest contain the parameters needed to make a departures (or arrivals, see ArrivalsRequest) request
 type DeparturesRequest struct {
-	StopArea string
+	// StopArea or StopPoint restrict the departures to those from a stop area or stop point.
+	// If both are given, StopPoint takes precedence.
+	StopArea  types.ID
+	StopPoint types.ID
+
+	// From what time on do you want to see the departures ? (default now)
+	From time.Time `param:"from_datetime"`
+
+	// The maximum amount of departures (default 10)
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// Freshness of the data, such as types.DataFreshnessRealTime for a realtime board
+	Freshness types.DataFreshness `param:"data_freshness"`
 }
 
-func (req DeparturesRequest) toURL() (url.Values, error) {
-	rb := utils.NewRequestBuilder()
+// path returns the path of the object whose departures are requested, followed by a slash, if any
+func (req DeparturesRequest) path() string {
+	switch {
+	case req.StopPoint != "":
+		return "stop_points/" + string(req.StopPoint) + "/"
+	case req.StopArea != "":
+		return "stop_areas/" + string(req.StopArea) + "/"
+	default:
+		return ""
+	}
+}
 
-	rb.AddString("stop_area", req.StopArea)
+func (req DeparturesRequest) toURL() (url.Values, error) {
+	if err := checkEnums("", req.Freshness); err != nil {
+		return nil, err
+	}
 
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
 	return rb.Values(), nil
 }
@@ -0,0 +1,126 @@
+package navitia
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_DeparturesResults_Unmarshal tests unmarshalling for DeparturesResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_DeparturesResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["departures"], reflect.TypeOf(DeparturesResults{}))
+}
+
+// Test_Scope_Departures checks that the departures from a stop point are requested, and that their date times are decoded
+func Test_Scope_Departures(t *testing.T) {
+	fixture := testData["departures"].correct["shannon.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const stopPoint = "stop_point:OEA:SP:8360B337651"
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/ie/stop_points/" + stopPoint + "/departures"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if freshness := r.URL.Query().Get("data_freshness"); freshness != string(types.DataFreshnessRealTime) {
+			t.Errorf("unexpected data_freshness: %q", freshness)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := DeparturesRequest{StopPoint: stopPoint, Freshness: types.DataFreshnessRealTime}
+	res, err := session.Scope("ie").Departures(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in Departures: %v", err)
+	}
+	if res.Count() != 10 {
+		t.Fatalf("expected 10 departures, got %d", res.Count())
+	}
+
+	d := res.Departures[0]
+	if d.StopPoint.ID != stopPoint {
+		t.Errorf("unexpected stop point: %s", d.StopPoint.ID)
+	}
+	if d.DisplayInformations.Label != "343" {
+		t.Errorf("unexpected line label: %q", d.DisplayInformations.Label)
+	}
+	if expected := time.Date(2017, 4, 27, 17, 8, 0, 0, time.UTC); !d.StopDateTime.Departure.Equal(expected) {
+		t.Errorf("unexpected departure time: got %s, expected %s", d.StopDateTime.Departure, expected)
+	}
+}
+
+// Test_Scope_Departures_PreferRealtime checks that a session preferring realtime falls back to the base schedule departures
+func Test_Scope_Departures_PreferRealtime(t *testing.T) {
+	fixture := testData["departures"].correct["shannon.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	var freshnesses []string
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		freshness := r.URL.Query().Get("data_freshness")
+		freshnesses = append(freshnesses, freshness)
+		if freshness == string(types.DataFreshnessRealTime) {
+			_, _ = w.Write([]byte(`{"departures": []}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	}), WithPreferRealtime())
+
+	res, err := session.Scope("ie").Departures(context.Background(), DeparturesRequest{StopPoint: "stop_point:OEA:SP:8360B337651"})
+	if err != nil {
+		t.Fatalf("error in Departures: %v", err)
+	}
+	if res.Count() != 10 {
+		t.Errorf("expected 10 base schedule departures, got %d", res.Count())
+	}
+
+	expected := []string{string(types.DataFreshnessRealTime), string(types.DataFreshnessBaseSchedule)}
+	if !reflect.DeepEqual(freshnesses, expected) {
+		t.Errorf("unexpected requested freshnesses: got %v, expected %v", freshnesses, expected)
+	}
+}
+
+// BenchmarkScope_Departures benchmarks polling a departure board, from building the request to decoding its response
+func BenchmarkScope_Departures(b *testing.B) {
+	fixture, err := ioutil.ReadFile("testdata/departures/correct/shannon.json")
+	if err != nil {
+		b.Fatalf("error while reading fixture: %v", err)
+	}
+
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body:       ioutil.NopCloser(bytes.NewReader(fixture)),
+			Request:    req,
+		}, nil
+	})
+	session, err := NewCustom("", "http://example.com/v1", doer)
+	if err != nil {
+		b.Fatalf("error while creating session: %v", err)
+	}
+	scope := session.Scope("fr-se")
+	req := DeparturesRequest{StopPoint: "stop_point:OEA:SP:8360B337651", Count: 10}
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(fixture)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := scope.Departures(ctx, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const disruptionsEndpoint string = "disruptions"
+
+// DisruptionsResults holds the results of a disruptions request.
+type DisruptionsResults struct {
+	Disruptions []types.Disruption `json:"disruptions"`
+	Paging      Paging             `json:"links"`
+	Pagination  Pagination         `json:"pagination"`
+	Logging     `json:"-"`
+	session     *Session
+}
+
+// Count returns the number of disruptions available in a DisruptionsResults
+func (dr *DisruptionsResults) Count() int {
+	return len(dr.Disruptions)
+}
+
+// TotalAvailable returns the total number of disruptions available across all pages.
+func (dr *DisruptionsResults) TotalAvailable() int {
+	return dr.Pagination.total(len(dr.Disruptions))
+}
+
+func (dr *DisruptionsResults) paging() Paging {
+	return dr.Paging
+}
+
+func (dr *DisruptionsResults) newPage() pagedResults {
+	return &DisruptionsResults{session: dr.session}
+}
+
+// ForEach calls f with each of the disruptions, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (dr *DisruptionsResults) ForEach(ctx context.Context, f func(disruption types.Disruption) error) error {
+	return walkPages(ctx, dr.session, dr, func(page pagedResults) error {
+		for _, disruption := range page.(*DisruptionsResults).Disruptions {
+			if err := f(disruption); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// DisruptionsRequest contains the optional parameters for a Disruptions request.
+type DisruptionsRequest struct {
+	// Only return the disruptions active during this period
+	Since time.Time `param:"since"`
+	Until time.Time `param:"until"`
+
+	// Language of the disruption messages, where the data provides translations (e.g "fr-FR")
+	Language string `param:"language"`
+
+	// Only return the disruptions with these tags
+	Tags []string `param:"tags[]"`
+
+	// Maximum amount of disruptions
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+}
+
+func (req DisruptionsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// disruptions is the internal function used by Disruptions functions
+func (s *Session) disruptions(ctx context.Context, url string, req DisruptionsRequest) (*DisruptionsResults, error) {
+	results := &DisruptionsResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// Disruptions requests the disruptions of the region, such as works or incidents, with their severity, messages and impacted objects.
+// Use Since and Until to restrict them to a period.
+func (scope *Scope) Disruptions(ctx context.Context, req DisruptionsRequest) (*DisruptionsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + disruptionsEndpoint
+
+	return scope.session.disruptions(ctx, reqURL, req)
+}
+
+// DisruptionImpacts lists the objects (lines, stop areas, trips...) impacted by the disruption of the given ID in a region.
+// The kind of each object is given by its Object.EmbeddedType.
+//
+// If the disruption doesn't exist (or doesn't anymore), a *RemoteError with a 404 status code and the RemoteErrUnknownObject ID is returned.
+// It is context aware.
+func (s *Session) DisruptionImpacts(ctx context.Context, region types.ID, disruptionID types.ID) ([]types.ImpactedObject, error) {
+	// Build the URL
+	reqURL := s.APIURL + "/" + regionEndpoint + "/" + string(region) + "/" + disruptionsEndpoint + "/" + string(disruptionID)
+
+	results := &DisruptionsResults{session: s}
+	err := s.requestURL(ctx, reqURL, results)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if results.Count() == 0 {
+		return nil, notFound("disruption", disruptionID)
+	}
+
+	var impacted []types.ImpactedObject
+	for _, d := range results.Disruptions {
+		impacted = append(impacted, d.Impacted...)
+	}
+	return impacted, nil
+}
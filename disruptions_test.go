@@ -0,0 +1,112 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_DisruptionsResults_Unmarshal tests unmarshalling for DisruptionsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_DisruptionsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["disruptions"], reflect.TypeOf(DisruptionsResults{}))
+}
+
+func Test_DisruptionImpacts(t *testing.T) {
+	fixture := testData["disruptions"].correct["multiple_impacts.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const id = "9b7c3f2e-8d41-11e7-a2c4-005056a47b86"
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/disruptions/" + id:
+			_, _ = w.Write(fixture)
+		case "/coverage/fr-idf/disruptions/empty":
+			_, _ = w.Write([]byte(`{"disruptions": [], "links": []}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}`))
+		}
+	}))
+
+	t.Run("found", func(t *testing.T) {
+		impacted, err := session.DisruptionImpacts(context.Background(), "fr-idf", id)
+		if err != nil {
+			t.Fatalf("error in DisruptionImpacts: %v", err)
+		}
+
+		count := make(map[string]int)
+		for _, io := range impacted {
+			count[io.Object.EmbeddedType]++
+		}
+		expected := map[string]int{
+			types.EmbeddedLine:     2,
+			types.EmbeddedStopArea: 2,
+			types.EmbeddedTrip:     1,
+		}
+		if !reflect.DeepEqual(count, expected) {
+			t.Errorf("unexpected impacted objects: got %v, expected %v", count, expected)
+		}
+	})
+
+	for _, missing := range []types.ID{"gone", "empty"} {
+		missing := missing
+		t.Run("not found/"+string(missing), func(t *testing.T) {
+			_, err := session.DisruptionImpacts(context.Background(), "fr-idf", missing)
+			remoteErr, ok := err.(*RemoteError)
+			if !ok {
+				t.Fatalf("expected a *RemoteError, got %T: %v", err, err)
+			}
+			if remoteErr.StatusCode != http.StatusNotFound || remoteErr.ID != RemoteErrUnknownObject {
+				t.Errorf("unexpected error: %#v", remoteErr)
+			}
+		})
+	}
+}
+
+// Test_Scope_Disruptions checks that the period is sent as since & until, and that the disruptions are decoded
+func Test_Scope_Disruptions(t *testing.T) {
+	fixture := testData["disruptions"].correct["multiple_impacts.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	since := time.Date(2017, time.August, 20, 0, 0, 0, 0, time.UTC)
+	until := since.Add(7 * 24 * time.Hour)
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/disruptions"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query.Get("since"); got != "20170820T000000" {
+			t.Errorf("unexpected since: %q", got)
+		}
+		if got := query.Get("until"); got != "20170827T000000" {
+			t.Errorf("unexpected until: %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").Disruptions(context.Background(), DisruptionsRequest{Since: since, Until: until})
+	if err != nil {
+		t.Fatalf("error in Disruptions: %v", err)
+	}
+	if res.Count() != 1 {
+		t.Fatalf("expected 1 disruption, got %d", res.Count())
+	}
+	d := res.Disruptions[0]
+	if d.Status != types.DisruptionStatusActive || d.Severity.Effect != types.EffectNoService || len(d.Periods) != 1 {
+		t.Errorf("unexpected disruption: %+v", d)
+	}
+}
@@ -0,0 +1,148 @@
+package navitia
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+
+	"github.com/govitia/navitia/types"
+)
+
+// dumpTimeLayout is the layout used for times in Dump
+const dumpTimeLayout = "15:04"
+
+// linkTypeDisruption is the type of links referencing a Disruption
+const linkTypeDisruption = "disruption"
+
+// DumpOptions configures JourneyResults.Dump
+type DumpOptions struct {
+	// Color enables ANSI colors in the output
+	Color bool
+
+	// Sections includes a line per section of each journey
+	Sections bool
+
+	// Geometry includes the geometry of each section (requires Sections)
+	Geometry bool
+
+	// Disruptions includes the disruptions affecting each section (requires Sections)
+	Disruptions bool
+}
+
+// dumper writes a JourneyResults for humans
+type dumper struct {
+	w    *bufio.Writer
+	opts DumpOptions
+
+	disruptions map[types.ID]types.Disruption
+
+	time, line, warn *color.Color
+}
+
+// newDumper creates a dumper writing to w, with colors enabled or not according to opts
+func newDumper(w io.Writer, opts DumpOptions, disruptions []types.Disruption) *dumper {
+	d := &dumper{
+		w:           bufio.NewWriter(w),
+		opts:        opts,
+		disruptions: make(map[types.ID]types.Disruption, len(disruptions)),
+		time:        color.New(color.FgRed),
+		line:        color.New(color.FgGreen, color.Bold),
+		warn:        color.New(color.FgYellow),
+	}
+	for _, c := range [...]*color.Color{d.time, d.line, d.warn} {
+		if opts.Color {
+			c.EnableColor()
+		} else {
+			c.DisableColor()
+		}
+	}
+	for _, dis := range disruptions {
+		d.disruptions[dis.ID] = dis
+	}
+	return d
+}
+
+// journey writes the i-th journey
+func (d *dumper) journey(i int, j types.Journey) {
+	transfers := "transfers"
+	if j.Transfers == 1 {
+		transfers = "transfer"
+	}
+
+	fmt.Fprintf(d.w, "#%d", i+1)
+	if j.Type != "" {
+		fmt.Fprintf(d.w, " (%s)", j.Type)
+	}
+	fmt.Fprintf(d.w, ": %s → %s | %s | %d %s\n",
+		d.time.Sprint(j.Departure.Format(dumpTimeLayout)),
+		d.time.Sprint(j.Arrival.Format(dumpTimeLayout)),
+		j.Duration,
+		j.Transfers, transfers,
+	)
+
+	if !d.opts.Sections {
+		return
+	}
+	for _, s := range j.Sections {
+		d.section(s)
+	}
+}
+
+// section writes a section of a journey
+func (d *dumper) section(s types.Section) {
+	fmt.Fprintf(d.w, "\t%s-%s %s",
+		d.time.Sprint(s.Departure.Format(dumpTimeLayout)),
+		d.time.Sprint(s.Arrival.Format(dumpTimeLayout)),
+		s.Type,
+	)
+
+	switch {
+	case s.Type == types.SectionPublicTransport:
+		fmt.Fprintf(d.w, " %s %s", s.Display.CommercialMode, d.line.Sprint(s.Display.Label))
+		if s.Display.Direction != "" {
+			fmt.Fprintf(d.w, " dir. %s", s.Display.Direction)
+		}
+	case s.Mode != "":
+		fmt.Fprintf(d.w, " (%s)", s.Mode)
+	}
+
+	if s.From.Name != "" && s.To.Name != "" {
+		fmt.Fprintf(d.w, ": %s → %s", s.From.Name, s.To.Name)
+	}
+	fmt.Fprintf(d.w, " [%s]\n", s.Duration)
+
+	if d.opts.Geometry && s.Geo != nil && s.Geo.NumCoords() > 0 {
+		fmt.Fprintf(d.w, "\t\tgeometry: %d points, %v → %v\n", s.Geo.NumCoords(), s.Geo.Coord(0), s.Geo.Coord(s.Geo.NumCoords()-1))
+	}
+
+	if d.opts.Disruptions {
+		for _, l := range s.Links {
+			if l.Type != linkTypeDisruption {
+				continue
+			}
+			dis, ok := d.disruptions[l.ID]
+			if !ok {
+				continue
+			}
+			var msg string
+			if msgs := dis.MessagesFor(types.ChannelContentText); len(msgs) != 0 {
+				msg = msgs[0].Text
+			}
+			fmt.Fprintf(d.w, "\t\t%s %s\n", d.warn.Sprintf("⚠ %s", dis.Severity.Effect), msg)
+		}
+	}
+}
+
+// Dump writes a human-readable summary of the journeys to w: times, lines and transfers, and optionally their sections,
+// geometry and disruptions.
+//
+// It is meant for CLI tools and debugging, the output format isn't stable and shouldn't be parsed.
+func (jr *JourneyResults) Dump(w io.Writer, opts DumpOptions) error {
+	d := newDumper(w, opts, jr.Disruptions)
+	for i, j := range jr.Journeys {
+		d.journey(i, j)
+	}
+	return d.w.Flush()
+}
@@ -0,0 +1,62 @@
+package navitia
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+var updateGoldenFlag = flag.Bool("update", false, "Update the golden files")
+
+// Test_JourneyResults_Dump compares the output of Dump with the golden files in testdata/journeys/golden
+func Test_JourneyResults_Dump(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		opts    DumpOptions
+	}{
+		{"summary", "a.json", DumpOptions{}},
+		{"sections", "a.json", DumpOptions{Sections: true}},
+		{"full", "disrupted.json", DumpOptions{Sections: true, Geometry: true, Disruptions: true}},
+		{"color", "disrupted.json", DumpOptions{Color: true, Sections: true, Disruptions: true}},
+		{"empty_geometry", "empty_geometry.json", DumpOptions{Sections: true, Geometry: true}},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			raw, ok := testData["journeys"].correct[test.fixture]
+			if !ok {
+				t.Fatalf("fixture %s not found", test.fixture)
+			}
+
+			var jr JourneyResults
+			if err := json.Unmarshal(raw, &jr); err != nil {
+				t.Fatalf("error while unmarshalling: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := jr.Dump(&buf, test.opts); err != nil {
+				t.Fatalf("Dump returned an error: %v", err)
+			}
+
+			golden := filepath.Join(testDataPath, "journeys", "golden", test.name+".txt")
+			if *updateGoldenFlag {
+				if err := ioutil.WriteFile(golden, buf.Bytes(), 0644); err != nil {
+					t.Fatalf("error while updating golden file: %v", err)
+				}
+			}
+
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("error while reading golden file: %v", err)
+			}
+			if got := buf.Bytes(); !bytes.Equal(got, want) {
+				t.Errorf("Dump output differs from %s\ngot:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
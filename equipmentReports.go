@@ -0,0 +1,74 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const equipmentReportsEndpoint string = "equipment_reports"
+
+// EquipmentReportsResults holds the results of an equipment reports request: one report per line.
+type EquipmentReportsResults struct {
+	EquipmentReports []types.EquipmentReport `json:"equipment_reports"`
+	Paging           Paging                  `json:"links"`
+	Pagination       Pagination              `json:"pagination"`
+	Logging          `json:"-"`
+	session          *Session
+}
+
+// Count returns the number of equipment reports available in an EquipmentReportsResults
+func (er *EquipmentReportsResults) Count() int {
+	return len(er.EquipmentReports)
+}
+
+// TotalAvailable returns the total number of equipment reports available across all pages.
+func (er *EquipmentReportsResults) TotalAvailable() int {
+	return er.Pagination.total(len(er.EquipmentReports))
+}
+
+// EquipmentReportsRequest contains the optional parameters for an EquipmentReports request.
+type EquipmentReportsRequest struct {
+	// Filter restricts the reports with a navitia filter, such as `line.code=14`
+	Filter string `param:"filter"`
+
+	// Maximum amount of reports per page
+	Count uint `param:"count"`
+
+	// StartPage is the index of the requested page, starting at 0
+	StartPage uint `param:"start_page"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+}
+
+func (req EquipmentReportsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// equipmentReports is the internal function used by EquipmentReports functions
+func (s *Session) equipmentReports(ctx context.Context, url string, req EquipmentReportsRequest) (*EquipmentReportsResults, error) {
+	results := &EquipmentReportsResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// EquipmentReports retrieves the realtime state of the equipments, such as elevators and escalators,
+// of the stop areas served by each line of the region.
+//
+// This requires an equipment provider to be configured on the coverage.
+func (scope *Scope) EquipmentReports(ctx context.Context, req EquipmentReportsRequest) (*EquipmentReportsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + equipmentReportsEndpoint
+
+	return scope.session.equipmentReports(ctx, reqURL, req)
+}
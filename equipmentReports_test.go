@@ -0,0 +1,81 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_EquipmentReportsResults_Unmarshal tests unmarshalling for EquipmentReportsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_EquipmentReportsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["equipment_reports"], reflect.TypeOf(EquipmentReportsResults{}))
+}
+
+// Test_Scope_EquipmentReports checks the request parameters and the decoding of the equipments' state.
+func Test_Scope_EquipmentReports(t *testing.T) {
+	fixture := testData["equipment_reports"].correct["rer_a.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/equipment_reports"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if filter := r.URL.Query().Get("filter"); filter != "line.code=A" {
+			t.Errorf("unexpected filter: got %q, expected %q", filter, "line.code=A")
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").EquipmentReports(context.Background(), EquipmentReportsRequest{Filter: "line.code=A"})
+	if err != nil {
+		t.Fatalf("error in EquipmentReports: %v", err)
+	}
+
+	if res.Count() != 1 {
+		t.Fatalf("expected 1 equipment report, got %d", res.Count())
+	}
+	report := res.EquipmentReports[0]
+	if report.Line.Code != "A" {
+		t.Errorf("unexpected line: %q", report.Line.Code)
+	}
+	if len(report.StopAreaEquipments) != 1 {
+		t.Fatalf("expected 1 stop area, got %d", len(report.StopAreaEquipments))
+	}
+
+	sae := report.StopAreaEquipments[0]
+	if len(sae.EquipmentDetails) != 2 {
+		t.Fatalf("expected 2 equipments, got %d", len(sae.EquipmentDetails))
+	}
+	unavailable := sae.Unavailable()
+	if len(unavailable) != 1 {
+		t.Fatalf("expected 1 unavailable equipment, got %d", len(unavailable))
+	}
+
+	escalator := unavailable[0]
+	if escalator.Kind != types.EquipmentKindEscalator {
+		t.Errorf("unexpected kind: got %q, expected %q", escalator.Kind, types.EquipmentKindEscalator)
+	}
+	availability := escalator.CurrentAvailability
+	if availability.Cause != "maintenance" || availability.Effect != "out of service" {
+		t.Errorf("unexpected cause and effect: %q, %q", availability.Cause, availability.Effect)
+	}
+	if len(availability.Periods) != 1 {
+		t.Fatalf("expected 1 period, got %d", len(availability.Periods))
+	}
+	if end := availability.Periods[0].End; !end.Equal(time.Date(2017, time.April, 15, 18, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected end of unavailability: %s", end)
+	}
+	if !sae.EquipmentDetails[1].CurrentAvailability.Available() {
+		t.Errorf("expected equipment %s to be available", sae.EquipmentDetails[1].ID)
+	}
+}
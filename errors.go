@@ -3,11 +3,43 @@ package navitia
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
 )
 
+// ErrOutsideCoverage is returned when a position isn't covered by any region of the API
+var ErrOutsideCoverage = errors.New("position outside of the API's coverage")
+
+// A ValidationError is a parameter of a request that the API would reject, found before sending it, see JourneyRequest.Validate
+type ValidationError struct {
+	Field  string // Field of the request, such as "MinJourneys"
+	Reason string // Why the value is invalid
+}
+
+// Error implements error for a ValidationError
+func (err *ValidationError) Error() string {
+	return "invalid " + err.Field + ": " + err.Reason
+}
+
+// ValidationErrors lists the invalid parameters of a request, see JourneyRequest.Validate
+type ValidationErrors []*ValidationError
+
+// Error implements error for ValidationErrors, listing each of them
+func (errs ValidationErrors) Error() string {
+	msgs := make([]string, len(errs))
+	for i, err := range errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
 // RemoteErrorID is an ID for a remote error
 type RemoteErrorID string
 
@@ -20,11 +52,31 @@ const (
 	RemoteErrNoDestination         RemoteErrorID = "no_destination"             // Couldn’t find an destination for the journeys
 	RemoteErrNoOriginNoDestination RemoteErrorID = "nor_origin_nor_destination" // Couldn’t find an origin nor a destination for the journeys
 	RemoteErrUnknownObject         RemoteErrorID = "unknown_object"             // Unknown Object
+	RemoteErrNoSolution            RemoteErrorID = "no_solution"                // No journey was found between the origin & destination
+	RemoteErrUnknownAPI            RemoteErrorID = "unknown_api"                // The requested API isn't available on the region
 
 	// 400 Errors
 
 	RemoteErrBadFilter     RemoteErrorID = "bad_filter"      // Bad filter (with custom filter)
 	RemoteErrUnableToParse RemoteErrorID = "unable_to_parse" // Unable to parse mal-formed custom filter"
+	RemoteErrBadFormat     RemoteErrorID = "bad_format"      // A parameter is mal-formed
+
+	// 500 Errors
+
+	RemoteErrInternalError      RemoteErrorID = "internal_error"      // Internal error of the server
+	RemoteErrServiceUnavailable RemoteErrorID = "service_unavailable" // The region's data isn't loaded, or is being reloaded
+)
+
+// Sentinel errors matching a RemoteError with the same ID, for use with errors.Is, such as errors.Is(err, navitia.ErrNoSolution)
+var (
+	ErrDateOutOfBounds       error = &RemoteError{ID: RemoteErrDateOutOfBounds}
+	ErrNoOrigin              error = &RemoteError{ID: RemoteErrNoOrigin}
+	ErrNoDestination         error = &RemoteError{ID: RemoteErrNoDestination}
+	ErrNoOriginNoDestination error = &RemoteError{ID: RemoteErrNoOriginNoDestination}
+	ErrUnknownObject         error = &RemoteError{ID: RemoteErrUnknownObject}
+	ErrNoSolution            error = &RemoteError{ID: RemoteErrNoSolution}
+	ErrBadFilter             error = &RemoteError{ID: RemoteErrBadFilter}
+	ErrServiceUnavailable    error = &RemoteError{ID: RemoteErrServiceUnavailable}
 )
 
 // remoteErrorsDescriptions contains human-readable descriptions for a given remote error ID
@@ -36,8 +88,13 @@ var remoteErrorsDescriptions = map[RemoteErrorID]string{
 	RemoteErrNoDestination:         "Couldn’t find an destination for the journeys",
 	RemoteErrNoOriginNoDestination: "Couldn’t find an origin nor a destination for the journeys",
 	RemoteErrUnknownObject:         "Unknown Object",
+	RemoteErrNoSolution:            "No solution found",
+	RemoteErrUnknownAPI:            "Unknown API",
 	RemoteErrBadFilter:             "Bad filter (with custom filter)",
 	RemoteErrUnableToParse:         "Unable to parse mal-formed custom filter",
+	RemoteErrBadFormat:             "Mal-formed parameter",
+	RemoteErrInternalError:         "Internal error",
+	RemoteErrServiceUnavailable:    "Service unavailable",
 }
 
 // A RemoteError represents an error sent by the server
@@ -45,6 +102,80 @@ type RemoteError struct {
 	StatusCode int
 	ID         RemoteErrorID `json:"id"`
 	Message    string        `json:"message"`
+
+	// retryAfter is the raw Retry-After header of the response, if any
+	retryAfter string
+
+	// received is when the response was received, used to resolve a Retry-After given in seconds
+	received time.Time
+
+	// cause is why the body of the response couldn't be decoded, if it couldn't
+	cause error
+}
+
+// RetryAfter returns the time after which the request may be retried, as indicated by the Retry-After header
+// of the response (typically when rate limited, or when the service is unavailable).
+// Both the delay in seconds and the HTTP-date forms of the header are supported.
+//
+// If the response had no valid Retry-After header, ok is false.
+func (err *RemoteError) RetryAfter() (t time.Time, ok bool) {
+	return parseRetryAfter(err.retryAfter, err.received)
+}
+
+// parseRetryAfter parses a Retry-After header of a response received at the given time
+func parseRetryAfter(header string, received time.Time) (t time.Time, ok bool) {
+	if header == "" {
+		return time.Time{}, false
+	}
+
+	// Delay in seconds
+	if secs, convErr := strconv.ParseUint(header, 10, 32); convErr == nil {
+		return received.Add(time.Duration(secs) * time.Second), true
+	}
+
+	// HTTP-date
+	t, parseErr := http.ParseTime(header)
+	if parseErr != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Unwrap returns why the body of the response couldn't be decoded as an error of the API, such as for rate limiting
+// or gateway errors answered by a proxy, in which case Message is the body. It is nil if it was decoded.
+func (err *RemoteError) Unwrap() error {
+	return err.cause
+}
+
+// Is reports whether target is a RemoteError with the same ID, such as one of the ErrXXX sentinels.
+// This allows branching on the cause of an error with errors.Is.
+func (err *RemoteError) Is(target error) bool {
+	t, ok := target.(*RemoteError)
+	return ok && t.ID != "" && t.ID == err.ID
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a RemoteError.
+// The API either gives the error's id & message at the root of the response, or nested in an "error" object.
+func (err *RemoteError) UnmarshalJSON(b []byte) error {
+	type payload struct {
+		ID      RemoteErrorID `json:"id"`
+		Message string        `json:"message"`
+	}
+	data := &struct {
+		payload
+		Nested *payload `json:"error"`
+	}{}
+
+	if jsonErr := json.Unmarshal(b, data); jsonErr != nil {
+		return errors.Wrap(jsonErr, "error while unmarshalling RemoteError")
+	}
+
+	if data.Nested != nil {
+		err.ID, err.Message = data.Nested.ID, data.Nested.Message
+	} else {
+		err.ID, err.Message = data.ID, data.Message
+	}
+	return nil
 }
 
 // Error formats the error in a human-readable format
@@ -66,17 +197,51 @@ func (err RemoteError) Error() string {
 	return s
 }
 
-// parseRemoteError parses a non 200 OK status-coded response and returns the error
+// maxErrorMessage is the length above which the body of a response that isn't a JSON error is truncated, see parseRemoteError
+const maxErrorMessage = 256
+
+// parseRemoteError parses a non 200 OK status-coded response and returns the error.
+// If its body isn't an error of the API, such as an empty or HTML one given by a proxy, the RemoteError is still returned,
+// with the (truncated) body as its message.
 func parseRemoteError(resp *http.Response) error {
-	remoteErr := &RemoteError{StatusCode: resp.StatusCode}
+	remoteErr := &RemoteError{
+		StatusCode: resp.StatusCode,
+		retryAfter: resp.Header.Get("Retry-After"),
+		received:   time.Now(),
+	}
 
 	// Parse it
-	dec := json.NewDecoder(resp.Body)
-	err := dec.Decode(&remoteErr)
+	body, err := ioutil.ReadAll(resp.Body)
+	if err == nil {
+		err = json.Unmarshal(body, remoteErr)
+	}
 	if err != nil {
-		return errors.Wrap(err, "parseRemoteError: error while decoding JSON")
+		remoteErr.cause = errors.Wrap(err, "parseRemoteError: error while decoding JSON")
+		remoteErr.Message = strings.TrimSpace(string(body))
+		if len(remoteErr.Message) > maxErrorMessage {
+			remoteErr.Message = strings.ToValidUTF8(remoteErr.Message[:maxErrorMessage], "") + "…"
+		}
+		if remoteErr.Message == "" {
+			remoteErr.Message = http.StatusText(resp.StatusCode)
+		}
 	}
 
 	// Return
 	return remoteErr
 }
+
+// notFound creates the error returned when an object looked up by ID isn't in the response,
+// as some instances answer with an empty list rather than a 404.
+func notFound(kind string, id types.ID) *RemoteError {
+	return &RemoteError{
+		StatusCode: http.StatusNotFound,
+		ID:         RemoteErrUnknownObject,
+		Message:    kind + " " + string(id) + " not found",
+	}
+}
+
+// remoteNotFound reports whether err is, or wraps, a RemoteError with a 404 status code, such as for an unknown object
+func remoteNotFound(err error) bool {
+	var remoteErr *RemoteError
+	return errors.As(err, &remoteErr) && remoteErr.StatusCode == http.StatusNotFound
+}
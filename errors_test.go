@@ -0,0 +1,144 @@
+package navitia
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Test_RemoteError_RetryAfter checks both forms of the Retry-After header
+func Test_RemoteError_RetryAfter(t *testing.T) {
+	date := time.Date(2017, time.April, 13, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header string
+		ok     bool
+		check  func(got time.Time, sent time.Time) bool
+	}{
+		{
+			name:   "seconds",
+			header: "120",
+			ok:     true,
+			check: func(got, sent time.Time) bool {
+				return !got.Before(sent.Add(120*time.Second)) && !got.After(time.Now().Add(120*time.Second))
+			},
+		},
+		{
+			name:   "http-date",
+			header: date.Format(http.TimeFormat),
+			ok:     true,
+			check:  func(got, _ time.Time) bool { return got.Equal(date) },
+		},
+		{name: "missing", header: "", ok: false},
+		{name: "invalid", header: "soon", ok: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if test.header != "" {
+					w.Header().Set("Retry-After", test.header)
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+				_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+			}))
+
+			sent := time.Now()
+			_, err := session.Regions(context.Background(), RegionRequest{})
+			remoteErr, ok := err.(*RemoteError)
+			if !ok {
+				t.Fatalf("expected a *RemoteError, got %T: %v", err, err)
+			}
+
+			got, ok := remoteErr.RetryAfter()
+			if ok != test.ok {
+				t.Fatalf("unexpected ok: got %t, expected %t (time %s)", ok, test.ok, got)
+			}
+			if test.check != nil && !test.check(got, sent) {
+				t.Errorf("unexpected retry time for header %q: %s", test.header, got)
+			}
+		})
+	}
+}
+
+// Test_RemoteError_Is checks that the error id is parsed from both forms of the payload, and can be matched with errors.Is
+func Test_RemoteError_Is(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/lines/nested":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}}`))
+		case "/coverage/fr-idf/lines/flat":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"id": "bad_filter", "message": "ptref : Filters: Unable to parse filter"}`))
+		case "/journeys":
+			_, _ = w.Write([]byte(`{"error": {"id": "no_solution", "message": "no solution found for this journey"}, "journeys": [], "links": []}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	scope := session.Scope("fr-idf")
+	ctx := context.Background()
+
+	_, err := scope.Line(ctx, "nested", LinesRequest{})
+	if !errors.Is(err, ErrUnknownObject) || errors.Is(err, ErrBadFilter) {
+		t.Errorf("expected an unknown object error, got %v", err)
+	}
+	var remoteErr *RemoteError
+	if !errors.As(err, &remoteErr) || remoteErr.StatusCode != http.StatusNotFound || remoteErr.Message == "" {
+		t.Errorf("unexpected remote error: %#v", remoteErr)
+	}
+
+	if _, err = scope.Line(ctx, "flat", LinesRequest{}); !errors.Is(err, ErrBadFilter) {
+		t.Errorf("expected a bad filter error, got %v", err)
+	}
+
+	// No solution is reported along a 200 status code
+	if _, err = session.Journeys(ctx, JourneyRequest{From: "2.377;48.847", To: "2.352;48.867"}); !errors.Is(err, ErrNoSolution) {
+		t.Errorf("expected a no solution error, got %v", err)
+	}
+}
+
+// Test_parseRemoteError_NotJSON checks that responses whose body isn't an error of the API, such as from a proxy,
+// still give a RemoteError
+func Test_parseRemoteError_NotJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		message string
+	}{
+		{"rate limited", http.StatusTooManyRequests, "", "Too Many Requests"},
+		{"bad gateway", http.StatusBadGateway, "<html><body>502 Bad Gateway</body></html>\n", "<html><body>502 Bad Gateway</body></html>"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "30")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+
+			_, err := session.Regions(context.Background(), RegionRequest{})
+			var remoteErr *RemoteError
+			if !errors.As(err, &remoteErr) {
+				t.Fatalf("expected a *RemoteError, got %T: %v", err, err)
+			}
+			if remoteErr.StatusCode != test.status || remoteErr.Message != test.message {
+				t.Errorf("unexpected error: status %d, message %q", remoteErr.StatusCode, remoteErr.Message)
+			}
+			if _, ok := remoteErr.RetryAfter(); !ok {
+				t.Errorf("expected the Retry-After header to be kept")
+			}
+			if remoteErr.Unwrap() == nil {
+				t.Errorf("expected the decoding error to be kept")
+			}
+		})
+	}
+}
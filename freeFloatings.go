@@ -0,0 +1,70 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const freeFloatingsNearbyEndpoint string = "freefloatings_nearby"
+
+// FreeFloatingsResults holds the results of a free-floating vehicles request.
+type FreeFloatingsResults struct {
+	FreeFloatings []types.FreeFloating `json:"free_floatings"`
+	Paging        Paging               `json:"links"`
+	Pagination    Pagination           `json:"pagination"`
+	Logging       `json:"-"`
+	session       *Session
+}
+
+// Count returns the number of free-floating vehicles available in a FreeFloatingsResults
+func (ffr *FreeFloatingsResults) Count() int {
+	return len(ffr.FreeFloatings)
+}
+
+// TotalAvailable returns the total number of free-floating vehicles available across all pages.
+func (ffr *FreeFloatingsResults) TotalAvailable() int {
+	return ffr.Pagination.total(len(ffr.FreeFloatings))
+}
+
+// FreeFloatingsRequest contains the optional parameters for a FreeFloatingsNearby request.
+type FreeFloatingsRequest struct {
+	// Maximum distance of the vehicles, in meters (default 500)
+	Distance uint `param:"distance"`
+
+	// Types are the types of vehicles to query, such as types.FreeFloatingBike
+	Types []string `param:"type[]"`
+
+	// Maximum amount of vehicles per page
+	Count uint `param:"count"`
+
+	// StartPage is the index of the requested page, starting at 0
+	StartPage uint `param:"start_page"`
+}
+
+func (req FreeFloatingsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// freeFloatings is the internal function used by FreeFloatings functions
+func (s *Session) freeFloatings(ctx context.Context, url string, req FreeFloatingsRequest) (*FreeFloatingsResults, error) {
+	results := &FreeFloatingsResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// FreeFloatingsNearby lists the free-floating vehicles, such as shared bikes, scooters and cars, around the given coordinates.
+//
+// This requires a free-floating provider to be configured on the coverage.
+func (scope *Scope) FreeFloatingsNearby(ctx context.Context, coords types.Coordinates, req FreeFloatingsRequest) (*FreeFloatingsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/coords/" + coords.String() + "/" + freeFloatingsNearbyEndpoint
+
+	return scope.session.freeFloatings(ctx, reqURL, req)
+}
@@ -0,0 +1,68 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_FreeFloatingsResults_Unmarshal tests unmarshalling for FreeFloatingsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_FreeFloatingsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["free_floatings"], reflect.TypeOf(FreeFloatingsResults{}))
+}
+
+// Test_Scope_FreeFloatingsNearby checks the request path and parameters, and the decoding of the vehicles.
+func Test_Scope_FreeFloatingsNearby(t *testing.T) {
+	fixture := testData["free_floatings"].correct["paris.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/coords/2.3774;48.8472/freefloatings_nearby"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query["type[]"]; !reflect.DeepEqual(got, []string{"KICKSCOOTER", "CAR"}) {
+			t.Errorf("unexpected types: %v", got)
+		}
+		if got := query.Get("distance"); got != "200" {
+			t.Errorf("unexpected distance: got %q, expected %q", got, "200")
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	coords := types.Coordinates{Longitude: 2.3774, Latitude: 48.8472}
+	req := FreeFloatingsRequest{
+		Distance: 200,
+		Types:    []string{types.FreeFloatingKickScooter, types.FreeFloatingCar},
+	}
+	res, err := session.Scope("fr-idf").FreeFloatingsNearby(context.Background(), coords, req)
+	if err != nil {
+		t.Fatalf("error in FreeFloatingsNearby: %v", err)
+	}
+
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 vehicles, got %d", res.Count())
+	}
+	scooter := res.FreeFloatings[0]
+	if scooter.ProviderName != "Lime" || scooter.Propulsion != types.PropulsionElectric {
+		t.Errorf("unexpected provider or propulsion: %q, %q", scooter.ProviderName, scooter.Propulsion)
+	}
+	if scooter.Battery == nil || *scooter.Battery != 87 {
+		t.Errorf("unexpected battery: %v", scooter.Battery)
+	}
+	if scooter.Coord.Latitude != 48.84731 {
+		t.Errorf("unexpected latitude: %f", scooter.Coord.Latitude)
+	}
+	if car := res.FreeFloatings[1]; car.Battery != nil {
+		t.Errorf("expected no battery level for %s, got %d", car.ID, *car.Battery)
+	}
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// typePrefix prefixes the names of the generated types, so that they don't clash with the hand-written ones, such as rawLine for Line
+const typePrefix = "raw"
+
+// initialisms are the words written in upper case in Go identifiers, such as ID in StopAreaID
+var initialisms = map[string]bool{
+	"api": true, "bss": true, "gtfs": true, "id": true, "json": true, "poi": true,
+	"pt": true, "uri": true, "url": true, "utc": true, "uuid": true,
+}
+
+// goName returns the exported Go identifier for a name of the schema, such as "StopAreaID" for "stop_area_id"
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		if initialisms[strings.ToLower(word)] {
+			b.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+
+	ident := b.String()
+	if ident == "" || unicode.IsDigit([]rune(ident)[0]) {
+		ident = "X" + ident
+	}
+	return ident
+}
+
+// typeName returns the name of the type generated for a definition, such as "rawStopArea" for "StopArea"
+func typeName(name string) string {
+	return typePrefix + goName(name)
+}
+
+// generator writes the Go source of the types described by a schema
+type generator struct {
+	schema *schema
+	buf    bytes.Buffer
+
+	// usesJSON is set if json.RawMessage is used, so that encoding/json is imported
+	usesJSON bool
+}
+
+// generate returns the formatted Go source declaring, in package pkg, one struct per object of the schema.
+// source is the location the schema was read from, written in the header of the generated file.
+//
+// As with the hand-written jsonXxx types, values are pointers so that missing values can be told apart from zero ones,
+// and dates are kept as strings, as Navitia gives them in its own formats.
+// Objects declared inline, rather than as a definition of the schema, are kept as json.RawMessage.
+func generate(s *schema, pkg string, source string) ([]byte, error) {
+	g := &generator{schema: s}
+
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var body bytes.Buffer
+	for _, name := range names {
+		g.buf.Reset()
+		if err := g.writeStruct(name, s.Definitions[name]); err != nil {
+			return nil, errors.Wrapf(err, "error while generating the type of %q", name)
+		}
+		body.Write(g.buf.Bytes())
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by github.com/govitia/navitia/gen from %s. DO NOT EDIT.\n\n", source)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	if g.usesJSON {
+		out.WriteString("import \"encoding/json\"\n\n")
+	}
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(err, "error while formatting the generated source")
+	}
+	return src, nil
+}
+
+// writeStruct writes the struct of a definition
+func (g *generator) writeStruct(name string, def *definition) error {
+	props, err := def.properties(g.schema)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fmt.Fprintf(&g.buf, "// %s is the raw JSON form of a %s, as described by the schema.\n", typeName(name), name)
+	if desc := oneLine(def.Description); desc != "" {
+		fmt.Fprintf(&g.buf, "// %s\n", desc)
+	}
+	fmt.Fprintf(&g.buf, "type %s struct {\n", typeName(name))
+
+	fields := make(map[string]string, len(keys))
+	for _, key := range keys {
+		field := goName(key)
+		if other, ok := fields[field]; ok {
+			return errors.Errorf("properties %q and %q are both named %s", other, key, field)
+		}
+		fields[field] = key
+
+		typ, err := g.goType(props[key])
+		if err != nil {
+			return errors.Wrapf(err, "error in property %q", key)
+		}
+		if desc := oneLine(props[key].Description); desc != "" {
+			fmt.Fprintf(&g.buf, "\t// %s\n", desc)
+		}
+		fmt.Fprintf(&g.buf, "\t%s %s `json:%q`\n", field, typ, key)
+	}
+
+	g.buf.WriteString("}\n\n")
+	return nil
+}
+
+// goType returns the Go type of a property, as a pointer for single values
+func (g *generator) goType(def *definition) (string, error) {
+	if def.Ref != "" {
+		name, err := refName(def.Ref)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := g.schema.Definitions[name]; !ok {
+			return "", errors.Errorf("unknown definition %q", name)
+		}
+		return "*" + typeName(name), nil
+	}
+
+	switch def.Type {
+	case "string":
+		return "*string", nil
+	case "integer":
+		if def.Format == "int64" {
+			return "*int64", nil
+		}
+		return "*int", nil
+	case "number":
+		return "*float64", nil
+	case "boolean":
+		return "*bool", nil
+	case "array":
+		if def.Items == nil {
+			return "", errors.New("array without items")
+		}
+		elem, err := g.goType(def.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + strings.TrimPrefix(elem, "*"), nil
+	case "object":
+		if values := def.mapValues(); values != nil {
+			elem, err := g.goType(values)
+			if err != nil {
+				return "", err
+			}
+			return "map[string]" + strings.TrimPrefix(elem, "*"), nil
+		}
+	}
+
+	g.usesJSON = true
+	return "json.RawMessage", nil
+}
+
+// oneLine returns a description on a single line, to be written as a comment
+func oneLine(desc string) string {
+	return strings.Join(strings.Fields(desc), " ")
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// testSchema is an excerpt of the schema published by navitia.io
+const testSchema = `{
+	"swagger": "2.0",
+	"definitions": {
+		"PtObject": {
+			"properties": {
+				"id": {"type": "string", "description": "Identifier of the object"},
+				"name": {"type": "string"}
+			}
+		},
+		"Line": {
+			"description": "A public transport line",
+			"allOf": [
+				{"$ref": "#/definitions/PtObject"},
+				{"properties": {
+					"color": {"type": "string"},
+					"routes": {"type": "array", "items": {"$ref": "#/definitions/Route"}},
+					"codes": {"type": "object", "additionalProperties": {"type": "string"}},
+					"geojson": {"type": "object", "properties": {"type": {"type": "string"}}}
+				}}
+			]
+		},
+		"Route": {
+			"properties": {
+				"id": {"type": "string"},
+				"is_frequence": {"type": "boolean"},
+				"direction_type": {"type": "integer"},
+				"line": {"$ref": "#/definitions/Line"}
+			}
+		}
+	}
+}`
+
+func Test_goName(t *testing.T) {
+	t.Parallel()
+
+	for name, want := range map[string]string{
+		"id":              "ID",
+		"stop_area_id":    "StopAreaID",
+		"StopPoint":       "StopPoint",
+		"bss_stands":      "BSSStands",
+		"utc_arrival":     "UTCArrival",
+		"disable-geojson": "DisableGeojson",
+		"1st_stop":        "X1stStop",
+	} {
+		if got := goName(name); got != want {
+			t.Errorf("goName(%q): got %q, want %q", name, got, want)
+		}
+	}
+}
+
+func Test_generate(t *testing.T) {
+	t.Parallel()
+
+	s, err := parseSchema(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatalf("error in parseSchema: %v", err)
+	}
+	src, err := generate(s, "types", "schema.json")
+	if err != nil {
+		t.Fatalf("error in generate: %v", err)
+	}
+
+	// Fields are compared regardless of their alignment
+	out := strings.Join(strings.Fields(string(src)), " ")
+	for _, want := range []string{
+		"// Code generated by github.com/govitia/navitia/gen from schema.json. DO NOT EDIT.",
+		"package types",
+		`import "encoding/json"`,
+		"// rawLine is the raw JSON form of a Line, as described by the schema. // A public transport line type rawLine struct {",
+		"// Identifier of the object ID *string `json:\"id\"`",
+		"Routes []rawRoute `json:\"routes\"`",
+		"Codes map[string]string `json:\"codes\"`",
+		"Geojson json.RawMessage `json:\"geojson\"`",
+		"IsFrequence *bool `json:\"is_frequence\"`",
+		"DirectionType *int `json:\"direction_type\"`",
+		"Line *rawLine `json:\"line\"`",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected the generated source to contain %q, got:\n%s", want, src)
+		}
+	}
+
+	// Types are sorted by name
+	if strings.Index(out, "type rawLine") > strings.Index(out, "type rawRoute") {
+		t.Errorf("types aren't sorted:\n%s", out)
+	}
+}
+
+func Test_generate_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"version":       `{"swagger": "3.0", "definitions": {"Line": {}}}`,
+		"empty":         `{"swagger": "2.0", "definitions": {}}`,
+		"unknown ref":   `{"swagger": "2.0", "definitions": {"Line": {"properties": {"network": {"$ref": "#/definitions/Network"}}}}}`,
+		"external ref":  `{"swagger": "2.0", "definitions": {"Line": {"properties": {"network": {"$ref": "network.json"}}}}}`,
+		"array":         `{"swagger": "2.0", "definitions": {"Line": {"properties": {"routes": {"type": "array"}}}}}`,
+		"same name":     `{"swagger": "2.0", "definitions": {"Line": {"properties": {"line_id": {"type": "string"}, "line-id": {"type": "string"}}}}}`,
+		"unknown allOf": `{"swagger": "2.0", "definitions": {"Line": {"allOf": [{"$ref": "#/definitions/PtObject"}]}}}`,
+	}
+	for name, schema := range tests {
+		s, err := parseSchema(strings.NewReader(schema))
+		if err == nil {
+			_, err = generate(s, "types", "schema.json")
+		}
+		if err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
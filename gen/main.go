@@ -0,0 +1,93 @@
+// Command gen generates the raw JSON structs of the objects returned by the Navitia API from its Swagger 2.0 schema,
+// so that the hand-written types can be checked against, and kept in sync with, the evolutions of the API.
+//
+// The generated types are named after the definitions of the schema prefixed with "raw", such as rawStopArea,
+// and mirror the JSON as given by the API: the idiomatic types of package types are still written by hand on top of them.
+//
+// Usage:
+//
+//	go run ./gen -key $NAVITIA_API_KEY -o types/raw_gen.go
+//	go run ./gen -schema schema.json -package types -o types/raw_gen.go
+//
+// The schema is read from a local file, or fetched from a URL, by default the one published by navitia.io.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// defaultSchema is the location of the schema published by navitia.io
+const defaultSchema = "https://api.navitia.io/v1/schema"
+
+var (
+	schemaFlag  = flag.String("schema", defaultSchema, "Path or URL of the Swagger 2.0 schema")
+	keyFlag     = flag.String("key", "", "API key, to fetch the schema from navitia.io")
+	outputFlag  = flag.String("o", "", "Output file (default stdout)")
+	packageFlag = flag.String("package", "types", "Package of the generated file")
+)
+
+// open opens the schema at the given path or URL
+func open(location string, key string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(location, "http://") && !strings.HasPrefix(location, "https://") {
+		return os.Open(location)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" {
+		req.Header.Set("Authorization", key)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, errors.Errorf("unexpected status %q while fetching %s", resp.Status, location)
+	}
+	return resp.Body, nil
+}
+
+// run reads the schema and writes the generated source
+func run() error {
+	r, err := open(*schemaFlag, *keyFlag)
+	if err != nil {
+		return errors.Wrap(err, "error while opening the schema")
+	}
+	defer r.Close()
+
+	s, err := parseSchema(r)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(s, *packageFlag, *schemaFlag)
+	if err != nil {
+		return err
+	}
+
+	if *outputFlag == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(*outputFlag, src, 0644)
+}
+
+func main() {
+	flag.Parse()
+
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "gen: %v\n", err)
+		os.Exit(1)
+	}
+}
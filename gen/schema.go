@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A schema is the part of a Swagger 2.0 document describing the objects returned by the API,
+// such as the one published by navitia.io at https://api.navitia.io/v1/schema
+type schema struct {
+	Swagger     string                 `json:"swagger"`
+	Definitions map[string]*definition `json:"definitions"`
+}
+
+// A definition describes an object, or the type of one of its properties
+type definition struct {
+	Ref         string                 `json:"$ref"`
+	Type        string                 `json:"type"`
+	Format      string                 `json:"format"`
+	Description string                 `json:"description"`
+	Properties  map[string]*definition `json:"properties"`
+	Items       *definition            `json:"items"`
+	AllOf       []*definition          `json:"allOf"`
+
+	// AdditionalProperties is either a bool or a definition, only the latter gives the type of the values of a map
+	AdditionalProperties json.RawMessage `json:"additionalProperties"`
+}
+
+// refPrefix prefixes the references to other definitions of the schema
+const refPrefix = "#/definitions/"
+
+// parseSchema decodes a Swagger 2.0 schema
+func parseSchema(r io.Reader) (*schema, error) {
+	s := &schema{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, errors.Wrap(err, "error while decoding the schema")
+	}
+	if !strings.HasPrefix(s.Swagger, "2.") {
+		return nil, errors.Errorf("unsupported schema version %q, only Swagger 2.0 is supported", s.Swagger)
+	}
+	if len(s.Definitions) == 0 {
+		return nil, errors.New("the schema has no definitions")
+	}
+	return s, nil
+}
+
+// refName returns the name of the definition a reference points to, such as "Line" for "#/definitions/Line"
+func refName(ref string) (string, error) {
+	if !strings.HasPrefix(ref, refPrefix) {
+		return "", errors.Errorf("unsupported reference %q, only references to definitions are supported", ref)
+	}
+	return strings.TrimPrefix(ref, refPrefix), nil
+}
+
+// mapValues returns the definition of the values of a map, or nil if the definition isn't one of a map
+func (d *definition) mapValues() *definition {
+	if len(d.AdditionalProperties) == 0 || d.AdditionalProperties[0] != '{' {
+		return nil
+	}
+	values := &definition{}
+	if err := json.Unmarshal(d.AdditionalProperties, values); err != nil {
+		return nil
+	}
+	return values
+}
+
+// properties returns the properties of an object, including those of the objects it is composed of through allOf
+func (d *definition) properties(s *schema) (map[string]*definition, error) {
+	props := make(map[string]*definition, len(d.Properties))
+	for _, part := range d.AllOf {
+		if part.Ref != "" {
+			name, err := refName(part.Ref)
+			if err != nil {
+				return nil, err
+			}
+			ref, ok := s.Definitions[name]
+			if !ok {
+				return nil, errors.Errorf("unknown definition %q", name)
+			}
+			part = ref
+		}
+		inherited, err := part.properties(s)
+		if err != nil {
+			return nil, err
+		}
+		for key, prop := range inherited {
+			props[key] = prop
+		}
+	}
+	for key, prop := range d.Properties {
+		props[key] = prop
+	}
+	return props, nil
+}
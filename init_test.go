@@ -3,6 +3,7 @@ package navitia
 import (
 	"flag"
 	"net/http"
+	"testing"
 )
 
 const skipNoKey = "No api key supplied, skipping (provide one using -key flag)"
@@ -15,6 +16,7 @@ var (
 // Initialise testing function
 func init() {
 	// Populate flags
+	testing.Init()
 	flag.Parse()
 
 	// Create session
@@ -0,0 +1,90 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/twpayne/go-geom"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const isochronesEndpoint string = "isochrones"
+
+// IsochronesResults holds the results of an isochrones request: the zones reachable within each duration range.
+type IsochronesResults struct {
+	Isochrones []types.IsochroneZone `json:"isochrones"`
+	Paging     Paging                `json:"links"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of isochrones available in an IsochronesResults
+func (ir *IsochronesResults) Count() int {
+	return len(ir.Isochrones)
+}
+
+// IsochroneRequest contains the parameters needed to make an Isochrones request.
+// Either From or To must be set: the isochrone is computed from the starting point, or to the destination.
+type IsochroneRequest struct {
+	From types.ID `param:"from"`
+	To   types.ID `param:"to"`
+
+	// When do you want to depart (or arrive if To is set) ?
+	Date time.Time `param:"datetime"`
+
+	// Only the zones reachable in more than MinDuration and less than MaxDuration are returned
+	MinDuration time.Duration `param:"min_duration,seconds"`
+	MaxDuration time.Duration `param:"max_duration,seconds"`
+
+	// BoundaryDurations splits the result in several zones, one per duration range
+	BoundaryDurations []time.Duration `param:"boundary_duration[],seconds"`
+
+	// The traveller's type
+	Traveler types.TravelerType `param:"traveler_type"`
+
+	// Define the freshness of data to use
+	Freshness types.DataFreshness `param:"data_freshness"`
+
+	// Forbidden public transport objects
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+
+	// Boundary restricts the isochrones to a study area.
+	//
+	// As the API doesn't support it, clipping is done client-side once the response is received, see types.Isochrone.Clip.
+	// The boundary may be concave, but mustn't intersect itself.
+	Boundary *geom.Polygon
+}
+
+// toURL formats an isochrone request to url
+func (req IsochroneRequest) toURL() (url.Values, error) {
+	if err := checkEnums(req.Traveler, req.Freshness); err != nil {
+		return nil, err
+	}
+
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// isochrones is the internal function used by Isochrones functions
+func (s *Session) isochrones(ctx context.Context, url string, req IsochroneRequest) (*IsochronesResults, error) {
+	results := &IsochronesResults{session: s}
+	err := s.request(ctx, url, req, results)
+	if err != nil || req.Boundary == nil {
+		return results, err
+	}
+
+	// Clip the isochrones to the boundary
+	for i := range results.Isochrones {
+		if err := results.Isochrones[i].Geo.Clip(req.Boundary); err != nil {
+			return results, errors.Wrapf(err, "error while clipping isochrone #%d", i)
+		}
+	}
+	return results, nil
+}
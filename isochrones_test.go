@@ -0,0 +1,53 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/twpayne/go-geom"
+)
+
+func Test_IsochroneRequest_toURL(t *testing.T) {
+	t.Parallel()
+
+	req := IsochroneRequest{From: "stop_area:RAT:SA:GDLYO", MaxDuration: time.Hour, BoundaryDurations: []time.Duration{20 * time.Minute, 40 * time.Minute}}
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in IsochroneRequest.toURL: %v", err)
+	}
+
+	if got := values["boundary_duration[]"]; len(got) != 2 || got[0] != "1200" || got[1] != "2400" {
+		t.Errorf("unexpected boundary durations: %v", got)
+	}
+	if got := values.Get("max_duration"); got != "3600" {
+		t.Errorf("unexpected max duration: %s", got)
+	}
+}
+
+// Test_Isochrones_Boundary checks that the isochrones are clipped to the boundary once received
+func Test_Isochrones_Boundary(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"isochrones": [{
+			"min_duration": 0,
+			"max_duration": 3600,
+			"geojson": {"type": "MultiPolygon", "coordinates": [[[[0, 0], [4, 0], [4, 4], [0, 4], [0, 0]]]]}
+		}]}`))
+	}))
+
+	boundary := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{{1, 1}, {2, 1}, {2, 2}, {1, 2}, {1, 1}}})
+	res, err := session.Scope("sandbox").Isochrones(context.Background(), IsochroneRequest{From: "stop_area:RAT:SA:GDLYO", Boundary: boundary})
+	if err != nil {
+		t.Fatalf("error in Isochrones: %v", err)
+	}
+	if res.Count() != 1 {
+		t.Fatalf("expected 1 isochrone, got %d", res.Count())
+	}
+
+	for _, p := range res.Isochrones[0].Geo.MultiPolygon[0][0] {
+		if p[0] < 1 || p[0] > 2 || p[1] < 1 || p[1] > 2 {
+			t.Errorf("point %v lies outside of the boundary", p)
+		}
+	}
+}
@@ -1,9 +1,18 @@
 package navitia
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/govitia/navitia/types"
 	"github.com/govitia/navitia/utils"
 )
@@ -16,8 +25,71 @@ const journeysEndpoint = "journeys"
 type JourneyResults struct {
 	Journeys []types.Journey `json:"journeys"`
 	Paging   Paging          `json:"links"`
-	Logging  `json:"-"`
-	session  *Session
+
+	// Notes referenced by the journeys and their sections, they are attached to them once decoded.
+	Notes types.Notes `json:"notes"`
+
+	// Disruptions referenced by the journeys' sections
+	Disruptions []types.Disruption `json:"disruptions"`
+
+	// Tickets referenced by the journeys' fares, see JourneyResults.TicketsOf
+	Tickets []types.Ticket `json:"tickets"`
+
+	Logging `json:"-"`
+	session *Session
+
+	// remoteErr is the error given along the results, such as when no journey was found
+	remoteErr *RemoteError
+}
+
+// UnmarshalJSON implements unmarshalling for JourneyResults.
+// Once decoded, the notes are attached to the journeys & sections referencing them.
+func (jr *JourneyResults) UnmarshalJSON(b []byte) error {
+	// We define the values as pointers to the real values, allowing us to bypass copying
+	data := &struct {
+		Journeys    *[]types.Journey    `json:"journeys"`
+		Paging      *Paging             `json:"links"`
+		Notes       *types.Notes        `json:"notes"`
+		Disruptions *[]types.Disruption `json:"disruptions"`
+		Tickets     *[]types.Ticket     `json:"tickets"`
+		Error       **RemoteError       `json:"error"`
+	}{
+		Journeys:    &jr.Journeys,
+		Paging:      &jr.Paging,
+		Notes:       &jr.Notes,
+		Disruptions: &jr.Disruptions,
+		Tickets:     &jr.Tickets,
+		Error:       &jr.remoteErr,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return errors.Wrap(err, "JourneyResults.UnmarshalJSON: error while unmarshalling")
+	}
+
+	// Resolve the notes
+	for i := range jr.Journeys {
+		jr.Journeys[i].ResolveNotes(jr.Notes)
+	}
+
+	return nil
+}
+
+// TicketsOf returns the tickets needed for the given journey, as listed by its fare.
+// Tickets not given along the results are left out.
+func (jr *JourneyResults) TicketsOf(j types.Journey) []types.Ticket {
+	ids := j.Fare.TicketIDs()
+	tickets := make([]types.Ticket, 0, len(ids))
+	for _, id := range ids {
+		for _, t := range jr.Tickets {
+			if t.ID == id {
+				tickets = append(tickets, t)
+				break
+			}
+		}
+	}
+	return tickets
 }
 
 // Count returns the number of results available in a JourneyResults
@@ -25,47 +97,87 @@ func (jr *JourneyResults) Count() int {
 	return len(jr.Journeys)
 }
 
+// Next retrieves the journeys following these ones, such as for a "later departures" button, with the session the results came from.
+// The API gives the next search window as a link, so there's no need to shift the request's date by hand.
+//
+// If the API gave no such link, ErrNoPage is returned.
+func (jr *JourneyResults) Next(ctx context.Context) (*JourneyResults, error) {
+	return jr.follow(ctx, jr.Paging.Next)
+}
+
+// Previous retrieves the journeys preceding these ones, see Next.
+func (jr *JourneyResults) Previous(ctx context.Context) (*JourneyResults, error) {
+	return jr.follow(ctx, jr.Paging.Previous)
+}
+
+// follow follows a paging link of the results
+func (jr *JourneyResults) follow(ctx context.Context, link func(ctx context.Context, s *Session, res results) error) (*JourneyResults, error) {
+	if link == nil {
+		return nil, ErrNoPage
+	}
+	if jr.session == nil {
+		return nil, errors.New("can't follow a link of journey results not obtained from a session")
+	}
+
+	results := &JourneyResults{session: jr.session}
+	err := link(ctx, jr.session, results)
+	if err == nil {
+		err = results.failure()
+	}
+	return results, err
+}
+
+// failure returns the error the API gave along the results if there's no journey, such as when no solution was found.
+// The API answers these with a 200 status code.
+func (jr *JourneyResults) failure() error {
+	if jr.remoteErr == nil || len(jr.Journeys) != 0 {
+		return nil
+	}
+	jr.remoteErr.StatusCode = http.StatusOK
+	return jr.remoteErr
+}
+
 // JourneyRequest contain the parameters needed to make a Journey request
 type JourneyRequest struct {
 	// There must be at least one From or To parameter defined
 	// When used with just one of them, the resulting Journey won't have a populated Sections field.
-	From types.ID
-	To   types.ID
+	From types.ID `param:"from"`
+	To   types.ID `param:"to"`
 
 	// When do you want to depart ? Or is DateIsArrival when do you want to arrive at your destination.
-	Date          time.Time
+	Date          time.Time `param:"datetime"`
 	DateIsArrival bool
 
 	// The traveller's type
-	Traveler types.TravelerType
+	Traveler types.TravelerType `param:"traveler_type"`
 
 	// Define the freshness of data to use to compute journeys
-	Freshness types.DataFreshness
+	Freshness types.DataFreshness `param:"data_freshness"`
 
 	// Forbidden public transport objects
-	Forbidden []types.ID
+	Forbidden []types.ID `param:"forbidden_uris[]"`
 
 	// Allowed public transport objects
 	// Note: This counstraint intersects with Forbidden
-	Allowed []types.ID
+	Allowed []types.ID `param:"allowed_id[]"`
 
 	// Force the first section mode if it isn't a public transport mode
 	// Note: The parameter is inclusive, not exclusive. As such if you want to forbid a mode you have to include all modes except that one.
-	FirstSectionModes []string
+	FirstSectionModes []string `param:"first_section_mode[]"`
 
 	// Same, but for the last section
-	LastSectionModes []string
+	LastSectionModes []string `param:"last_section_mode[]"`
 
 	// MaxDurationToPT is the maximum allowed duration to reach the public transport.
 	// Use this to limit the walking/biking part.
-	MaxDurationToPT time.Duration
+	MaxDurationToPT time.Duration `param:"max_duration_to_pt,seconds"`
 
 	// These four following parameters set the speed of each mode (Walking, Bike, BSS & car)
 	// In meters per second
-	WalkingSpeed   float64
-	BikeSpeed      float64
-	BikeShareSpeed float64
-	CarSpeed       float64
+	WalkingSpeed   float64 `param:"walking_speed"`
+	BikeSpeed      float64 `param:"bike_speed"`
+	BikeShareSpeed float64 `param:"bss_speed"`
+	CarSpeed       float64 `param:"car_speed"`
 
 	// Minimum and maximum amounts of journeys suggested
 	MinJourneys uint
@@ -75,51 +187,198 @@ type JourneyRequest struct {
 	// Note: if Count=0 then it isn't taken into account
 	Count uint
 
-	// Maximum number of transfers in each journey
-	MaxTransfers uint
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// Maximum number of transfers in each journey, if set: 0 only gives direct public transport journeys
+	MaxTransfers *uint `param:"max_nb_transfers"`
 
 	// Maximum duration of a trip
-	MaxDuration time.Duration // To seconds
+	MaxDuration time.Duration `param:"max_duration,seconds"`
 
 	// Wheelchair restricts the answer to accessible public transports
-	Wheelchair bool
+	Wheelchair bool `param:"wheelchair"`
 
 	// Headsign If given, add a filter on the vehicle journeys that has the
 	// given value as headsign (on vehicle journey itself or at a stop time).
-	Headsign string
+	Headsign string `param:"headsign"`
+
+	// DirectPath tells whether journeys without public transport, such as walking all the way, are suggested.
+	// The zero value leaves the server default, DirectPathIndifferent.
+	DirectPath DirectPath `param:"direct_path"`
+
+	// FreeRadiusFrom and FreeRadiusTo are radiuses in meters around the origin and destination
+	// within which stop points are considered reached for free, without any fallback section.
+	FreeRadiusFrom uint `param:"free_radius_from"`
+	FreeRadiusTo   uint `param:"free_radius_to"`
+
+	// TimeframeDuration is the window after Date within which journeys are searched,
+	// such as for listing every journey in the next hour, along with MaxJourneys
+	TimeframeDuration time.Duration `param:"timeframe_duration,seconds"`
+
+	// Advanced holds tuning parameters, which you usually don't need to change
+	Advanced AdvancedParams `param:",inline"`
 }
 
-// toURL formats a journey request to url
-// Should be refactored using a switch statement
-func (req JourneyRequest) toURL() (url.Values, error) {
-	rb := utils.NewRequestBuilder()
+// A DirectPath tells how journeys without public transport are handled by a journey request
+type DirectPath string
 
-	// Encode the from and to
-	rb.AddString("from", string(req.From))
-	rb.AddString("to", string(req.To))
+// DirectPathXXX are the known values of DirectPath
+const (
+	DirectPathIndifferent          DirectPath = "indifferent"            // Suggest them along with the others
+	DirectPathOnly                 DirectPath = "only"                   // Only suggest them
+	DirectPathOnlyWithAlternatives DirectPath = "only_with_alternatives" // Only suggest them, along with their alternatives (such as bike instead of walking)
+	DirectPathNone                 DirectPath = "none"                   // Never suggest them
+)
+
+// AdvancedParams holds the tuning parameters of a journey request.
+// Navitia prefixes them with an underscore, as they are meant for fine-tuning and their defaults are sensible for most uses.
+// A zero value leaves the server default in place.
+type AdvancedParams struct {
+	// MinCar is the minimum duration of a car fallback (_min_car).
+	// Shorter car legs are replaced by walking, as getting in and parking a car for a very short ride isn't worth it.
+	// Raising it favours public transport over park & ride, at the risk of longer walks.
+	MinCar time.Duration `param:"_min_car,seconds"`
+
+	// MinBike is the minimum duration of a bike fallback (_min_bike).
+	// Shorter bike legs aren't proposed, so that a 30-second ride isn't suggested instead of a short walk.
+	MinBike time.Duration `param:"_min_bike,seconds"`
+
+	// MinBikeShare is the minimum duration of a bike sharing fallback (_min_bss).
+	// Renting and returning a bike takes time, so short bike sharing legs are rarely worth it.
+	MinBikeShare time.Duration `param:"_min_bss,seconds"`
+
+	// MinTaxi is the minimum duration of a taxi fallback (_min_taxi).
+	// Raising it avoids proposing a taxi for trips that are barely longer than a walk, at the risk of fewer door-to-door options.
+	MinTaxi time.Duration `param:"_min_taxi,seconds"`
+
+	// MinRidesharing is the minimum duration of a ridesharing fallback (_min_ridesharing).
+	// Ridesharing implies meeting a driver, so very short legs are impractical.
+	MinRidesharing time.Duration `param:"_min_ridesharing,seconds"`
+}
+
+// knownDirectPaths lists the known values of DirectPath
+var knownDirectPaths = map[DirectPath]bool{
+	DirectPathIndifferent:          true,
+	DirectPathOnly:                 true,
+	DirectPathOnlyWithAlternatives: true,
+	DirectPathNone:                 true,
+}
+
+// Validate checks the request against the constraints documented by navitia, so that an invalid request fails before being sent,
+// rather than with an error from the API which is often hard to relate to the culprit.
+//
+// It returns nil if the request is valid, or ValidationErrors listing every invalid field.
+// Journeys are validated before being requested, so this is only needed to check a request ahead of time, such as when it's built from user input.
+func (req JourneyRequest) Validate() error {
+	var errs ValidationErrors
+	invalid := func(field, format string, args ...interface{}) {
+		errs = append(errs, &ValidationError{Field: field, Reason: fmt.Sprintf(format, args...)})
+	}
 
-	if !req.Date.IsZero() {
-		rb.AddDateTime("datetime", req.Date)
-		if req.DateIsArrival {
-			rb.AddString("datetime_represents", "arrival")
+	// Origin, destination & date
+	if req.From == "" && req.To == "" {
+		invalid("From", "an origin or a destination is required")
+	}
+	if req.DateIsArrival && req.Date.IsZero() {
+		invalid("DateIsArrival", "the arrival date is required")
+	}
+
+	// Enums
+	if req.Traveler != "" && !req.Traveler.Known() {
+		invalid("Traveler", "unknown traveler type %q", req.Traveler)
+	}
+	if req.Freshness != "" && !req.Freshness.Known() {
+		invalid("Freshness", "unknown data freshness %q", req.Freshness)
+	}
+	if req.DirectPath != "" && !knownDirectPaths[req.DirectPath] {
+		invalid("DirectPath", "unknown direct path %q", req.DirectPath)
+	}
+
+	// Counts
+	if req.Count != 0 && (req.MinJourneys != 0 || req.MaxJourneys != 0) {
+		invalid("Count", "exclusive with MinJourneys & MaxJourneys, which would be ignored")
+	}
+	if req.MaxJourneys != 0 && req.MinJourneys > req.MaxJourneys {
+		invalid("MinJourneys", "%d is more than MaxJourneys (%d)", req.MinJourneys, req.MaxJourneys)
+	}
+
+	// Durations & speeds can't be negative
+	for _, d := range []struct {
+		field string
+		value time.Duration
+	}{
+		{"MaxDurationToPT", req.MaxDurationToPT},
+		{"MaxDuration", req.MaxDuration},
+		{"TimeframeDuration", req.TimeframeDuration},
+		{"Advanced.MinCar", req.Advanced.MinCar},
+		{"Advanced.MinBike", req.Advanced.MinBike},
+		{"Advanced.MinBikeShare", req.Advanced.MinBikeShare},
+		{"Advanced.MinTaxi", req.Advanced.MinTaxi},
+		{"Advanced.MinRidesharing", req.Advanced.MinRidesharing},
+	} {
+		if d.value < 0 {
+			invalid(d.field, "negative duration %s", d.value)
+		}
+	}
+	for _, speed := range []struct {
+		field string
+		value float64
+	}{
+		{"WalkingSpeed", req.WalkingSpeed},
+		{"BikeSpeed", req.BikeSpeed},
+		{"BikeShareSpeed", req.BikeShareSpeed},
+		{"CarSpeed", req.CarSpeed},
+	} {
+		if speed.value < 0 {
+			invalid(speed.field, "negative speed %g m/s", speed.value)
 		}
 	}
 
-	rb.AddString("traveler_type", string(req.Traveler))
-	rb.AddString("data_freshness", string(req.Freshness))
-	rb.AddIDSlice("forbidden_uris[]", req.Forbidden)
-	rb.AddIDSlice("allowed_id[]", req.Allowed)
-	rb.AddMode("first_section_mode[]", req.FirstSectionModes)
-	rb.AddMode("last_section_mode[]", req.LastSectionModes)
+	// An object can't be both forbidden & allowed
+	for _, id := range req.Allowed {
+		for _, forbidden := range req.Forbidden {
+			if id == forbidden {
+				invalid("Allowed", "%q is also forbidden", id)
+			}
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Forbid forbids the journeys going through the given public transport objects, such as lines or networks, adding them to Forbidden.
+// It returns an error, forbidding none of them, if an ID isn't one of a network, line, route, mode, stop area or stop point,
+// or if it is allowed.
+func (req *JourneyRequest) Forbid(ids ...types.ID) error {
+	return addFilters(&req.Forbidden, req.Allowed, ids)
+}
+
+// AllowOnly restricts the journeys to the given public transport objects, such as a network, adding them to Allowed.
+// It returns an error, allowing none of them, if an ID isn't one of a network, line, route, mode, stop area or stop point,
+// or if it is forbidden.
+func (req *JourneyRequest) AllowOnly(ids ...types.ID) error {
+	return addFilters(&req.Allowed, req.Forbidden, ids)
+}
+
+// toURL formats a journey request to url
+// Should be refactored using a switch statement
+func (req JourneyRequest) toURL() (url.Values, error) {
+	if err := checkEnums(req.Traveler, req.Freshness); err != nil {
+		return nil, err
+	}
 
-	// max_duration_to_pt
-	rb.AddInt("max_duration_to_pt", int(req.MaxDurationToPT/time.Second))
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
 
-	// walking_speed, bike_speed, bss_speed & car_speed
-	rb.AddFloat64("walking_speed", req.WalkingSpeed)
-	rb.AddFloat64("bike_speed", req.BikeSpeed)
-	rb.AddFloat64("bss_speed", req.BikeShareSpeed)
-	rb.AddFloat64("car_speed", req.CarSpeed)
+	if !req.Date.IsZero() && req.DateIsArrival {
+		rb.AddString("datetime_represents", "arrival")
+	}
 
 	// If count is defined don't bother with the minimimal and maximum amount of items to return
 	if req.Count != 0 {
@@ -129,19 +388,219 @@ func (req JourneyRequest) toURL() (url.Values, error) {
 		rb.AddUInt("max_nb_journeys", req.MaxJourneys)
 	}
 
-	// max_nb_transfers
-	rb.AddUInt("max_nb_transfers", req.MaxTransfers)
+	return rb.Values(), nil
+}
+
+// cacheKeyCoordsFormat is the format coordinates are normalized to in cache keys (about 10cm of precision)
+const cacheKeyCoordsFormat = "%.6f;%.6f"
 
-	// max_duration
-	rb.AddInt("max_duration", int(req.MaxDuration/time.Second))
+// canonicalPlace normalizes the precision of a place ID if it is a pair of coordinates, or returns it as-is
+func canonicalPlace(id string) string {
+	parts := strings.Split(id, ";")
+	if len(parts) != 2 {
+		return id
+	}
+	lon, errLon := strconv.ParseFloat(parts[0], 64)
+	lat, errLat := strconv.ParseFloat(parts[1], 64)
+	if errLon != nil || errLat != nil {
+		return id
+	}
+	return fmt.Sprintf(cacheKeyCoordsFormat, lon, lat)
+}
 
-	// headsign
-	rb.AddString("headsign", req.Headsign)
+// CacheKey returns a canonical key identifying the request in the given region, to be used for caching responses.
+//
+// Two logically equivalent requests yield the same key:
+// repeated parameters (forbidden & allowed objects, section modes) are deduplicated and sorted,
+// and coordinates are normalized to a fixed precision.
+func (req JourneyRequest) CacheKey(region types.ID) string {
+	// toURL only fails for invalid requests, which can't be sent and thus never need a key
+	values, _ := req.toURL()
+
+	// Sort & deduplicate the repeated parameters
+	for key, vals := range values {
+		if len(vals) < 2 {
+			continue
+		}
+		sort.Strings(vals)
+		uniq := vals[:1]
+		for _, v := range vals[1:] {
+			if v != uniq[len(uniq)-1] {
+				uniq = append(uniq, v)
+			}
+		}
+		values[key] = uniq
+	}
 
-	// wheelchair
-	if req.Wheelchair {
-		rb.AddString("wheelchair", "true")
+	// Normalize the coordinates
+	for _, key := range [...]string{"from", "to"} {
+		if v := values.Get(key); v != "" {
+			values.Set(key, canonicalPlace(v))
+		}
 	}
 
-	return rb.Values(), nil
+	// url.Values.Encode sorts by key
+	return string(region) + "/" + journeysEndpoint + "?" + values.Encode()
+}
+
+// Profiles under which ByProfile groups journeys
+const (
+	ProfileFastest         = "fastest"          // Shortest duration
+	ProfileFewestTransfers = "fewest_transfers" // Fewest transfers
+	ProfileLeastWalking    = "least_walking"    // Least time spent walking
+	ProfileMostEco         = "most_eco"         // Least CO2 emitted
+)
+
+// profileMetrics maps each profile to the metric journeys are ranked on, the lower the better.
+// ok is false if the journey doesn't give the metric.
+var profileMetrics = map[string]func(j types.Journey) (metric float64, ok bool){
+	ProfileFastest:         func(j types.Journey) (float64, bool) { return float64(j.Duration), true },
+	ProfileFewestTransfers: func(j types.Journey) (float64, bool) { return float64(j.Transfers), true },
+	ProfileLeastWalking:    func(j types.Journey) (float64, bool) { return float64(j.DurationByMode()[types.ModeWalking]), true },
+	ProfileMostEco: func(j types.Journey) (float64, bool) {
+		return j.CO2Emissions.Value, j.CO2Emissions.Unit != "" || j.CO2Emissions.Value != 0
+	},
+}
+
+// ByProfile groups the journeys by the profile they're the best at: fastest, fewest transfers, least walking & most eco.
+// See the ProfileXXX constants.
+//
+// Journeys are ranked on each profile's metric and the best one is tagged with it. If several journeys tie, they're all tagged.
+// Journeys not giving a metric, such as the CO2 emitted, aren't ranked on it, and a profile on which every ranked journey ties is left out,
+// as it doesn't tell them apart. A journey may thus appear under several profiles, or under none.
+func (jr *JourneyResults) ByProfile() map[string][]types.Journey {
+	profiles := make(map[string][]types.Journey, len(profileMetrics))
+
+	for profile, metric := range profileMetrics {
+		var (
+			ranked []types.Journey
+			values []float64
+		)
+		for _, j := range jr.Journeys {
+			if m, ok := metric(j); ok {
+				ranked, values = append(ranked, j), append(values, m)
+			}
+		}
+		if len(ranked) == 0 {
+			continue
+		}
+
+		best, tie := values[0], true
+		for _, m := range values[1:] {
+			if m != values[0] {
+				tie = false
+			}
+			if m < best {
+				best = m
+			}
+		}
+		if tie && len(ranked) > 1 {
+			continue
+		}
+
+		for i, j := range ranked {
+			if values[i] == best {
+				profiles[profile] = append(profiles[profile], j)
+			}
+		}
+	}
+
+	return profiles
+}
+
+// linkTypeLine is the type of links referencing a Line
+const linkTypeLine = "line"
+
+// ptLegs returns the ordered sequence of public transport legs of a journey, each identified by its trip,
+// or failing that by its line.
+func ptLegs(j types.Journey) []string {
+	var legs []string
+	for _, s := range j.Sections {
+		if s.Type != types.SectionPublicTransport {
+			continue
+		}
+
+		if trip, ok := s.TripID(); ok {
+			legs = append(legs, string(trip))
+			continue
+		}
+		leg := s.Display.Label
+		for _, l := range s.Links {
+			if l.Type == linkTypeLine {
+				leg = string(l.ID)
+				break
+			}
+		}
+		legs = append(legs, leg)
+	}
+	return legs
+}
+
+// sameLegs reports whether both sequences of legs are the same
+func sameLegs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// withinTolerance reports whether a and b are at most tolerance apart
+func withinTolerance(a, b time.Time, tolerance time.Duration) bool {
+	d := a.Sub(b)
+	return -tolerance <= d && d <= tolerance
+}
+
+// better reports whether journey a is better than b: arriving earlier, or else shorter, or else with fewer transfers.
+func better(a, b types.Journey) bool {
+	switch {
+	case !a.Arrival.Equal(b.Arrival):
+		return a.Arrival.Before(b.Arrival)
+	case a.Duration != b.Duration:
+		return a.Duration < b.Duration
+	default:
+		return a.Transfers < b.Transfers
+	}
+}
+
+// DedupeSimilar returns a copy of the results where journeys differing only by trivial variations, such as which side
+// of a stop you walk from, are collapsed, keeping the best of them.
+//
+// Two journeys are similar when they take the same public transport legs in the same order (the same trips, or failing that the same lines),
+// and their departures and arrivals are within tolerance of each other.
+// Journeys without public transport are never collapsed. The order of the journeys is preserved.
+func (jr *JourneyResults) DedupeSimilar(tolerance time.Duration) *JourneyResults {
+	deduped := *jr
+	deduped.Journeys = make([]types.Journey, 0, len(jr.Journeys))
+
+	// legs[i] are the public transport legs of deduped.Journeys[i]
+	var legs [][]string
+
+outer:
+	for _, j := range jr.Journeys {
+		jLegs := ptLegs(j)
+		if len(jLegs) != 0 {
+			for i, kept := range deduped.Journeys {
+				similar := sameLegs(jLegs, legs[i]) &&
+					withinTolerance(j.Departure, kept.Departure, tolerance) &&
+					withinTolerance(j.Arrival, kept.Arrival, tolerance)
+				if !similar {
+					continue
+				}
+				if better(j, kept) {
+					deduped.Journeys[i] = j
+				}
+				continue outer
+			}
+		}
+
+		deduped.Journeys = append(deduped.Journeys, j)
+		legs = append(legs, jLegs)
+	}
+
+	return &deduped
 }
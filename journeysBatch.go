@@ -0,0 +1,59 @@
+package navitia
+
+import (
+	"context"
+	"sync"
+)
+
+// A JourneyBatchResult is the outcome of one of the requests of a batch, see JourneysBatch.
+type JourneyBatchResult struct {
+	Results *JourneyResults
+	Err     error
+}
+
+// JourneysBatch computes the journeys of several requests, sending up to concurrency of them at once.
+// The results are returned in the order of the requests, each with its own error, so that one failing doesn't fail the others.
+//
+// If concurrency is zero or negative, all the requests are sent at once, still bounded by WithMaxConcurrency if set.
+// Once the context is done, the requests not yet sent fail with its error.
+func (s *Session) JourneysBatch(ctx context.Context, reqs []JourneyRequest, concurrency int) []JourneyBatchResult {
+	return journeysBatch(ctx, reqs, concurrency, s.Journeys)
+}
+
+// JourneysBatch computes the journeys of several requests in a specific scope, see Session.JourneysBatch.
+func (scope *Scope) JourneysBatch(ctx context.Context, reqs []JourneyRequest, concurrency int) []JourneyBatchResult {
+	return journeysBatch(ctx, reqs, concurrency, scope.Journeys)
+}
+
+// journeysBatch is the internal function used by JourneysBatch functions, calling journeys for each request
+func journeysBatch(ctx context.Context, reqs []JourneyRequest, concurrency int, journeys func(context.Context, JourneyRequest) (*JourneyResults, error)) []JourneyBatchResult {
+	results := make([]JourneyBatchResult, len(reqs))
+	if concurrency <= 0 || concurrency > len(reqs) {
+		concurrency = len(reqs)
+	}
+
+	// Each worker takes the next request, writing its result at its index
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(concurrency)
+	for w := 0; w < concurrency; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ctx.Err(); err != nil {
+					results[i].Err = err
+					continue
+				}
+				results[i].Results, results[i].Err = journeys(ctx, reqs[i])
+			}
+		}()
+	}
+
+	for i := range reqs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,80 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Session_JourneysBatch checks that the results are in order, with their own errors, and that the concurrency is bounded
+func Test_Session_JourneysBatch(t *testing.T) {
+	var (
+		mu             sync.Mutex
+		inFlight, peak int
+	)
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > peak {
+			peak = inFlight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		// The duration of the journey is the index of the request, the third one being unknown
+		from := r.URL.Query().Get("from")
+		if from == "2" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error": {"id": "unknown_object", "message": "unknown"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"journeys": [{"duration": ` + from + `}]}`))
+	}))
+
+	reqs := make([]JourneyRequest, 8)
+	for i := range reqs {
+		reqs[i] = JourneyRequest{From: types.ID(strconv.Itoa(i)), To: "to"}
+	}
+
+	results := session.JourneysBatch(context.Background(), reqs, 3)
+	if len(results) != len(reqs) {
+		t.Fatalf("expected %d results, got %d", len(reqs), len(results))
+	}
+	for i, res := range results {
+		if i == 2 {
+			if res.Err == nil {
+				t.Errorf("expected an error for request %d", i)
+			}
+			continue
+		}
+		if res.Err != nil {
+			t.Errorf("unexpected error for request %d: %v", i, res.Err)
+			continue
+		}
+		if len(res.Results.Journeys) != 1 || res.Results.Journeys[0].Duration != time.Duration(i)*time.Second {
+			t.Errorf("unexpected journeys for request %d: %+v", i, res.Results.Journeys)
+		}
+	}
+	if peak > 3 {
+		t.Errorf("expected at most 3 requests in flight, got %d", peak)
+	}
+
+	// Once cancelled, requests fail with the context's error
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i, res := range session.JourneysBatch(ctx, reqs, 0) {
+		if res.Err != context.Canceled {
+			t.Errorf("expected request %d to be cancelled, got %v", i, res.Err)
+		}
+	}
+}
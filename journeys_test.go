@@ -1,11 +1,18 @@
 package navitia
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/govitia/navitia/types"
+	"golang.org/x/text/currency"
 )
 
 func Test_JourneyRequest_toUrl(t *testing.T) {
@@ -21,6 +28,158 @@ func Test_JourneyRequest_toUrl(t *testing.T) {
 	}
 }
 
+// Test_JourneyRequest_toUrl_Advanced checks that the advanced parameters are serialized in seconds, and only when set
+func Test_JourneyRequest_toUrl_Advanced(t *testing.T) {
+	t.Parallel()
+
+	req := JourneyRequest{
+		Advanced: AdvancedParams{
+			MinBike: 5 * time.Minute,
+			MinCar:  90 * time.Second,
+		},
+	}
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in JourneyRequest.toURL: %v", err)
+	}
+
+	expected := url.Values{
+		"_min_bike": []string{"300"},
+		"_min_car":  []string{"90"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values: got %v, expected %v", values, expected)
+	}
+}
+
+// Test_JourneyRequest_toUrl_Full checks that every documented parameter is serialized under its query name
+func Test_JourneyRequest_toUrl_Full(t *testing.T) {
+	t.Parallel()
+
+	req := JourneyRequest{
+		From:              "2.377;48.847",
+		To:                "stop_area:OIF:SA:8768600",
+		Traveler:          types.TravelerSlowWalker,
+		Freshness:         types.DataFreshnessRealTime,
+		Forbidden:         []types.ID{"line:OIF:100110014:14OIF439"},
+		Allowed:           []types.ID{"network:OIF:439"},
+		FirstSectionModes: []string{types.ModeWalking, types.ModeBike},
+		LastSectionModes:  []string{types.ModeWalking},
+		MinJourneys:       2,
+		MaxJourneys:       5,
+		MaxTransfers:      uintPtr(1),
+		Depth:             uintPtr(2),
+		MaxDuration:       time.Hour,
+		Wheelchair:        true,
+		DirectPath:        DirectPathNone,
+		FreeRadiusFrom:    200,
+		FreeRadiusTo:      50,
+		TimeframeDuration: 30 * time.Minute,
+	}
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in JourneyRequest.toURL: %v", err)
+	}
+
+	expected := url.Values{
+		"from":                 []string{"2.377;48.847"},
+		"to":                   []string{"stop_area:OIF:SA:8768600"},
+		"traveler_type":        []string{"slow_walker"},
+		"data_freshness":       []string{"realtime"},
+		"forbidden_uris[]":     []string{"line:OIF:100110014:14OIF439"},
+		"allowed_id[]":         []string{"network:OIF:439"},
+		"first_section_mode[]": []string{"walking", "bike"},
+		"last_section_mode[]":  []string{"walking"},
+		"min_nb_journeys":      []string{"2"},
+		"max_nb_journeys":      []string{"5"},
+		"max_nb_transfers":     []string{"1"},
+		"depth":                []string{"2"},
+		"max_duration":         []string{"3600"},
+		"wheelchair":           []string{"true"},
+		"direct_path":          []string{"none"},
+		"free_radius_from":     []string{"200"},
+		"free_radius_to":       []string{"50"},
+		"timeframe_duration":   []string{"1800"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values:\n\tgot      %v\n\texpected %v", values, expected)
+	}
+}
+
+// Test_JourneyRequest_toUrl_NoTransfers checks that a maximum of 0 transfers is sent, unlike an unset maximum
+func Test_JourneyRequest_toUrl_NoTransfers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		max      *uint
+		expected []string
+	}{
+		{nil, nil},
+		{uintPtr(0), []string{"0"}},
+	} {
+		values, err := JourneyRequest{From: "2.377;48.847", MaxTransfers: tc.max}.toURL()
+		if err != nil {
+			t.Fatalf("error in JourneyRequest.toURL: %v", err)
+		}
+		if got := values["max_nb_transfers"]; !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("unexpected max_nb_transfers: got %v, expected %v", got, tc.expected)
+		}
+	}
+}
+
+// Test_JourneyRequest_toUrl_UnknownEnums checks that a misspelled traveler type or data freshness is rejected rather than sent
+func Test_JourneyRequest_toUrl_UnknownEnums(t *testing.T) {
+	t.Parallel()
+
+	for _, req := range []JourneyRequest{
+		{Traveler: "slow-walker"},
+		{Freshness: "real_time"},
+	} {
+		if _, err := req.toURL(); err == nil {
+			t.Errorf("expected an error for %+v, got none", req)
+		}
+	}
+
+	req := JourneyRequest{Traveler: types.TravelerWithLuggage, Freshness: types.DataFreshnessAdaptedSchedule}
+	if _, err := req.toURL(); err != nil {
+		t.Errorf("unexpected error for known values: %v", err)
+	}
+}
+
+// Test_JourneyRequest_CacheKey checks that equivalent requests built differently share the same cache key
+func Test_JourneyRequest_CacheKey(t *testing.T) {
+	t.Parallel()
+
+	a := JourneyRequest{
+		From:              "2.3773;48.847",
+		To:                "stop_area:RAT:SA:GDLYO",
+		Forbidden:         []types.ID{"line:RAT:M1", "line:RAT:M14"},
+		FirstSectionModes: []string{"walking", "bike"},
+		MaxTransfers:      uintPtr(2),
+	}
+	b := JourneyRequest{
+		MaxTransfers:      uintPtr(2),
+		FirstSectionModes: []string{"bike", "walking", "bike"},
+		Forbidden:         []types.ID{"line:RAT:M14", "line:RAT:M1"},
+		To:                "stop_area:RAT:SA:GDLYO",
+		From:              "2.377300;48.8470",
+	}
+
+	if ka, kb := a.CacheKey("fr-idf"), b.CacheKey("fr-idf"); ka != kb {
+		t.Errorf("equivalent requests have different cache keys:\n\t%s\n\t%s", ka, kb)
+	}
+
+	// A different request, or region, must not share the key
+	c := a
+	c.MaxTransfers = uintPtr(3)
+	if a.CacheKey("fr-idf") == c.CacheKey("fr-idf") {
+		t.Errorf("different requests share the cache key %s", a.CacheKey("fr-idf"))
+	}
+	if a.CacheKey("fr-idf") == a.CacheKey("sandbox") {
+		t.Errorf("requests in different regions share the cache key %s", a.CacheKey("fr-idf"))
+	}
+}
+
 func Test_Journeys(t *testing.T) {
 	if *apiKey == "" {
 		t.Skip(skipNoKey)
@@ -30,7 +189,7 @@ func Test_Journeys(t *testing.T) {
 
 	req := JourneyRequest{}
 	coords := types.Coordinates{Latitude: 48.847002, Longitude: 2.377310}
-	req.From = coords.ID()
+	req.From = types.ID(coords.String())
 
 	res, err := testSession.Journeys(ctx, req)
 	if err != nil {
@@ -46,8 +205,8 @@ func Test_Journeys_Paging(t *testing.T) {
 	ctx := context.Background()
 
 	params := JourneyRequest{
-		From: types.Coordinates{Latitude: 48.842716, Longitude: 2.384471}.ID(), // 110 Avenue Daumesnil (Paris)
-		To:   types.Coordinates{Latitude: 48.867305, Longitude: 2.352005}.ID(), // 10 Rue du Caire (Paris)
+		From: types.ID(types.Coordinates{Latitude: 48.842716, Longitude: 2.384471}.String()), // 110 Avenue Daumesnil (Paris)
+		To:   types.ID(types.Coordinates{Latitude: 48.867305, Longitude: 2.352005}.String()), // 10 Rue du Caire (Paris)
 	}
 
 	res, err := testSession.Journeys(ctx, params)
@@ -75,3 +234,456 @@ func Test_Journeys_Paging(t *testing.T) {
 func Test_JourneysResults_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["journeys"], reflect.TypeOf(JourneyResults{}))
 }
+
+// Test_JourneyResults_Notes checks that a note referenced by a section is resolved from the response's notes
+func Test_JourneyResults_Notes(t *testing.T) {
+	data := testData["journeys"].correct["notes.json"]
+	if len(data) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	res := &JourneyResults{}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if len(res.Journeys) != 1 {
+		t.Fatalf("expected 1 journey, got %d", len(res.Journeys))
+	}
+	j := res.Journeys[0]
+
+	const expected = "Réservation obligatoire au moins 2 heures avant le départ au 01 23 45 67 89."
+	for _, s := range j.Sections {
+		notes := s.Notes()
+		if s.ID != "section_1_0" {
+			if len(notes) != 0 {
+				t.Errorf("section %s: expected no notes, got %#v", s.ID, notes)
+			}
+			continue
+		}
+		if len(notes) != 1 || notes[0].Value != expected {
+			t.Errorf("section %s: unexpected notes %#v", s.ID, notes)
+		}
+	}
+
+	if notes := j.Notes(); len(notes) != 1 || notes[0].ID != "note:RAT:7b9c1d" {
+		t.Errorf("unexpected journey notes: %#v", notes)
+	}
+}
+
+// Test_AccessibleJourneys checks that the wheelchair parameters are sent and that only fully accessible journeys remain
+func Test_AccessibleJourneys(t *testing.T) {
+	fixture := testData["journeys"].correct["accessibility_mixed.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("wheelchair") != "true" || q.Get("traveler_type") != string(types.TravelerInWheelchair) {
+			t.Errorf("wheelchair parameters not set: %s", r.URL.RawQuery)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.AccessibleJourneys(context.Background(), JourneyRequest{From: "2.3773;48.847"})
+	if err != nil {
+		t.Fatalf("error in AccessibleJourneys: %v", err)
+	}
+	if res.Count() != 1 {
+		t.Fatalf("expected only 1 accessible journey, got %d", res.Count())
+	}
+	if res.Journeys[0].Type != types.JourneyBest {
+		t.Errorf("unexpected journey kept: %s", res.Journeys[0].Type)
+	}
+}
+
+// Test_JourneyResults_ByProfile checks that each journey is grouped under the profiles it wins
+func Test_JourneyResults_ByProfile(t *testing.T) {
+	walk := func(d time.Duration) types.Section {
+		return types.Section{Type: types.SectionStreetNetwork, Mode: types.ModeWalking, Duration: d}
+	}
+
+	jr := &JourneyResults{Journeys: []types.Journey{
+		{Type: "fast", Duration: 20 * time.Minute, Transfers: 2, Sections: []types.Section{walk(8 * time.Minute)}, CO2Emissions: types.CO2Emissions{Value: 30}},
+		{Type: "direct", Duration: 30 * time.Minute, Transfers: 0, Sections: []types.Section{walk(12 * time.Minute)}, CO2Emissions: types.CO2Emissions{Value: 40}},
+		{Type: "comfy", Duration: 35 * time.Minute, Transfers: 1, Sections: []types.Section{walk(2 * time.Minute)}, CO2Emissions: types.CO2Emissions{Value: 20}},
+		{Type: "green", Duration: 25 * time.Minute, Transfers: 1, Sections: []types.Section{walk(2 * time.Minute)}, CO2Emissions: types.CO2Emissions{Value: 10}},
+	}}
+
+	expected := map[string][]types.JourneyQualification{
+		ProfileFastest:         {"fast"},
+		ProfileFewestTransfers: {"direct"},
+		ProfileLeastWalking:    {"comfy", "green"}, // Tie
+		ProfileMostEco:         {"green"},
+	}
+
+	got := make(map[string][]types.JourneyQualification)
+	for profile, journeys := range jr.ByProfile() {
+		for _, j := range journeys {
+			got[profile] = append(got[profile], j.Type)
+		}
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected grouping:\n\tgot %v\n\texpected %v", got, expected)
+	}
+}
+
+// Test_JourneyResults_ByProfile_MissingMetric checks that profiles whose metric is missing, or tied for every journey, are left out
+func Test_JourneyResults_ByProfile_MissingMetric(t *testing.T) {
+	// No co2_emission, and the same number of transfers & walking
+	const body = `{"journeys": [
+		{"type": "fast", "duration": 1200, "nb_transfers": 1, "sections": []},
+		{"type": "slow", "duration": 1800, "nb_transfers": 1, "sections": []}
+	]}`
+
+	jr := &JourneyResults{}
+	if err := json.Unmarshal([]byte(body), jr); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	profiles := jr.ByProfile()
+	for _, profile := range []string{ProfileFewestTransfers, ProfileLeastWalking, ProfileMostEco} {
+		if journeys, ok := profiles[profile]; ok {
+			t.Errorf("expected no journeys tagged %s, got %d", profile, len(journeys))
+		}
+	}
+	if fastest := profiles[ProfileFastest]; len(fastest) != 1 || fastest[0].Type != "fast" {
+		t.Errorf("unexpected fastest journeys: %v", fastest)
+	}
+}
+
+// Test_JourneysFromHere checks that journeys are requested from the position in its region, and that a position outside of any region is reported as such
+func Test_JourneysFromHere(t *testing.T) {
+	fixture := testData["journeys"].correct["a.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	var (
+		paris = types.Coordinates{Latitude: 48.847002, Longitude: 2.377310}
+		ocean = types.Coordinates{Latitude: 45.5, Longitude: -30.25}
+	)
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/" + paris.String():
+			_, _ = w.Write([]byte(`{"regions": [{"id": "fr-idf"}]}`))
+		case "/coverage/fr-idf/journeys":
+			if from := r.URL.Query().Get("from"); from != paris.String() {
+				t.Errorf("expected journeys from %s, got %s", paris.ID(), from)
+			}
+			_, _ = w.Write(fixture)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "No region available for the coordinates"}`))
+		}
+	}))
+
+	t.Run("inside", func(t *testing.T) {
+		res, err := session.JourneysFromHere(context.Background(), paris, "stop_area:OIF:SA:59238", JourneyRequest{})
+		if err != nil {
+			t.Fatalf("error in JourneysFromHere: %v", err)
+		}
+		if res.Count() == 0 {
+			t.Error("expected journeys, got none")
+		}
+	})
+
+	t.Run("ocean", func(t *testing.T) {
+		_, err := session.JourneysFromHere(context.Background(), ocean, "stop_area:OIF:SA:59238", JourneyRequest{})
+		if err != ErrOutsideCoverage {
+			t.Errorf("expected ErrOutsideCoverage, got %v", err)
+		}
+	})
+}
+
+// Test_Scope_JourneysFrom checks that journeys from an object are requested under the object's path,
+// and that the origin can't be given twice.
+func Test_Scope_JourneysFrom(t *testing.T) {
+	fixture := testData["journeys"].correct["a.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	var paths []string
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if from := r.URL.Query().Get("from"); from != "" {
+			t.Errorf("expected no from parameter, got %q", from)
+		}
+		_, _ = w.Write(fixture)
+	}))
+	scope := session.Scope("fr-idf")
+
+	for _, from := range []types.ID{"stop_area:OIF:SA:59238", "2.377;48.847"} {
+		res, err := scope.JourneysFrom(context.Background(), from, JourneyRequest{To: "stop_area:OIF:SA:8768600"})
+		if err != nil {
+			t.Fatalf("error in JourneysFrom(%s): %v", from, err)
+		}
+		if res.Count() == 0 {
+			t.Errorf("expected journeys from %s, got none", from)
+		}
+	}
+	expected := []string{
+		"/coverage/fr-idf/stop_areas/stop_area:OIF:SA:59238/journeys",
+		"/coverage/fr-idf/coords/2.377;48.847/journeys",
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("unexpected paths:\n\tgot %v\n\texpected %v", paths, expected)
+	}
+
+	if _, err := scope.JourneysFrom(context.Background(), "stop_area:OIF:SA:59238", JourneyRequest{From: "2.377;48.847"}); err == nil {
+		t.Error("expected an error when req.From is also set")
+	}
+	if _, err := scope.JourneysFrom(context.Background(), "59238", JourneyRequest{}); err == nil {
+		t.Error("expected an error for an ID without a type")
+	}
+	if len(paths) != 2 {
+		t.Errorf("expected invalid requests not to be sent, got %d requests", len(paths))
+	}
+}
+
+// Test_JourneyResults_DedupeSimilar checks that two journeys differing only by their first walk are collapsed, keeping the best one
+func Test_JourneyResults_DedupeSimilar(t *testing.T) {
+	fixture := testData["journeys"].correct["near_duplicates.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	jr := &JourneyResults{}
+	if err := json.Unmarshal(fixture, jr); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if jr.Count() != 3 {
+		t.Fatalf("expected 3 journeys in the fixture, got %d", jr.Count())
+	}
+
+	deduped := jr.DedupeSimilar(2 * time.Minute)
+	if deduped.Count() != 2 {
+		t.Fatalf("expected the near-duplicates to collapse into 2 journeys, got %d", deduped.Count())
+	}
+	if deduped.Journeys[0].Type != types.JourneyBest {
+		t.Errorf("expected the shorter duplicate to be kept, got %q", deduped.Journeys[0].Type)
+	}
+	if jr.Count() != 3 {
+		t.Errorf("expected the original results to be left untouched, got %d journeys", jr.Count())
+	}
+
+	// With a tolerance tighter than the variation, nothing is collapsed
+	if n := jr.DedupeSimilar(time.Minute).Count(); n != 3 {
+		t.Errorf("expected no journey collapsed with a 1 minute tolerance, got %d journeys", n)
+	}
+}
+
+// largeJourneyResults builds a response holding n journeys, by repeating the journeys of a fixture
+func largeJourneyResults(tb testing.TB, n int) []byte {
+	tb.Helper()
+
+	fixture := testData["journeys"].correct["a.json"]
+	if len(fixture) == 0 {
+		tb.Skip("no data provided, skipping...")
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(fixture, &doc); err != nil {
+		tb.Fatalf("error while unmarshalling fixture: %v", err)
+	}
+	var journeys []json.RawMessage
+	if err := json.Unmarshal(doc["journeys"], &journeys); err != nil {
+		tb.Fatalf("error while unmarshalling fixture journeys: %v", err)
+	}
+
+	large := make([]json.RawMessage, n)
+	for i := range large {
+		large[i] = journeys[i%len(journeys)]
+	}
+	raw, err := json.Marshal(large)
+	if err != nil {
+		tb.Fatalf("error while marshalling journeys: %v", err)
+	}
+	doc["journeys"] = raw
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		tb.Fatalf("error while marshalling response: %v", err)
+	}
+	return out
+}
+
+// BenchmarkJourneyResults_UnmarshalJSON benchmarks the decoding of a 50-journey response, as done when receiving it
+func BenchmarkJourneyResults_UnmarshalJSON(b *testing.B) {
+	data := largeJourneyResults(b, 50)
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		jr := &JourneyResults{}
+		if err := decodeResults(bytes.NewReader(data), jr, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Test_JourneyResults_Next checks that the next & previous search windows are followed through the "next" and "prev" links
+func Test_JourneyResults_Next(t *testing.T) {
+	var session *Session
+	session = newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/journeys"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+
+		// The journey departs at the requested time, and links to the windows before & after it
+		datetime := r.URL.Query().Get("datetime")
+		var links string
+		switch datetime {
+		case "20170407T090000":
+			links = fmt.Sprintf(`{"type": "next", "href": "%[1]s/journeys?datetime=20170407T091000", "templated": false},
+				{"type": "prev", "href": "%[1]s/journeys?datetime=20170407T085000", "templated": false}`, session.APIURL)
+		case "20170407T091000":
+			links = fmt.Sprintf(`{"type": "prev", "href": "%s/journeys?datetime=20170407T090000", "templated": false}`, session.APIURL)
+		}
+		fmt.Fprintf(w, `{"journeys": [{"departure_date_time": "%s", "type": "best"}], "links": [%s]}`, datetime, links)
+	}))
+
+	ctx := context.Background()
+	res, err := session.Journeys(ctx, JourneyRequest{From: "2.377;48.847", Date: time.Date(2017, time.April, 7, 9, 0, 0, 0, time.UTC)})
+	if err != nil {
+		t.Fatalf("error in Journeys: %v", err)
+	}
+
+	next, err := res.Next(ctx)
+	if err != nil {
+		t.Fatalf("error while getting the next journeys: %v", err)
+	}
+	if next.Count() != 1 || next.Journeys[0].Departure.Hour() != 9 || next.Journeys[0].Departure.Minute() != 10 {
+		t.Errorf("unexpected next journeys: %+v", next.Journeys)
+	}
+	if _, err := next.Next(ctx); err != ErrNoPage {
+		t.Errorf("expected ErrNoPage after the last window, got %v", err)
+	}
+
+	prev, err := next.Previous(ctx)
+	if err != nil {
+		t.Fatalf("error while getting the previous journeys: %v", err)
+	}
+	if prev.Count() != 1 || !prev.Journeys[0].Departure.Equal(res.Journeys[0].Departure) {
+		t.Errorf("expected to be back to the first journeys, got %+v", prev.Journeys)
+	}
+}
+
+// Test_JourneyResults_Tickets checks that the fare of a journey and the tickets given along the results are decoded and linked
+func Test_JourneyResults_Tickets(t *testing.T) {
+	data := testData["journeys"].correct["tickets.json"]
+	if len(data) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	res := &JourneyResults{}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if len(res.Journeys) != 1 || len(res.Tickets) != 2 {
+		t.Fatalf("expected 1 journey & 2 tickets, got %d & %d", len(res.Journeys), len(res.Tickets))
+	}
+	j := res.Journeys[0]
+
+	// The total is given in centimes
+	if !j.Fare.Found || j.Fare.Total.Currency() != currency.EUR || fmt.Sprint(currency.Symbol(j.Fare.Total)) != "€ 1.90" {
+		t.Errorf("unexpected fare: found %t, total %v", j.Fare.Found, currency.Symbol(j.Fare.Total))
+	}
+
+	tickets := res.TicketsOf(j)
+	if len(tickets) != 1 {
+		t.Fatalf("expected 1 ticket for the journey, got %d", len(tickets))
+	}
+	ticket := tickets[0]
+	if ticket.ID != "ticket:RAT:tplus" || ticket.Name != "Ticket t+" || !ticket.Found || ticket.Cost.Currency() != currency.EUR {
+		t.Errorf("unexpected ticket: %#v", ticket)
+	}
+	if ids := ticket.SectionIDs(); !reflect.DeepEqual(ids, []types.ID{"section_1_0", "section_4_0"}) {
+		t.Errorf("unexpected sections covered by the ticket: %v", ids)
+	}
+
+	if unknown := res.Tickets[1]; unknown.Found || unknown.Cost != (currency.Amount{}) {
+		t.Errorf("expected no cost for a ticket without a price, got %#v", unknown)
+	}
+}
+
+// Test_JourneyRequest_Forbid checks that forbidden & allowed objects are serialized, and that incompatible IDs are rejected
+func Test_JourneyRequest_Forbid(t *testing.T) {
+	t.Parallel()
+
+	req := JourneyRequest{}
+	if err := req.Forbid("line:RAT:M1", "network:SNCF"); err != nil {
+		t.Fatalf("error in Forbid: %v", err)
+	}
+	if err := req.AllowOnly("network:RAT"); err != nil {
+		t.Fatalf("error in AllowOnly: %v", err)
+	}
+
+	for _, err := range []error{
+		req.Forbid("poi:osm:way:85413372"),
+		req.Forbid("line:RAT:M14", "2.377;48.847"),
+		req.AllowOnly("line:RAT:M1"),
+	} {
+		if err == nil {
+			t.Error("expected an error")
+		}
+	}
+
+	values, err := req.toURL()
+	if err != nil {
+		t.Fatalf("error in JourneyRequest.toURL: %v", err)
+	}
+	if got := values["forbidden_uris[]"]; !reflect.DeepEqual(got, []string{"line:RAT:M1", "network:SNCF"}) {
+		t.Errorf("unexpected forbidden_uris[]: %v", got)
+	}
+	if got := values["allowed_id[]"]; !reflect.DeepEqual(got, []string{"network:RAT"}) {
+		t.Errorf("unexpected allowed_id[]: %v", got)
+	}
+}
+
+// Test_JourneyRequest_Validate checks that every invalid parameter is reported, and that invalid requests aren't sent
+func Test_JourneyRequest_Validate(t *testing.T) {
+	valid := JourneyRequest{From: "stop_area:RAT:SA:NATIO", Count: 3, DirectPath: DirectPathNone}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("unexpected error for a valid request: %v", err)
+	}
+
+	req := JourneyRequest{
+		DateIsArrival: true,
+		Traveler:      "slow-walker",
+		DirectPath:    "never",
+		Count:         3,
+		MinJourneys:   5,
+		MaxJourneys:   2,
+		MaxDuration:   -time.Minute,
+		WalkingSpeed:  -1,
+		Forbidden:     []types.ID{"network:RAT"},
+		Allowed:       []types.ID{"network:RAT"},
+	}
+	err := req.Validate()
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T: %v", err, err)
+	}
+	var fields []string
+	for _, e := range errs {
+		fields = append(fields, e.Field)
+	}
+	expected := []string{"From", "DateIsArrival", "Traveler", "DirectPath", "Count", "MinJourneys", "MaxDuration", "WalkingSpeed", "Allowed"}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("unexpected invalid fields:\n\tgot      %v\n\texpected %v", fields, expected)
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s", r.URL)
+	}))
+	if _, err := session.Scope("fr-idf").Journeys(context.Background(), req); err == nil {
+		t.Error("expected an error for an invalid request")
+	}
+	if _, err := session.Scope("fr-idf").JourneysFrom(context.Background(), "stop_area:RAT:SA:NATIO", JourneyRequest{MinJourneys: 3, MaxJourneys: 1}); err == nil {
+		t.Error("expected an error for an invalid request")
+	}
+}
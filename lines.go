@@ -0,0 +1,137 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const linesEndpoint string = "lines"
+
+// LinesResults holds the results of a lines request.
+//
+// The disruptions of each line are resolved once the results are received, see types.Line.Disruptions.
+type LinesResults struct {
+	Lines       []types.Line       `json:"lines"`
+	Disruptions []types.Disruption `json:"disruptions"`
+	Paging      Paging             `json:"links"`
+	Pagination  Pagination         `json:"pagination"`
+	Logging     `json:"-"`
+	session     *Session
+}
+
+// Count returns the number of lines available in a LinesResults
+func (lr *LinesResults) Count() int {
+	return len(lr.Lines)
+}
+
+// TotalAvailable returns the total number of lines available across all pages.
+func (lr *LinesResults) TotalAvailable() int {
+	return lr.Pagination.total(len(lr.Lines))
+}
+
+func (lr *LinesResults) paging() Paging {
+	return lr.Paging
+}
+
+func (lr *LinesResults) newPage() pagedResults {
+	return &LinesResults{session: lr.session}
+}
+
+func (lr *LinesResults) merge(page pagedResults) {
+	next := page.(*LinesResults)
+	lr.Lines = append(lr.Lines, next.Lines...)
+	lr.Disruptions = append(lr.Disruptions, next.Disruptions...)
+	lr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the lines, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (lr *LinesResults) ForEach(ctx context.Context, f func(line types.Line) error) error {
+	return walkPages(ctx, lr.session, lr, func(page pagedResults) error {
+		lines := page.(*LinesResults)
+		for i := range lines.Lines {
+			lines.Lines[i].ResolveDisruptions(lines.Disruptions)
+		}
+		for _, line := range lines.Lines {
+			if err := f(line); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// LinesRequest contains the optional parameters for a Lines request.
+type LinesRequest struct {
+	// Maximum amount of lines
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, such as the routes of each line, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// DisableGeoJSON skips the shape of the lines, which can be heavy
+	DisableGeoJSON bool `param:"disable_geojson"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+
+	// AllPages fetches every page and merges them into the returned results, up to MaxPages pages (100 if zero).
+	// See CollectionRequest.AllPages.
+	AllPages bool
+	MaxPages uint
+}
+
+func (req LinesRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// lines is the internal function used by Lines functions
+func (s *Session) lines(ctx context.Context, url string, req LinesRequest) (*LinesResults, error) {
+	results := &LinesResults{session: s}
+	err := s.request(ctx, url, req, results)
+	if err == nil && req.AllPages {
+		err = mergePages(ctx, s, results, req.MaxPages)
+	}
+	if err != nil {
+		return results, err
+	}
+
+	for i := range results.Lines {
+		results.Lines[i].ResolveDisruptions(results.Disruptions)
+	}
+	return results, nil
+}
+
+// Lines lists the lines of the region, with their colors, modes, network, opening hours and shape.
+func (scope *Scope) Lines(ctx context.Context, req LinesRequest) (*LinesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + linesEndpoint
+
+	return scope.session.lines(ctx, reqURL, req)
+}
+
+// Line retrieves a single line by its ID.
+//
+// If the line doesn't exist, a *RemoteError with a 404 status code is returned.
+func (scope *Scope) Line(ctx context.Context, id types.ID, req LinesRequest) (*types.Line, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + linesEndpoint + "/" + string(id)
+
+	results, err := scope.session.lines(ctx, reqURL, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if results.Count() == 0 {
+		return nil, notFound("line", id)
+	}
+	return &results.Lines[0], nil
+}
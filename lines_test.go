@@ -0,0 +1,79 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// Test_LinesResults_Unmarshal tests unmarshalling for LinesResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_LinesResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["lines"], reflect.TypeOf(LinesResults{}))
+}
+
+// Test_Scope_Lines checks the request parameters, and that the lines are decoded with their shape and disruptions
+func Test_Scope_Lines(t *testing.T) {
+	fixture := testData["lines"].correct["metro.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/lines"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if got := r.URL.Query().Get("count"); got != "2" {
+			t.Errorf("unexpected count: %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").Lines(context.Background(), LinesRequest{Count: 2})
+	if err != nil {
+		t.Fatalf("error in Lines: %v", err)
+	}
+
+	if res.Count() != 2 || res.TotalAvailable() != 16 {
+		t.Fatalf("expected 2 lines out of 16, got %d out of %d", res.Count(), res.TotalAvailable())
+	}
+	m14, m6 := res.Lines[0], res.Lines[1]
+	if m14.Code != "14" || m14.Network.Name != "RATP" || m14.Geo == nil {
+		t.Errorf("unexpected line: %s (code %q, network %q, shape %v)", m14.ID, m14.Code, m14.Network.Name, m14.Geo)
+	}
+	if len(m14.Disruptions()) != 1 || len(m6.Disruptions()) != 0 {
+		t.Errorf("expected only line 14 to be disrupted, got %d and %d disruptions", len(m14.Disruptions()), len(m6.Disruptions()))
+	}
+}
+
+// Test_Scope_Line checks that a single line is retrieved by its ID, and that an unknown one gives a 404 RemoteError
+func Test_Scope_Line(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coverage/fr-idf/lines/line:RAT:M14" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "ptref : Filters: Unable to find object"}`))
+			return
+		}
+		if got := r.URL.Query().Get("disable_geojson"); got != "true" {
+			t.Errorf("unexpected disable_geojson: %q", got)
+		}
+		_, _ = w.Write([]byte(`{"lines": [{"id": "line:RAT:M14", "name": "Saint-Lazare - Olympiades", "code": "14"}]}`))
+	}))
+
+	l, err := session.Scope("fr-idf").Line(context.Background(), "line:RAT:M14", LinesRequest{DisableGeoJSON: true})
+	if err != nil {
+		t.Fatalf("error in Line: %v", err)
+	}
+	if l.Code != "14" || l.Geo != nil {
+		t.Errorf("unexpected line: %+v", l)
+	}
+
+	_, err = session.Scope("fr-idf").Line(context.Background(), "line:unknown", LinesRequest{})
+	if remote, ok := err.(*RemoteError); !ok || remote.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a 404 *RemoteError, got %v", err)
+	}
+}
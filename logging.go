@@ -1,12 +1,22 @@
 package navitia
 
-import "time"
+import (
+	"net/http"
+	"time"
+)
 
 // Logging stores logging info
 type Logging struct {
 	Created  time.Time
 	Sent     time.Time
 	Received time.Time
+
+	// rateLimit is the rate limiting state given along the response, if any, see RateLimit
+	rateLimit    RateLimit
+	hasRateLimit bool
+
+	// raw is the body of the response, if kept, see Raw
+	raw []byte
 }
 
 // creating stores creation time
@@ -23,3 +33,26 @@ func (l *Logging) sending() {
 func (l *Logging) parsing() {
 	l.Received = time.Now()
 }
+
+// rateLimited stores the rate limiting state given in the headers of the response
+func (l *Logging) rateLimited(h http.Header) {
+	l.rateLimit, l.hasRateLimit = parseRateLimit(h, time.Now())
+}
+
+// RateLimit returns the state of the API's rate limiting when the results were received, such as the amount of requests left.
+// If the response didn't report it, ok is false.
+func (l *Logging) RateLimit() (rl RateLimit, ok bool) {
+	return l.rateLimit, l.hasRateLimit
+}
+
+// keepRaw stores the body of the response, which it copies
+func (l *Logging) keepRaw(body []byte) {
+	l.raw = append([]byte(nil), body...)
+}
+
+// Raw returns the JSON body of the response the results were decoded from, such as for archiving it or decoding it with another schema.
+// It is only kept if the Session was created with the WithRawResponse option, and is nil otherwise.
+// For results merging several pages, it is the body of the first one.
+func (l *Logging) Raw() []byte {
+	return l.raw
+}
@@ -0,0 +1,43 @@
+package navitia
+
+import "net/http"
+
+// A RoundTripFunc sends a request and returns its response, as a Doer does.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// A Middleware wraps the sending of the Session's requests, such as for logging, tracing, adding headers or collecting metrics.
+// It is given the next step of the chain, which it must call to actually send the request.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use adds middlewares to the Session, which wrap every request it sends from then on.
+//
+// Middlewares are called in the order they are added: the first one added is the outermost, and the last one calls the HTTP client.
+// They wrap the sending of the request only, once it is fully built & authenticated, and not the decoding of its response.
+func (s *Session) Use(middlewares ...Middleware) {
+	s.middlewaresMu.Lock()
+	defer s.middlewaresMu.Unlock()
+	s.middlewares = append(s.middlewares, middlewares...)
+	s.chain = nil
+}
+
+// roundTrip returns the function sending a request through the middlewares & the HTTP client.
+// The chain is built once, rather than for every request.
+func (s *Session) roundTrip() RoundTripFunc {
+	s.middlewaresMu.RLock()
+	rt := s.chain
+	s.middlewaresMu.RUnlock()
+	if rt != nil {
+		return rt
+	}
+
+	s.middlewaresMu.Lock()
+	defer s.middlewaresMu.Unlock()
+	if s.chain == nil {
+		rt := RoundTripFunc(s.client.Do)
+		for i := len(s.middlewares) - 1; i >= 0; i-- {
+			rt = s.middlewares[i](rt)
+		}
+		s.chain = rt
+	}
+	return s.chain
+}
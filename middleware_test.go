@@ -0,0 +1,47 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// Test_Session_Use checks that middlewares wrap requests in the order they're added, and may alter them
+func Test_Session_Use(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := r.Header.Get("X-Request-Id"); id != "42" {
+			t.Errorf("expected the header added by the middleware, got %q", id)
+		}
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}))
+
+	var calls []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+" before")
+				resp, err := next(req)
+				calls = append(calls, name+" after")
+				return resp, err
+			}
+		}
+	}
+	setHeader := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.Header.Set("X-Request-Id", "42")
+			return next(req)
+		}
+	}
+	session.Use(trace("outer"), setHeader)
+	session.Use(trace("inner"))
+
+	if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+		t.Fatalf("error in Regions: %v", err)
+	}
+
+	expected := []string{"outer before", "inner before", "inner after", "outer after"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("unexpected calls: got %v, expected %v", calls, expected)
+	}
+}
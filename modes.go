@@ -0,0 +1,125 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+const (
+	commercialModesEndpoint string = "commercial_modes"
+	physicalModesEndpoint   string = "physical_modes"
+)
+
+// CommercialModesResults holds the results of a commercial modes request.
+type CommercialModesResults struct {
+	CommercialModes []types.CommercialMode `json:"commercial_modes"`
+	Paging          Paging                 `json:"links"`
+	Pagination      Pagination             `json:"pagination"`
+	Logging         `json:"-"`
+	session         *Session
+}
+
+// Count returns the number of commercial modes available in a CommercialModesResults
+func (cmr *CommercialModesResults) Count() int {
+	return len(cmr.CommercialModes)
+}
+
+// TotalAvailable returns the total number of commercial modes available across all pages.
+func (cmr *CommercialModesResults) TotalAvailable() int {
+	return cmr.Pagination.total(len(cmr.CommercialModes))
+}
+
+func (cmr *CommercialModesResults) paging() Paging {
+	return cmr.Paging
+}
+
+func (cmr *CommercialModesResults) newPage() pagedResults {
+	return &CommercialModesResults{session: cmr.session}
+}
+
+func (cmr *CommercialModesResults) merge(page pagedResults) {
+	next := page.(*CommercialModesResults)
+	cmr.CommercialModes = append(cmr.CommercialModes, next.CommercialModes...)
+	cmr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the commercial modes, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (cmr *CommercialModesResults) ForEach(ctx context.Context, f func(mode types.CommercialMode) error) error {
+	return walkPages(ctx, cmr.session, cmr, func(page pagedResults) error {
+		for _, mode := range page.(*CommercialModesResults).CommercialModes {
+			if err := f(mode); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// PhysicalModesResults holds the results of a physical modes request.
+type PhysicalModesResults struct {
+	PhysicalModes []types.PhysicalMode `json:"physical_modes"`
+	Paging        Paging               `json:"links"`
+	Pagination    Pagination           `json:"pagination"`
+	Logging       `json:"-"`
+	session       *Session
+}
+
+// Count returns the number of physical modes available in a PhysicalModesResults
+func (pmr *PhysicalModesResults) Count() int {
+	return len(pmr.PhysicalModes)
+}
+
+// TotalAvailable returns the total number of physical modes available across all pages.
+func (pmr *PhysicalModesResults) TotalAvailable() int {
+	return pmr.Pagination.total(len(pmr.PhysicalModes))
+}
+
+func (pmr *PhysicalModesResults) paging() Paging {
+	return pmr.Paging
+}
+
+func (pmr *PhysicalModesResults) newPage() pagedResults {
+	return &PhysicalModesResults{session: pmr.session}
+}
+
+func (pmr *PhysicalModesResults) merge(page pagedResults) {
+	next := page.(*PhysicalModesResults)
+	pmr.PhysicalModes = append(pmr.PhysicalModes, next.PhysicalModes...)
+	pmr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the physical modes, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (pmr *PhysicalModesResults) ForEach(ctx context.Context, f func(mode types.PhysicalMode) error) error {
+	return walkPages(ctx, pmr.session, pmr, func(page pagedResults) error {
+		for _, mode := range page.(*PhysicalModesResults).PhysicalModes {
+			if err := f(mode); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CommercialModes lists the commercial modes of the region, such as "RER" or "Noctilien".
+// They aren't normalised across regions, see PhysicalModes for that.
+func (scope *Scope) CommercialModes(ctx context.Context, req CollectionRequest) (*CommercialModesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + commercialModesEndpoint
+
+	results := &CommercialModesResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// PhysicalModes lists the physical modes available in the region, among the normalised types.PhysicalModeXXX.
+func (scope *Scope) PhysicalModes(ctx context.Context, req CollectionRequest) (*PhysicalModesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + physicalModesEndpoint
+
+	results := &PhysicalModesResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
@@ -0,0 +1,47 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_Modes checks that the commercial and physical modes of a region are listed from their own endpoints
+func Test_Scope_Modes(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/coverage/fr-idf/commercial_modes":
+			_, _ = w.Write([]byte(`{"commercial_modes": [
+				{"id": "commercial_mode:Metro", "name": "Métro", "physical_modes": [{"id": "physical_mode:Metro", "name": "Métro"}]},
+				{"id": "commercial_mode:RER", "name": "RER", "physical_modes": [{"id": "physical_mode:RapidTransit", "name": "Train de banlieue / RER"}]}
+			]}`))
+		case "/coverage/fr-idf/physical_modes":
+			_, _ = w.Write([]byte(`{"physical_modes": [
+				{"id": "physical_mode:Metro", "name": "Métro"},
+				{"id": "physical_mode:RapidTransit", "name": "Train de banlieue / RER"},
+				{"id": "physical_mode:Bus", "name": "Bus"}
+			]}`))
+		default:
+			t.Errorf("unexpected path: %s", r.URL.Path)
+		}
+	}))
+	scope := session.Scope("fr-idf")
+
+	cm, err := scope.CommercialModes(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in CommercialModes: %v", err)
+	}
+	if cm.Count() != 2 || cm.CommercialModes[1].PhysicalModes[0].ID != types.PhysicalModeRapidTransit {
+		t.Errorf("unexpected commercial modes: %+v", cm.CommercialModes)
+	}
+
+	pm, err := scope.PhysicalModes(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in PhysicalModes: %v", err)
+	}
+	if pm.Count() != 3 || pm.PhysicalModes[2].ID != types.PhysicalModeBus {
+		t.Errorf("unexpected physical modes: %+v", pm.PhysicalModes)
+	}
+}
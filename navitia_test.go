@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"testing"
 
 	"github.com/pkg/errors"
 )
@@ -16,6 +17,7 @@ var (
 )
 
 func init() {
+	testing.Init()
 	flag.Parse()
 	// If the given path is absolute, then use it as-is
 	if filepath.IsAbs(*testDataPathFlag) {
@@ -56,7 +58,25 @@ var typesList = []string{
 	"journeys",
 	"coverage",
 	"places",
+	"places_nearby",
 	"connections",
+	"codes",
+	"route_schedules",
+	"disruptions",
+	"datasets",
+	"departures",
+	"arrivals",
+	"stop_schedules",
+	"lines",
+	"pois",
+	"pt_objects",
+	"routes",
+	"traffic_reports",
+	"vehicle_journeys",
+	"calendars",
+	"equipment_reports",
+	"free_floatings",
+	"access_points",
 }
 
 // listCategoryDirs retrieves the subdirectories under the main testdata directory
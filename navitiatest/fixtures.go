@@ -0,0 +1,37 @@
+// Code generated by mkfixtures.go. DO NOT EDIT.
+
+package navitiatest
+
+// fixtures are the canned responses of the Server, by endpoint
+var fixtures = map[string]string{
+	"access_points":        "{\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":2},\"links\":[],\"access_points\":[{\"id\":\"access_point:OIF:AP:59:ENTREE_VAUGIRARD\",\"name\":\"Entrée Vaugirard\",\"coord\":{\"lat\":\"48.841512\",\"lon\":\"2.321203\"},\"is_entrance\":true,\"is_exit\":false,\"length\":85,\"traversal_time\":160,\"pathway_mode\":4,\"signposted_as\":\"Grandes lignes\"},{\"id\":\"access_point:OIF:AP:59:SORTIE_MAINE\",\"name\":\"Sortie Avenue du Maine\",\"coord\":{\"lat\":\"48.842121\",\"lon\":\"2.319821\"},\"is_entrance\":true,\"is_exit\":true,\"length\":140,\"traversal_time\":150,\"pathway_mode\":1,\"stair_count\":24}]}",
+	"arrivals":             "{\"arrivals\":[{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6751vn10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T170800\",\"base_arrival_date_time\":\"20170427T170800\",\"base_departure_date_time\":\"20170427T170800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T170800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6750w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T173800\",\"base_arrival_date_time\":\"20170427T173800\",\"base_departure_date_time\":\"20170427T173800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T173800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6754vn10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T180800\",\"base_arrival_date_time\":\"20170427T180800\",\"base_departure_date_time\":\"20170427T180800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T180800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6752vn10-132-e16-129I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T183800\",\"base_arrival_date_time\":\"20170427T183800\",\"base_departure_date_time\":\"20170427T183800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T183800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6755w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T193800\",\"base_arrival_date_time\":\"20170427T193800\",\"base_departure_date_time\":\"20170427T193800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T193800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6756vc10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T203800\",\"base_arrival_date_time\":\"20170427T203800\",\"base_departure_date_time\":\"20170427T203800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T203800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6757uo10-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T213800\",\"base_arrival_date_time\":\"20170427T213800\",\"base_departure_date_time\":\"20170427T213800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T213800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6759vc10-132-e16-129I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T223800\",\"base_arrival_date_time\":\"20170427T223800\",\"base_departure_date_time\":\"20170427T223800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T223800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6760w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T233800\",\"base_arrival_date_time\":\"20170427T233800\",\"base_departure_date_time\":\"20170427T233800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T233800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus Éireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6761vc10-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170428T001800\",\"base_arrival_date_time\":\"20170428T001800\",\"base_departure_date_time\":\"20170428T001800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170428T001800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}}],\"disruptions\":[],\"exceptions\":[],\"feed_publishers\":[{\"id\":\"ie\",\"license\":\"CC\",\"name\":\"Transport For Ireland\",\"url\":\"http://www.transportforireland.ie/transitData/PT_Data.html\"},{\"id\":\"OEA\",\"license\":\"CC\",\"name\":\"OEA - Bus Eireann\",\"url\":\"http://www.transportforireland.ie/transitData/PT_Data.html\"}],\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_points/{stop_point.id}\",\"rel\":\"stop_points\",\"templated\":true,\"type\":\"stop_point\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/commercial_modes/{commercial_modes.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_areas/{stop_area.id}\",\"rel\":\"stop_areas\",\"templated\":true,\"type\":\"stop_area\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/physical_modes/{physical_modes.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/routes/{route.id}\",\"rel\":\"routes\",\"templated\":true,\"type\":\"route\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/commercial_modes/{commercial_mode.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/vehicle_journeys/{vehicle_journey.id}\",\"rel\":\"vehicle_journeys\",\"templated\":true,\"type\":\"vehicle_journey\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/lines/{line.id}\",\"rel\":\"lines\",\"templated\":true,\"type\":\"line\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/physical_modes/{physical_mode.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/networks/{network.id}\",\"rel\":\"networks\",\"templated\":true,\"type\":\"network\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_areas/stop_area:OEA:SA:CTP8360B337651/departures?from_datetime=20170427T170408\",\"templated\":false,\"type\":\"first\"}],\"notes\":[],\"pagination\":{\"items_on_page\":10,\"items_per_page\":10,\"start_page\":0,\"total_result\":10}}",
+	"calendars":            "{\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":2},\"links\":[],\"calendars\":[{\"id\":\"Semaine\",\"name\":\"Semaine\",\"week_pattern\":{\"monday\":true,\"tuesday\":true,\"wednesday\":true,\"thursday\":true,\"friday\":true,\"saturday\":false,\"sunday\":false},\"active_periods\":[{\"begin\":\"20170102\",\"end\":\"20170708\"}],\"exceptions\":[{\"type\":\"remove\",\"datetime\":\"20170501\"},{\"type\":\"add\",\"datetime\":\"20170520\"}]},{\"id\":\"Dimanche\",\"name\":\"Dimanche et fêtes\",\"week_pattern\":{\"monday\":false,\"tuesday\":false,\"wednesday\":false,\"thursday\":false,\"friday\":false,\"saturday\":false,\"sunday\":true},\"active_periods\":[{\"begin\":\"20170101\",\"end\":\"20171231\"}],\"exceptions\":[]}]}",
+	"commercial_modes":     "{\"commercial_modes\":[{\"id\":\"commercial_mode:Metro\",\"name\":\"Métro\",\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}]}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"companies":            "{\"companies\":[{\"id\":\"company:RAT:1\",\"name\":\"RATP\"}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"contributors":         "{\"contributors\":[{\"id\":\"RAT\",\"name\":\"RATP\",\"license\":\"ODbL\",\"website\":\"https://data.ratp.fr\"}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"coords":               "{\"address\":{\"id\":\"2.373;48.845\",\"name\":\"20 Boulevard Diderot\",\"label\":\"20 Boulevard Diderot (Paris)\",\"house_number\":20,\"coord\":{\"lat\":\"48.845\",\"lon\":\"2.373\"}}}",
+	"coverage":             "{\"regions\":[{\"status\":\"running\",\"dataset_created_at\":\"20170331T101434\",\"name\":\"France - Ile-de-France\",\"start_production_date\":\"20170321\",\"shape\":\"MULTIPOLYGON(((2.666348 48.11921,2.663667 48.11918,2.644174 48.13556,2.63979 48.13712,2.622058 48.13503,2.603191 48.13028,2.575233 48.1301,2.569823 48.13869,2.560079 48.14034,2.537719 48.13883,2.524682 48.12847,2.52129 48.12374,2.513913 48.12537,2.490027 48.12537,2.476544 48.12806,2.468211 48.1261,2.463322 48.127,2.45416 48.12122,2.448706 48.12351,2.440921 48.12383,2.443041 48.13225,2.459211 48.13824,2.463981 48.14627,2.470109 48.15115,2.470883 48.15542,2.47734 48.15828,2.482004 48.16529,2.504793 48.15876,2.512056 48.16388,2.513196 48.16653,2.510952 48.17062,2.511463 48.17406,2.505975 48.18016,2.512095 48.18658,2.511849 48.19391,2.517593 48.19609,2.518299 48.20546,2.512883 48.21398,2.512021 48.22268,2.505428 48.22543,2.506145 48.23365,2.503945 48.23705,2.483149 48.23801,2.475196 48.24375,2.475065 48.24953,2.470776 48.25238,2.450606 48.24865,2.445587 48.25284,2.440641 48.25203,2.429981 48.25414,2.422163 48.25981,2.416475 48.27208,2.416362 48.27889,2.421171 48.28854,2.421142 48.29402,2.418417 48.29952,2.402505 48.31443,2.389282 48.31056,2.369458 48.30757,2.364803 48.30938,2.357582 48.30716,2.352183 48.31287,2.341015 48.31513,2.335234 48.32505,2.327537 48.32535,2.321085 48.32978,2.311084 48.32638,2.301952 48.31398,2.300851 48.31012,2.296248 48.30678,2.282482 48.31115,2.26814 48.31141,2.254459 48.29926,2.245017 48.29699,2.243535 48.30137,2.245505 48.31282,2.236873 48.31606,2.240597 48.32287,2.239957 48.32572,2.227561 48.32822,2.223131 48.33419,2.215036 48.33315,2.209212 48.3395,2.206619 48.33991,2.19057 48.3307,2.186394 48.32454,2.185326 48.31715,2.181479 48.31047,2.173123 48.3133,2.160948 48.31059,2.160329 48.30794,2.164909 48.29945,2.164293 48.29721,2.135427 48.29789,2.109973 48.29585,2.110581 48.30388,2.106356 48.30636,2.092233 48.29799,2.089509 48.29348,2.055712 48.29343,2.052486 48.28923,2.041157 48.28346,2.027521 48.28768,2.020212 48.285,2.007103 48.28354,1.972062 48.2873,1.970116 48.29279,1.964009 48.29508,1.957824 48.30787,1.973868 48.31865,1.973109 48.3236,1.977739 48.3296,1.967212 48.34148,1.973004 48.3472,1.972002 48.35652,1.980266 48.36122,1.981075 48.36525,1.976513 48.36837,1.978483 48.37279,1.977002 48.37754,1.965337 48.38056,1.971761 48.39025,1.970684 48.40003,1.952412 48.40491,1.932162 48.40219,1.927261 48.40554,1.927461 48.40919,1.924636 48.41272,1.92615 48.41671,1.936164 48.42511,1.935685 48.43817,1.931896 48.44157,1.929802 48.44857,1.925789 48.45065,1.921468 48.44685,1.914251 48.44622,1.903855 48.43731,1.8796 48.43954,1.870468 48.4386,1.856233 48.44496,1.844074 48.44516,1.842644 48.44977,1.838565 48.45171,1.837531 48.45862,1.83154 48.46578,1.82007 48.46427,1.800793 48.46487,1.79902 48.4733,1.791155 48.47746,1.79082 48.48534,1.784251 48.48973,1.786337 48.49685,1.782015 48.49949,1.775081 48.51073,1.774541 48.51457,1.776364 48.51865,1.774133 48.52746,1.782062 48.54441,1.78152 48.54984,1.767587 48.55768,1.76171 48.56399,1.760058 48.57084,1.756441 48.5732,1.747509 48.57413,1.743792 48.57126,1.737336 48.57113,1.731018 48.57335,1.727238 48.57141,1.708578 48.57702,1.707112 48.58108,1.700895 48.58541,1.71589 48.60441,1.714439 48.61195,1.711285 48.61273,1.703182 48.61047,1.693024 48.61246,1.686829 48.60999,1.678253 48.61564,1.67379 48.61245,1.66608 48.61239,1.654232 48.62164,1.654969 48.62804,1.648311 48.63161,1.646629 48.63795,1.63633 48.64655,1.62023 48.6488,1.60201 48.662,1.599994 48.66873,1.602928 48.67145,1.603108 48.67567,1.607453 48.68302,1.606952 48.68692,1.59574 48.69231,1.590052 48.69179,1.58151 48.69515,1.579941 48.7,1.575395 48.70309,1.584602 48.70668,1.586493 48.71164,1.596177 48.7221,1.600849 48.72302,1.614878 48.737,1.619342 48.73846,1.62274 48.74677,1.620399 48.74995,1.606268 48.75855,1.580719 48.76159,1.58032 48.76595,1.583636 48.77256,1.575521 48.78273,1.574152 48.79085,1.576605 48.79515,1.5759 48.80537,1.579278 48.81126,1.586689 48.81407,1.588473 48.81814,1.577654 48.8299,1.588654 48.83474,1.590092 48.83956,1.586424 48.84208,1.576478 48.84394,1.576707 48.84857,1.58032 48.85568,1.578697 48.85913,1.56762 48.86465,1.562997 48.86527,1.559131 48.86258,1.555534 48.86335,1.54913 48.86821,1.544501 48.86949,1.545748 48.87524,1.558561 48.88448,1.559248 48.88831,1.537563 48.90457,1.537064 48.90905,1.540476 48.91348,1.536939 48.92082,1.520183 48.92391,1.514263 48.92068,1.506387 48.92669,1.507186 48.93573,1.500231 48.94078,1.502786 48.94838,1.49391 48.95614,1.494277 48.96069,1.490625 48.96441,1.498273 48.97259,1.497627 48.97667,1.485095 48.97841,1.470642 48.97373,1.465005 48.98213,1.460087 48.98532,1.460528 48.98981,1.465757 48.99076,1.472781 48.9984,1.476894 49.00008,1.478337 49.00526,1.472747 49.00916,1.474432 49.01394,1.473032 49.01655,1.456843 49.0254,1.456067 49.03434,1.445097 49.04596,1.44708 49.04946,1.446426 49.05437,1.45444 49.05824,1.461895 49.06515,1.480395 49.05337,1.486157 49.05381,1.496163 49.0595,1.502539 49.06058,1.508106 49.06693,1.509691 49.07411,1.504388 49.08152,1.505424 49.0855,1.507809 49.08664,1.517846 49.08099,1.519567 49.0746,1.523334 49.07086,1.540849 49.07505,1.559025 49.07095,1.56509 49.07308,1.573035 49.07955,1.605239 49.08633,1.610685 49.08217,1.614461 49.08291,1.617315 49.08605,1.616201 49.0953,1.6204 49.10155,1.624695 49.10385,1.629033 49.11136,1.642341 49.11873,1.645578 49.12475,1.652129 49.13052,1.650232 49.13877,1.651914 49.14534,1.659397 49.15326,1.65958 49.15811,1.667036 49.16999,1.664913 49.17744,1.668123 49.18231,1.669554 49.19409,1.674471 49.20082,1.670984 49.20606,1.672862 49.21239,1.687452 49.21997,1.690603 49.22446,1.699773 49.22839,1.709643 49.24271,1.713539 49.24203,1.715833 49.23477,1.74146 49.22488,1.741258 49.22276,1.736938 49.2201,1.734639 49.21025,1.726465 49.20807,1.724292 49.20435,1.728171 49.19698,1.737986 49.19574,1.74093 49.19266,1.746331 49.17997,1.753936 49.17642,1.776452 49.18619,1.784857 49.18704,1.790281 49.18348,1.795183 49.18646,1.802803 49.1864,1.806216 49.1817,1.81644 49.17761,1.82683 49.18088,1.835587 49.17798,1.841212 49.17115,1.85182 49.17218,1.856346 49.17455,1.862189 49.17217,1.876612 49.17625,1.878801 49.1712,1.886561 49.16595,1.931819 49.17616,1.937742 49.172,1.950622 49.17184,1.961014 49.17542,1.973564 49.18509,1.982729 49.18276,1.990982 49.17836,2.001026 49.17713,2.02129 49.19003,2.032106 49.18999,2.04452 49.19692,2.046844 49.19984,2.056102 49.20044,2.069031 49.2047,2.074291 49.20993,2.080916 49.21192,2.083781 49.20967,2.091934 49.20936,2.094255 49.19482,2.099022 49.19125,2.109078 49.19215,2.11553 49.18816,2.12126 49.18992,2.127978 49.19482,2.135934 49.19221,2.138946 49.18734,2.147575 49.18927,2.150964 49.18537,2.164926 49.18079,2.166023 49.1782,2.16161 49.1725,2.165967 49.16692,2.172796 49.16995,2.175531 49.17716,2.188431 49.17452,2.198932 49.17653,2.203966 49.17551,2.219474 49.18164,2.225291 49.17472,2.23652 49.16742,2.235456 49.16533,2.224008 49.15896,2.224008 49.15458,2.228753 49.15289,2.253446 49.15435,2.261767 49.15955,2.268191 49.15766,2.281722 49.16048,2.287958 49.17161,2.293653 49.17398,2.297748 49.17844,2.300392 49.18495,2.311379 49.18796,2.322303 49.18542,2.324442 49.18088,2.358467 49.15277,2.363206 49.1541,2.370837 49.16051,2.375372 49.16049,2.381295 49.15906,2.391673 49.15102,2.41305 49.15371,2.419092 49.15084,2.440133 49.1476,2.442062 49.14592,2.440188 49.13924,2.443 49.13664,2.457866 49.14234,2.462557 49.14069,2.465821 49.13762,2.472062 49.13651,2.480075 49.12888,2.500214 49.12285,2.504139 49.11774,2.495597 49.11087,2.496067 49.10794,2.499396 49.106,2.511063 49.10588,2.528069 49.10238,2.530514 49.10551,2.532454 49.12057,2.541927 49.12365,2.547484 49.12242,2.554226 49.12573,2.558137 49.12431,2.555136 49.11264,2.561395 49.09974,2.566555 49.09622,2.571846 49.0962,2.579468 49.09287,2.582322 49.08458,2.584993 49.08212,2.58961 49.0812,2.596752 49.08326,2.606634 49.09062,2.609368 49.09598,2.616207 49.09529,2.620429 49.09678,2.623364 49.1015,2.634241 49.10975,2.637394 49.10803,2.638336 49.10392,2.642352 49.10149,2.650743 49.1021,2.677933 49.08947,2.683693 49.08328,2.696064 49.07563,2.693694 49.07034,2.697019 49.06627,2.706258 49.06705,2.717251 49.07408,2.719643 49.08055,2.724374 49.08186,2.72634 49.07781,2.730806 49.07574,2.734489 49.06438,2.738264 49.06216,2.753758 49.06226,2.764695 49.06564,2.77546 49.07251,2.776766 49.07496,2.774938 49.07856,2.767864 49.08223,2.768077 49.08445,2.779934 49.08431,2.783171 49.0898,2.809195 49.09875,2.823073 49.0874,2.833429 49.08433,2.845716 49.08593,2.858333 49.07199,2.869533 49.072,2.887398 49.08069,2.895149 49.07973,2.901096 49.08673,2.922978 49.07959,2.933419 49.08282,2.940734 49.07976,2.94535 49.08947,2.95522 49.08716,2.967964 49.09282,2.972521 49.08939,2.976217 49.07694,2.982751 49.07319,2.986269 49.07547,2.990406 49.08496,2.995722 49.08873,3.000713 49.09066,3.005344 49.09026,3.008095 49.09277,3.015944 49.09248,3.027646 49.08816,3.032729 49.09019,3.05231 49.08751,3.05585 49.09119,3.054162 49.09686,3.055155 49.10274,3.060878 49.10699,3.068661 49.11852,3.071879 49.11906,3.080717 49.11429,3.095357 49.11304,3.103435 49.10929,3.12036 49.11055,3.126931 49.10773,3.137438 49.1089,3.149558 49.10195,3.166196 49.10058,3.158835 49.08546,3.159114 49.08266,3.16728 49.07882,3.177056 49.07122,3.183024 49.06309,3.183272 49.05526,3.190441 49.05385,3.191983 49.05092,3.191165 49.04564,3.184736 49.04254,3.178096 49.02934,3.174569 49.02737,3.169786 49.02748,3.166693 49.02486,3.170615 49.01501,3.172855 49.01369,3.180946 49.01426,3.190857 49.01001,3.198828 49.00988,3.202467 49.00435,3.208578 49.00103,3.211707 48.99505,3.224908 48.99264,3.230058 48.98963,3.230516 48.98367,3.23341 48.97965,3.244642 48.97782,3.252072 48.97422,3.260857 48.95231,3.264035 48.94965,3.263162 48.9445,3.266423 48.94005,3.271383 48.93915,3.283743 48.94173,3.30485 48.94995,3.308353 48.94629,3.31372 48.93466,3.315048 48.92223,3.331656 48.91116,3.33614 48.91198,3.346584 48.91832,3.350196 48.91924,3.354528 48.9176,3.35957 48.91942,3.365649 48.92477,3.366291 48.92919,3.368317 48.92947,3.373025 48.92485,3.373128 48.91871,3.377474 48.91005,3.376999 48.90703,3.372179 48.90393,3.371111 48.8961,3.372868 48.8939,3.385079 48.88916,3.382506 48.87738,3.384648 48.87389,3.389358 48.87315,3.393 48.87673,3.397063 48.87739,3.40633 48.87724,3.407259 48.86794,3.409333 48.86601,3.423937 48.86876,3.431352 48.86197,3.446338 48.86169,3.453106 48.85698,3.451547 48.8466,3.453902 48.84349,3.46421 48.84268,3.46979 48.85235,3.486306 48.85228,3.492506 48.83531,3.486799 48.82499,3.487807 48.81416,3.480461 48.81103,3.469461 48.81922,3.463433 48.81714,3.4605 48.81404,3.444021 48.80927,3.442177 48.7991,3.444754 48.79031,3.442748 48.78136,3.437802 48.78174,3.428817 48.77815,3.421901 48.78281,3.410916 48.78113,3.39887 48.76259,3.398523 48.75829,3.409287 48.75396,3.414616 48.75599,3.421793 48.75572,3.429002 48.7596,3.437502 48.75399,3.438666 48.74304,3.443333 48.73865,3.467889 48.7398,3.470757 48.7385,3.467981 48.72843,3.469351 48.71728,3.467068 48.71137,3.468436 48.70599,3.477705 48.70027,3.478931 48.69735,3.4744 48.69472,3.474595 48.68868,3.472374 48.68477,3.464279 48.68463,3.455317 48.68111,3.45138 48.67841,3.446354 48.67034,3.447666 48.66212,3.461687 48.65358,3.457416 48.64171,3.458982 48.63766,3.473279 48.63802,3.484268 48.64276,3.488528 48.64753,3.492284 48.64876,3.516011 48.64497,3.533838 48.64773,3.534713 48.64595,3.528485 48.63908,3.529741 48.63526,3.545658 48.62975,3.560409 48.61758,3.558249 48.61493,3.543985 48.61474,3.535033 48.60896,3.511261 48.60497,3.509833 48.60007,3.514284 48.59537,3.517041 48.58888,3.494175 48.58835,3.486655 48.57994,3.474512 48.57571,3.470802 48.57277,3.469761 48.56893,3.475038 48.56526,3.47919 48.553,3.484797 48.55086,3.487269 48.54767,3.487136 48.54433,3.48254 48.54047,3.474235 48.53939,3.456732 48.52826,3.43891 48.5268,3.421624 48.53191,3.413473 48.53098,3.411449 48.52926,3.411646 48.5254,3.424763 48.51513,3.427752 48.5082,3.436446 48.49685,3.434729 48.48913,3.42214 48.48924,3.409939 48.48434,3.403714 48.48468,3.396042 48.48161,3.391129 48.47724,3.392433 48.47286,3.400556 48.469,3.401048 48.46146,3.408664 48.44952,3.406026 48.43884,3.397721 48.43199,3.396764 48.42786,3.39968 48.42551,3.411903 48.42278,3.4154 48.4176,3.423556 48.4165,3.423767 48.41271,3.415646 48.38941,3.401902 48.38843,3.386551 48.39675,3.378891 48.39746,3.374339 48.39253,3.369571 48.39245,3.367149 48.3903,3.364174 48.38139,3.366886 48.37625,3.36555 48.37111,3.356441 48.36977,3.351629 48.37353,3.338687 48.37157,3.335334 48.36899,3.316984 48.37509,3.314114 48.37493,3.310571 48.37164,3.305561 48.37165,3.296644 48.37574,3.289555 48.3746,3.284841 48.37705,3.270129 48.37546,3.264021 48.37112,3.262381 48.36728,3.254209 48.36381,3.228535 48.36891,3.221307 48.36849,3.200069 48.36242,3.195617 48.36701,3.190999 48.36886,3.184293 48.36691,3.180118 48.37344,3.174041 48.37574,3.16704 48.36956,3.152554 48.36922,3.146674 48.36436,3.137585 48.36968,3.128386 48.36599,3.121023 48.36649,3.114986 48.36313,3.107911 48.35134,3.103285 48.34809,3.098771 48.35035,3.095905 48.35636,3.086438 48.35612,3.079369 48.35992,3.062605 48.35594,3.050789 48.35862,3.045382 48.35431,3.040321 48.34472,3.039139 48.33751,3.044179 48.33299,3.044299 48.32984,3.031206 48.32092,3.020016 48.30824,3.022085 48.30478,3.028706 48.30315,3.028338 48.30018,3.023884 48.29464,3.03086 48.28609,3.027774 48.28141,3.028395 48.27672,3.043409 48.27347,3.046584 48.27135,3.047341 48.26704,3.045247 48.26182,3.048511 48.24924,3.042877 48.24803,3.036413 48.24943,3.031907 48.24743,3.028565 48.24099,3.022961 48.23627,3.024134 48.22978,3.012375 48.21952,3.009699 48.21196,3.005061 48.20596,2.99229 48.20331,2.986428 48.20681,2.976878 48.20431,2.974419 48.20135,2.974722 48.19638,2.971594 48.19317,2.953719 48.19029,2.937666 48.18062,2.935769 48.17404,2.937133 48.16239,2.868225 48.15498,2.854657 48.14238,2.842509 48.1375,2.83707 48.13305,2.824555 48.13173,2.820185 48.12851,2.807917 48.12901,2.799602 48.13131,2.795597 48.14196,2.797624 48.14695,2.797162 48.15241,2.803441 48.15878,2.80225 48.16246,2.797352 48.16575,2.791986 48.16329,2.77968 48.16597,2.771807 48.16047,2.763804 48.16299,2.756445 48.15926,2.754156 48.1562,2.757204 48.14833,2.756282 48.14486,2.722739 48.13615,2.706018 48.122,2.696474 48.12394,2.692394 48.12262,2.675343 48.12387,2.666348 48.11921)))\",\"end_production_date\":\"20170416\",\"error\":null,\"last_load_at\":\"20170410T184808\",\"id\":\"fr-idf\"}],\"links\":[{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/addresses\",\"type\":\"addresses\",\"rel\":\"addresses\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/contributors\",\"type\":\"contributors\",\"rel\":\"contributors\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/companies\",\"type\":\"companies\",\"rel\":\"companies\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/connections\",\"type\":\"connections\",\"rel\":\"connections\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/vehicle_journeys\",\"type\":\"vehicle_journeys\",\"rel\":\"vehicle_journeys\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/networks\",\"type\":\"networks\",\"rel\":\"networks\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/commercial_modes\",\"type\":\"commercial_modes\",\"rel\":\"commercial_modes\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/physical_modes\",\"type\":\"physical_modes\",\"rel\":\"physical_modes\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/disruptions\",\"type\":\"disruptions\",\"rel\":\"disruptions\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/pois\",\"type\":\"pois\",\"rel\":\"pois\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/stop_points\",\"type\":\"stop_points\",\"rel\":\"stop_points\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/poi_types\",\"type\":\"poi_types\",\"rel\":\"poi_types\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/datasets\",\"type\":\"datasets\",\"rel\":\"datasets\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/journey_pattern_points\",\"type\":\"journey_pattern_points\",\"rel\":\"journey_pattern_points\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/lines\",\"type\":\"lines\",\"rel\":\"lines\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/coord\",\"type\":\"coord\",\"rel\":\"coord\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/stop_areas\",\"type\":\"stop_areas\",\"rel\":\"stop_areas\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/coords\",\"type\":\"coords\",\"rel\":\"coords\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/journey_patterns\",\"type\":\"journey_patterns\",\"rel\":\"journey_patterns\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/routes\",\"type\":\"routes\",\"rel\":\"routes\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/trips\",\"type\":\"trips\",\"rel\":\"trips\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/line_groups\",\"type\":\"line_groups\",\"rel\":\"line_groups\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/places\",\"type\":\"places\",\"rel\":\"places\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/journeys\",\"type\":\"journeys\",\"rel\":\"journeys\",\"templated\":true},{\"href\":\"https:\\/\\/api.navitia.io\\/v1\\/coverage\\/fr-idf\\/\",\"type\":\"coverage\",\"rel\":\"coverage\",\"templated\":true}]}",
+	"datasets":             "{\"datasets\":[{\"id\":\"fr-idf-OIF-2017-04-10\",\"description\":\"Offre théorique IDF\",\"system\":\"ntfs\",\"realtime_level\":\"base_schedule\",\"start_validation_date\":\"20170410T000000\",\"end_validation_date\":\"20170709T000000\",\"contributor\":{\"id\":\"fr-idf:OIF\",\"name\":\"STIF\",\"license\":\"ODbL\",\"website\":\"https://opendata.stif.info\"}},{\"id\":\"fr-idf-OIF-realtime\",\"description\":\"Perturbations temps réel IDF\",\"system\":\"gtfs-rt\",\"realtime_level\":\"realtime\",\"start_validation_date\":\"20170410T000000\",\"end_validation_date\":\"20170709T000000\",\"contributor\":{\"id\":\"fr-idf:OIF\",\"name\":\"STIF\",\"license\":\"ODbL\",\"website\":\"https://opendata.stif.info\"}}],\"links\":[],\"pagination\":{\"items_on_page\":2,\"items_per_page\":25,\"start_page\":0,\"total_result\":2}}",
+	"departures":           "{\"departures\":[{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6751vn10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T170800\",\"base_arrival_date_time\":\"20170427T170800\",\"base_departure_date_time\":\"20170427T170800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T170800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6750w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T173800\",\"base_arrival_date_time\":\"20170427T173800\",\"base_departure_date_time\":\"20170427T173800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T173800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6754vn10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T180800\",\"base_arrival_date_time\":\"20170427T180800\",\"base_departure_date_time\":\"20170427T180800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T180800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6752vn10-132-e16-129I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T183800\",\"base_arrival_date_time\":\"20170427T183800\",\"base_departure_date_time\":\"20170427T183800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T183800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6755w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T193800\",\"base_arrival_date_time\":\"20170427T193800\",\"base_departure_date_time\":\"20170427T193800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T193800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6756vc10-132-e16-123I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T203800\",\"base_arrival_date_time\":\"20170427T203800\",\"base_departure_date_time\":\"20170427T203800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T203800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6757uo10-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T213800\",\"base_arrival_date_time\":\"20170427T213800\",\"base_departure_date_time\":\"20170427T213800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T213800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6759vc10-132-e16-129I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T223800\",\"base_arrival_date_time\":\"20170427T223800\",\"base_departure_date_time\":\"20170427T223800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T223800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6760w310-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170427T233800\",\"base_arrival_date_time\":\"20170427T233800\",\"base_departure_date_time\":\"20170427T233800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170427T233800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}},{\"display_informations\":{\"code\":\"343\",\"color\":\"000000\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Glentworth, Limerick Bus Station\",\"equipments\":[],\"headsign\":\"Ennis Bus Station - Limer\",\"label\":\"343\",\"links\":[],\"network\":\"Bus \\u00c9ireann\",\"physical_mode\":\"Bus\",\"text_color\":\"FFFFFF\"},\"links\":[{\"id\":\"line:OEA:10-132-e16-1\",\"type\":\"line\"},{\"id\":\"vehicle_journey:OEA:6761vc10-132-e16-124I-1\",\"type\":\"vehicle_journey\"},{\"id\":\"route:OEA:10-132-e16-1_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:Bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:OEA:01\",\"type\":\"network\"}],\"route\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1_R\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"name\":\"Glentworth, Limerick Bus Station\",\"quality\":0,\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8400B6350301\"},{\"type\":\"source\",\"value\":\"CTP8400B6350301\"}],\"coord\":{\"lat\":\"52.658542\",\"lon\":\"-8.624509\"},\"id\":\"stop_area:OEA:SA:CTP8400B6350301\",\"label\":\"Glentworth, Limerick Bus Station\",\"links\":[],\"name\":\"Glentworth, Limerick Bus Station\",\"timezone\":\"Europe/Dublin\"}},\"direction_type\":\"\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"route:OEA:10-132-e16-1_R\",\"is_frequence\":\"False\",\"line\":{\"closing_time\":\"232000\",\"code\":\"343\",\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA10-132-e16-1\"},{\"type\":\"source\",\"value\":\"10-132-e16-1\"}],\"color\":\"000000\",\"geojson\":{\"coordinates\":[],\"type\":\"MultiLineString\"},\"id\":\"line:OEA:10-132-e16-1\",\"links\":[],\"name\":\"\",\"opening_time\":\"050500\",\"text_color\":\"FFFFFF\"},\"links\":[],\"name\":\"Ennis Bus Station - Glentworth, Limerick Bus Station\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}]},\"stop_date_time\":{\"additional_informations\":[],\"arrival_date_time\":\"20170428T001800\",\"base_arrival_date_time\":\"20170428T001800\",\"base_departure_date_time\":\"20170428T001800\",\"data_freshness\":\"base_schedule\",\"departure_date_time\":\"20170428T001800\",\"links\":[]},\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEA8360B337651\"},{\"type\":\"source\",\"value\":\"8360B337651\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:Bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"equipments\":[],\"id\":\"stop_point:OEA:SP:8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"52.702267\",\"lon\":\"-8.927898000000001\"},\"id\":\"admin:osm:6800554\",\"insee\":\"\",\"label\":\"Clenagh\",\"level\":9,\"name\":\"Clenagh\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OEACTP8360B337651\"},{\"type\":\"source\",\"value\":\"CTP8360B337651\"}],\"coord\":{\"lat\":\"52.705837\",\"lon\":\"-8.877245\"},\"id\":\"stop_area:OEA:SA:CTP8360B337651\",\"label\":\"Shannon (Town Hall)\",\"links\":[],\"name\":\"Shannon (Town Hall)\",\"timezone\":\"Europe/Dublin\"}}}],\"disruptions\":[],\"exceptions\":[],\"feed_publishers\":[{\"id\":\"ie\",\"license\":\"CC\",\"name\":\"Transport For Ireland\",\"url\":\"http://www.transportforireland.ie/transitData/PT_Data.html\"},{\"id\":\"OEA\",\"license\":\"CC\",\"name\":\"OEA - Bus Eireann\",\"url\":\"http://www.transportforireland.ie/transitData/PT_Data.html\"}],\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_points/{stop_point.id}\",\"rel\":\"stop_points\",\"templated\":true,\"type\":\"stop_point\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/commercial_modes/{commercial_modes.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_areas/{stop_area.id}\",\"rel\":\"stop_areas\",\"templated\":true,\"type\":\"stop_area\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/physical_modes/{physical_modes.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/routes/{route.id}\",\"rel\":\"routes\",\"templated\":true,\"type\":\"route\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/commercial_modes/{commercial_mode.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/vehicle_journeys/{vehicle_journey.id}\",\"rel\":\"vehicle_journeys\",\"templated\":true,\"type\":\"vehicle_journey\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/lines/{line.id}\",\"rel\":\"lines\",\"templated\":true,\"type\":\"line\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/physical_modes/{physical_mode.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/networks/{network.id}\",\"rel\":\"networks\",\"templated\":true,\"type\":\"network\"},{\"href\":\"https://api.navitia.io/v1/coverage/ie/stop_areas/stop_area:OEA:SA:CTP8360B337651/departures?from_datetime=20170427T170408\",\"templated\":false,\"type\":\"first\"}],\"notes\":[],\"pagination\":{\"items_on_page\":10,\"items_per_page\":10,\"start_page\":0,\"total_result\":10}}",
+	"disruptions":          "{\"disruptions\":[{\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"disruption_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"impact_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b87\",\"status\":\"active\",\"severity\":{\"name\":\"trip canceled\",\"effect\":\"NO_SERVICE\",\"color\":\"FF0000\",\"priority\":4},\"application_periods\":[{\"begin\":\"20170819T000000\",\"end\":\"20170827T235959\"}],\"messages\":[{\"text\":\"Travaux : pas de trafic entre Nation et Gare de Lyon.\",\"channel\":{\"content_type\":\"text/plain\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40962\",\"name\":\"titre\",\"types\":[\"title\"]}}],\"updated_at\":\"20170810T120000\",\"cause\":\"travaux\",\"category\":\"Travaux\",\"impacted_objects\":[{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"code\":\"1\",\"color\":\"FFCD00\"}}},{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\"}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848197\",\"lon\":\"2.395859\"}}}},{\"pt_object\":{\"embedded_type\":\"trip\",\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\",\"quality\":0,\"trip\":{\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\"}},\"impacted_stops\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}},\"cause\":\"travaux\",\"stop_time_effect\":\"deleted\",\"departure_status\":\"deleted\",\"arrival_status\":\"deleted\",\"base_arrival_time\":\"083200\",\"base_departure_time\":\"083230\",\"is_detour\":false}]}]}],\"links\":[],\"pagination\":{\"items_on_page\":1,\"items_per_page\":25,\"start_page\":0,\"total_result\":1}}",
+	"equipment_reports":    "{\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1},\"links\":[],\"equipment_reports\":[{\"line\":{\"id\":\"line:OIF:810:AOIF741\",\"name\":\"Saint-Germain-en-Laye / Poissy / Cergy - Boissy-Saint-Léger / Marne-la-Vallée\",\"code\":\"A\",\"color\":\"D1302F\",\"text_color\":\"FFFFFF\"},\"stop_area_equipments\":[{\"stop_area\":{\"id\":\"stop_area:OIF:SA:8775860\",\"name\":\"Châtelet les Halles\",\"label\":\"Châtelet les Halles (Paris)\",\"coord\":{\"lon\":\"2.347013\",\"lat\":\"48.861822\"}},\"equipment_details\":[{\"id\":\"733\",\"name\":\"Accès quai RER A direction Marne-la-Vallée\",\"embedded_type\":\"escalator\",\"current_availability\":{\"status\":\"unavailable\",\"cause\":{\"label\":\"maintenance\"},\"effect\":{\"label\":\"out of service\"},\"periods\":[{\"begin\":\"20170410T080000\",\"end\":\"20170415T180000\"}],\"updated_at\":\"20170412T101500\"}},{\"id\":\"734\",\"name\":\"Ascenseur sortie Forum\",\"embedded_type\":\"elevator\",\"current_availability\":{\"status\":\"available\",\"periods\":[],\"updated_at\":\"20170412T101500\"}}]}]}]}",
+	"freefloatings_nearby": "{\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":10,\"total_result\":2},\"links\":[],\"free_floatings\":[{\"id\":\"ff:lime:3a1f\",\"public_id\":\"XP-042\",\"provider_name\":\"Lime\",\"type\":\"KICKSCOOTER\",\"propulsion\":\"ELECTRIC\",\"battery\":87,\"deeplink\":\"https://limebike.app.link/scooter/XP-042\",\"coord\":{\"lon\":\"2.37783\",\"lat\":\"48.84731\"},\"distance\":42.5},{\"id\":\"ff:getaround:91c2\",\"public_id\":\"GA-123-BC\",\"provider_name\":\"Getaround\",\"type\":\"CAR\",\"propulsion\":\"COMBUSTION\",\"deeplink\":\"https://getaround.com/car/91c2\",\"coord\":{\"lon\":\"2.37912\",\"lat\":\"48.84654\"},\"distance\":156}]}",
+	"isochrones":           "{\"isochrones\":[{\"min_duration\":0,\"max_duration\":1200,\"geojson\":{\"type\":\"MultiPolygon\",\"coordinates\":[[[[2.36,48.84],[2.39,48.84],[2.39,48.85],[2.36,48.85],[2.36,48.84]]]]}}]}",
+	"journeys":             "{\"context\":{\"car_direct_path\":{\"co2_emission\":{\"unit\":\"gEC\",\"value\":1535.5398252532}}},\"disruptions\":[],\"exceptions\":[],\"feed_publishers\":[{\"id\":\"OIF\",\"license\":\"ODbL\",\"name\":\"OIF - STIF Ile de France\",\"url\":\"http://www.vianavigo.com\"}],\"journeys\":[{\"arrival_date_time\":\"20170413T141146\",\"calendars\":[{\"active_periods\":[{\"begin\":\"20170326\",\"end\":\"20170417\"}],\"exceptions\":[{\"datetime\":\"20170402\",\"type\":\"remove\"},{\"datetime\":\"20170409\",\"type\":\"remove\"},{\"datetime\":\"20170415\",\"type\":\"add\"}],\"week_pattern\":{\"friday\":true,\"monday\":true,\"saturday\":false,\"sunday\":true,\"thursday\":true,\"tuesday\":true,\"wednesday\":true}}],\"co2_emission\":{\"unit\":\"gEC\",\"value\":39.556},\"departure_date_time\":\"20170413T133945\",\"duration\":1921,\"durations\":{\"total\":1921,\"walking\":1081},\"fare\":{\"found\":false,\"links\":[],\"total\":{\"currency\":\"\",\"value\":\"0.0\"}},\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?allowed_id%5B%5D=stop_area%3AOIF%3ASA%3A8739305&allowed_id%5B%5D=stop_area%3AOIF%3ASA%3A8754700&to=2.2922926%3B48.8583736&from=2.3749036%3B48.8467927&min_nb_journeys=5\",\"rel\":\"same_journey_schedules\",\"templated\":false,\"type\":\"journeys\"}],\"nb_transfers\":0,\"requested_date_time\":\"20170413T133734\",\"sections\":[{\"arrival_date_time\":\"20170413T135400\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T133945\",\"duration\":855,\"from\":{\"address\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8467927\",\"lon\":\"2.3749036\"},\"house_number\":9,\"id\":\"2.3749036;48.8467927\",\"label\":\"9 Rue Abel (Paris)\",\"name\":\"Rue Abel\"},\"embedded_type\":\"address\",\"id\":\"2.3749036;48.8467927\",\"name\":\"9 Rue Abel (Paris)\",\"quality\":0},\"geojson\":{\"coordinates\":[[2.3749393938,48.8467686088],[2.3749393938,48.8467686088],[2.374414,48.845988],[2.374362,48.845932],[2.37418,48.845844],[2.373971,48.845713],[2.372006,48.844257],[2.371002,48.843604],[2.370734,48.843413],[2.37059,48.843335],[2.370326,48.843194],[2.370285,48.843171],[2.370258,48.843155],[2.370234,48.843144],[2.368039,48.841998],[2.367888,48.8419],[2.367777,48.841975],[2.366689,48.842783],[2.366352,48.84303],[2.366289,48.843066],[2.366211,48.843111],[2.365939,48.842926],[2.365939,48.842926],[2.365433,48.842528]],\"properties\":[{\"length\":957}],\"type\":\"LineString\"},\"id\":\"section_12_0\",\"links\":[],\"mode\":\"walking\",\"path\":[{\"direction\":0,\"duration\":90,\"length\":101,\"name\":\"Rue Abel\"},{\"direction\":22,\"duration\":14,\"length\":16,\"name\":\"Boulevard Diderot\"},{\"direction\":-7,\"duration\":328,\"length\":367,\"name\":\"Rue Van Gogh\"},{\"direction\":8,\"duration\":40,\"length\":45,\"name\":\"\"},{\"direction\":-4,\"duration\":183,\"length\":205,\"name\":\"Pont Charles de Gaulle\"},{\"direction\":-6,\"duration\":13,\"length\":15,\"name\":\"\"},{\"direction\":90,\"duration\":161,\"length\":180,\"name\":\"Quai d'Austerlitz\"},{\"direction\":-87,\"duration\":26,\"length\":29,\"name\":\"\"},{\"direction\":0,\"duration\":0,\"length\":0,\"name\":\"Cour Seine\"}],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:8754702:800:C\",\"name\":\"Gare d'Austerlitz RER C (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41333\"},{\"type\":\"external_code\",\"value\":\"OIF8754702:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8754702:800:C\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:rapidtransit\",\"name\":\"RER\"}],\"coord\":{\"lat\":\"48.842528\",\"lon\":\"2.365433\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8754702:800:C\",\"label\":\"Gare d'Austerlitz RER C (Paris)\",\"links\":[],\"name\":\"Gare d'Austerlitz RER C\",\"physical_modes\":[{\"id\":\"physical_mode:RapidTransit\",\"name\":\"Train de banlieue / RER\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8754700\"},{\"type\":\"source\",\"value\":\"StopArea:8754700\"}],\"coord\":{\"lat\":\"48.843578\",\"lon\":\"2.364651\"},\"id\":\"stop_area:OIF:SA:8754700\",\"label\":\"Gare d'Austerlitz (Paris)\",\"links\":[],\"name\":\"Gare d'Austerlitz\",\"timezone\":\"Europe/Paris\"}}},\"type\":\"street_network\"},{\"additional_informations\":[\"regular\"],\"arrival_date_time\":\"20170413T140800\",\"base_arrival_date_time\":\"20170413T140800\",\"base_departure_date_time\":\"20170413T135400\",\"co2_emission\":{\"unit\":\"gEC\",\"value\":39.556},\"departure_date_time\":\"20170413T135400\",\"display_informations\":{\"code\":\"C\",\"color\":\"FCD946\",\"commercial_mode\":\"RER\",\"description\":\"\",\"direction\":\"Gare de Versailles Ch\\u00e2teau - Rive Gauche (Versailles)\",\"equipments\":[],\"headsign\":\"VICK\",\"label\":\"C\",\"links\":[],\"network\":\"RER\",\"physical_mode\":\"Train de banlieue / RER\",\"text_color\":\"FFFFFF\"},\"duration\":840,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:8754702:800:C\",\"name\":\"Gare d'Austerlitz RER C (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41333\"},{\"type\":\"external_code\",\"value\":\"OIF8754702:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8754702:800:C\"}],\"coord\":{\"lat\":\"48.842528\",\"lon\":\"2.365433\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8754702:800:C\",\"label\":\"Gare d'Austerlitz RER C (Paris)\",\"links\":[],\"name\":\"Gare d'Austerlitz RER C\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8754700\"},{\"type\":\"source\",\"value\":\"StopArea:8754700\"}],\"coord\":{\"lat\":\"48.843578\",\"lon\":\"2.364651\"},\"id\":\"stop_area:OIF:SA:8754700\",\"label\":\"Gare d'Austerlitz (Paris)\",\"links\":[],\"name\":\"Gare d'Austerlitz\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.365433,48.842528],[2.346035,48.853336],[2.32562,48.860708],[2.313911,48.862902],[2.30099,48.862662],[2.290392,48.857293]],\"properties\":[{\"length\":6380}],\"type\":\"LineString\"},\"id\":\"section_13_0\",\"links\":[{\"id\":\"vehicle_journey:OIF:82209457-1_354848-1_dst_2\",\"type\":\"vehicle_journey\"},{\"id\":\"line:OIF:800:COIF741\",\"type\":\"line\"},{\"id\":\"route:OIF:800:C_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:rapidtransit\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:RapidTransit\",\"type\":\"physical_mode\"},{\"id\":\"network:RER\",\"type\":\"network\"}],\"stop_date_times\":[{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135300\",\"base_arrival_date_time\":\"20170413T135300\",\"base_departure_date_time\":\"20170413T135400\",\"departure_date_time\":\"20170413T135400\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41333\"},{\"type\":\"external_code\",\"value\":\"OIF8754702:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8754702:800:C\"}],\"coord\":{\"lat\":\"48.842528\",\"lon\":\"2.365433\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8754702:800:C\",\"label\":\"Gare d'Austerlitz RER C (Paris)\",\"links\":[],\"name\":\"Gare d'Austerlitz RER C\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135700\",\"base_arrival_date_time\":\"20170413T135700\",\"base_departure_date_time\":\"20170413T135800\",\"departure_date_time\":\"20170413T135800\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41335\"},{\"type\":\"external_code\",\"value\":\"OIF8754731:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8754731:800:C\"}],\"coord\":{\"lat\":\"48.853336\",\"lon\":\"2.346035\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8754731:800:C\",\"label\":\"Saint-Michel Notre-Dame RER C (Paris)\",\"links\":[],\"name\":\"Saint-Michel Notre-Dame RER C\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140000\",\"base_arrival_date_time\":\"20170413T140000\",\"base_departure_date_time\":\"20170413T140100\",\"departure_date_time\":\"20170413T140100\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41334\"},{\"type\":\"external_code\",\"value\":\"OIF8754730:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8754730:800:C\"}],\"coord\":{\"lat\":\"48.860708\",\"lon\":\"2.32562\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8754730:800:C\",\"label\":\"Mus\\u00e9e d'Orsay (Paris)\",\"links\":[],\"name\":\"Mus\\u00e9e d'Orsay\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140300\",\"base_arrival_date_time\":\"20170413T140300\",\"base_departure_date_time\":\"20170413T140400\",\"departure_date_time\":\"20170413T140400\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41208\"},{\"type\":\"external_code\",\"value\":\"OIF8739303:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739303:800:C\"}],\"coord\":{\"lat\":\"48.862902\",\"lon\":\"2.313911\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8739303:800:C\",\"label\":\"Invalides (Paris)\",\"links\":[],\"name\":\"Invalides\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140600\",\"base_arrival_date_time\":\"20170413T140600\",\"base_departure_date_time\":\"20170413T140600\",\"departure_date_time\":\"20170413T140600\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41209\"},{\"type\":\"external_code\",\"value\":\"OIF8739304:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739304:800:C\"}],\"coord\":{\"lat\":\"48.862662\",\"lon\":\"2.30099\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8739304:800:C\",\"label\":\"Pont de l'Alma (Paris)\",\"links\":[],\"name\":\"Pont de l'Alma\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140800\",\"base_arrival_date_time\":\"20170413T140800\",\"base_departure_date_time\":\"20170413T140900\",\"departure_date_time\":\"20170413T140900\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41210\"},{\"type\":\"external_code\",\"value\":\"OIF8739305:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739305:800:C\"}],\"coord\":{\"lat\":\"48.857293\",\"lon\":\"2.290392\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8739305:800:C\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\"}}],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:8739305:800:C\",\"name\":\"Champ de Mars Tour Eiffel (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41210\"},{\"type\":\"external_code\",\"value\":\"OIF8739305:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739305:800:C\"}],\"coord\":{\"lat\":\"48.857293\",\"lon\":\"2.290392\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8739305:800:C\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"}],\"coord\":{\"lat\":\"48.8572\",\"lon\":\"2.293234\"},\"id\":\"stop_area:OIF:SA:8739305\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"timezone\":\"Europe/Paris\"}}},\"type\":\"public_transport\"},{\"arrival_date_time\":\"20170413T141146\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T140800\",\"duration\":226,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:8739305:800:C\",\"name\":\"Champ de Mars Tour Eiffel (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41210\"},{\"type\":\"external_code\",\"value\":\"OIF8739305:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739305:800:C\"},{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"41210\"},{\"type\":\"external_code\",\"value\":\"OIF8739305:800:C\"},{\"type\":\"source\",\"value\":\"StopPoint:8739305:800:C\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:rapidtransit\",\"name\":\"RER\"}],\"coord\":{\"lat\":\"48.857293\",\"lon\":\"2.290392\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:8739305:800:C\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"physical_modes\":[{\"id\":\"physical_mode:RapidTransit\",\"name\":\"Train de banlieue / RER\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"},{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"}],\"coord\":{\"lat\":\"48.8572\",\"lon\":\"2.293234\"},\"id\":\"stop_area:OIF:SA:8739305\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.290392,48.857293],[2.2902998397,48.8574374498],[2.290517,48.857576],[2.290641,48.857504],[2.290688,48.857477],[2.290747,48.857439],[2.290973,48.857209],[2.291011,48.857233],[2.291064,48.857272],[2.291097,48.857294],[2.291075,48.857309],[2.290908,48.857414],[2.291025,48.857486],[2.291388,48.857826],[2.2922745574,48.8584013995],[2.2922745574,48.8584013995]],\"properties\":[{\"length\":253}],\"type\":\"LineString\"},\"id\":\"section_14_0\",\"links\":[],\"mode\":\"walking\",\"path\":[{\"direction\":0,\"duration\":19,\"length\":21,\"name\":\"\"},{\"direction\":0,\"duration\":14,\"length\":16,\"name\":\"\"},{\"direction\":3,\"duration\":5,\"length\":6,\"name\":\"\"},{\"direction\":13,\"duration\":28,\"length\":31,\"name\":\"\"},{\"direction\":-101,\"duration\":3,\"length\":3,\"name\":\"\"},{\"direction\":-4,\"duration\":5,\"length\":6,\"name\":\"\"},{\"direction\":3,\"duration\":3,\"length\":3,\"name\":\"\"},{\"direction\":-89,\"duration\":17,\"length\":19,\"name\":\"\"},{\"direction\":93,\"duration\":132,\"length\":148,\"name\":\"Quai Branly\"}],\"to\":{\"address\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8583736\",\"lon\":\"2.2922926\"},\"house_number\":69,\"id\":\"2.2922926;48.8583736\",\"label\":\"69 Quai Branly (Paris)\",\"name\":\"Quai Branly\"},\"embedded_type\":\"address\",\"id\":\"2.2922926;48.8583736\",\"name\":\"69 Quai Branly (Paris)\",\"quality\":0},\"type\":\"street_network\"}],\"status\":\"\",\"tags\":[\"walking\",\"ecologic\"],\"type\":\"best\"},{\"arrival_date_time\":\"20170413T143254\",\"calendars\":[{\"active_periods\":[{\"begin\":\"20170411\",\"end\":\"20170414\"}],\"week_pattern\":{\"friday\":false,\"monday\":false,\"saturday\":false,\"sunday\":false,\"thursday\":true,\"tuesday\":true,\"wednesday\":true}}],\"co2_emission\":{\"unit\":\"gEC\",\"value\":314.4221},\"departure_date_time\":\"20170413T134249\",\"duration\":3005,\"durations\":{\"total\":3005,\"walking\":641},\"fare\":{\"found\":false,\"links\":[],\"total\":{\"currency\":\"\",\"value\":\"0.0\"}},\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?allowed_id%5B%5D=stop_area%3AOIF%3ASA%3A8768600&allowed_id%5B%5D=stop_area%3AOIF%3ASA%3A8739305&allowed_id%5B%5D=stop_area%3AOIF%3ASA%3A8738102&to=2.2922926%3B48.8583736&from=2.3749036%3B48.8467927&min_nb_journeys=5\",\"rel\":\"same_journey_schedules\",\"templated\":false,\"type\":\"journeys\"}],\"nb_transfers\":1,\"requested_date_time\":\"20170413T133734\",\"sections\":[{\"arrival_date_time\":\"20170413T134600\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T134249\",\"duration\":191,\"from\":{\"address\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8467927\",\"lon\":\"2.3749036\"},\"house_number\":9,\"id\":\"2.3749036;48.8467927\",\"label\":\"9 Rue Abel (Paris)\",\"name\":\"Rue Abel\"},\"embedded_type\":\"address\",\"id\":\"2.3749036;48.8467927\",\"name\":\"9 Rue Abel (Paris)\",\"quality\":0},\"geojson\":{\"coordinates\":[[2.3749393938,48.8467686088],[2.3749393938,48.8467686088],[2.374414,48.845988],[2.374362,48.845932],[2.37418,48.845844],[2.373767,48.845795],[2.373679,48.84579],[2.373698,48.845707],[2.373867,48.845725],[2.37388,48.845686],[2.373896,48.845634],[2.3734655071,48.8455830729],[2.373468,48.845562]],\"properties\":[{\"length\":213}],\"type\":\"LineString\"},\"id\":\"section_0_0\",\"links\":[],\"mode\":\"walking\",\"path\":[{\"direction\":0,\"duration\":90,\"length\":101,\"name\":\"Rue Abel\"},{\"direction\":22,\"duration\":46,\"length\":52,\"name\":\"Boulevard Diderot\"},{\"direction\":-94,\"duration\":8,\"length\":9,\"name\":\"\"},{\"direction\":-91,\"duration\":11,\"length\":12,\"name\":\"\"},{\"direction\":87,\"duration\":8,\"length\":9,\"name\":\"\"},{\"direction\":0,\"duration\":28,\"length\":31,\"name\":\"\"}],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59233\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22083\"},{\"type\":\"external_code\",\"value\":\"OIF59233\"},{\"type\":\"source\",\"value\":\"StopPoint:59233\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:metro\",\"name\":\"M\\u00e9tro\"}],\"coord\":{\"lat\":\"48.845562\",\"lon\":\"2.373468\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59233\",\"label\":\"Gare de Lyon (Paris)\",\"links\":[],\"name\":\"Gare de Lyon\",\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"M\\u00e9tro\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8768600\"},{\"type\":\"source\",\"value\":\"StopArea:8768600\"}],\"coord\":{\"lat\":\"48.844825\",\"lon\":\"2.373039\"},\"id\":\"stop_area:OIF:SA:8768600\",\"label\":\"Gare de Lyon (Paris)\",\"links\":[],\"name\":\"Gare de Lyon\",\"timezone\":\"Europe/Paris\"}}},\"type\":\"street_network\"},{\"additional_informations\":[\"regular\"],\"arrival_date_time\":\"20170413T140600\",\"base_arrival_date_time\":\"20170413T140600\",\"base_departure_date_time\":\"20170413T134600\",\"co2_emission\":{\"unit\":\"gEC\",\"value\":23.505},\"departure_date_time\":\"20170413T134600\",\"display_informations\":{\"code\":\"1\",\"color\":\"F2C931\",\"commercial_mode\":\"M\\u00e9tro\",\"description\":\"\",\"direction\":\"La D\\u00e9fense (Grande Arche) (Puteaux)\",\"equipments\":[],\"headsign\":\"OIF:79516015-1_53420-1\",\"label\":\"1\",\"links\":[],\"network\":\"METRO\",\"physical_mode\":\"M\\u00e9tro\",\"text_color\":\"FFFFFF\"},\"duration\":1200,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59233\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22083\"},{\"type\":\"external_code\",\"value\":\"OIF59233\"},{\"type\":\"source\",\"value\":\"StopPoint:59233\"}],\"coord\":{\"lat\":\"48.845562\",\"lon\":\"2.373468\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59233\",\"label\":\"Gare de Lyon (Paris)\",\"links\":[],\"name\":\"Gare de Lyon\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8768600\"},{\"type\":\"source\",\"value\":\"StopArea:8768600\"}],\"coord\":{\"lat\":\"48.844825\",\"lon\":\"2.373039\"},\"id\":\"stop_area:OIF:SA:8768600\",\"label\":\"Gare de Lyon (Paris)\",\"links\":[],\"name\":\"Gare de Lyon\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.373468,48.845562],[2.369238,48.852978],[2.361353,48.855137],[2.352092,48.857359],[2.347952,48.858572],[2.340992,48.860883],[2.336592,48.862375],[2.329113,48.864783],[2.321212,48.865681],[2.314141,48.867747],[2.310272,48.869014],[2.300788,48.872049],[2.295146,48.873934],[2.289462,48.875676],[2.282484,48.878009]],\"properties\":[{\"length\":7835}],\"type\":\"LineString\"},\"id\":\"section_1_0\",\"links\":[{\"id\":\"vehicle_journey:OIF:79516015-1_53420-1\",\"type\":\"vehicle_journey\"},{\"id\":\"line:OIF:100110001:1OIF439\",\"type\":\"line\"},{\"id\":\"route:OIF:100110001:1\",\"type\":\"route\"},{\"id\":\"commercial_mode:metro\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Metro\",\"type\":\"physical_mode\"},{\"id\":\"network:OIF:439\",\"type\":\"network\"}],\"stop_date_times\":[{\"additional_informations\":[],\"arrival_date_time\":\"20170413T134600\",\"base_arrival_date_time\":\"20170413T134600\",\"base_departure_date_time\":\"20170413T134600\",\"departure_date_time\":\"20170413T134600\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22083\"},{\"type\":\"external_code\",\"value\":\"OIF59233\"},{\"type\":\"source\",\"value\":\"StopPoint:59233\"}],\"coord\":{\"lat\":\"48.845562\",\"lon\":\"2.373468\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59233\",\"label\":\"Gare de Lyon (Paris)\",\"links\":[],\"name\":\"Gare de Lyon\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T134800\",\"base_arrival_date_time\":\"20170413T134800\",\"base_departure_date_time\":\"20170413T134800\",\"departure_date_time\":\"20170413T134800\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22089\"},{\"type\":\"external_code\",\"value\":\"OIF59238\"},{\"type\":\"source\",\"value\":\"StopPoint:59238\"}],\"coord\":{\"lat\":\"48.852978\",\"lon\":\"2.369238\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59238\",\"label\":\"Bastille (Paris)\",\"links\":[],\"name\":\"Bastille\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135000\",\"base_arrival_date_time\":\"20170413T135000\",\"base_departure_date_time\":\"20170413T135000\",\"departure_date_time\":\"20170413T135000\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22074\"},{\"type\":\"external_code\",\"value\":\"OIF59225\"},{\"type\":\"source\",\"value\":\"StopPoint:59225\"}],\"coord\":{\"lat\":\"48.855137\",\"lon\":\"2.361353\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59225\",\"label\":\"Saint-Paul (le Marais) (Paris)\",\"links\":[],\"name\":\"Saint-Paul (le Marais)\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135200\",\"base_arrival_date_time\":\"20170413T135200\",\"base_departure_date_time\":\"20170413T135200\",\"departure_date_time\":\"20170413T135200\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22091\"},{\"type\":\"external_code\",\"value\":\"OIF59590\"},{\"type\":\"source\",\"value\":\"StopPoint:59590\"}],\"coord\":{\"lat\":\"48.857359\",\"lon\":\"2.352092\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59590\",\"label\":\"H\\u00f4tel de Ville (Paris)\",\"links\":[],\"name\":\"H\\u00f4tel de Ville\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135300\",\"base_arrival_date_time\":\"20170413T135300\",\"base_departure_date_time\":\"20170413T135300\",\"departure_date_time\":\"20170413T135300\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22087\"},{\"type\":\"external_code\",\"value\":\"OIF59585\"},{\"type\":\"source\",\"value\":\"StopPoint:59585\"}],\"coord\":{\"lat\":\"48.858572\",\"lon\":\"2.347952\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59585\",\"label\":\"Ch\\u00e2telet (Paris)\",\"links\":[],\"name\":\"Ch\\u00e2telet\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135400\",\"base_arrival_date_time\":\"20170413T135400\",\"base_departure_date_time\":\"20170413T135400\",\"departure_date_time\":\"20170413T135400\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22081\"},{\"type\":\"external_code\",\"value\":\"OIF59231\"},{\"type\":\"source\",\"value\":\"StopPoint:59231\"}],\"coord\":{\"lat\":\"48.860883\",\"lon\":\"2.340992\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59231\",\"label\":\"Louvre-Rivoli (Paris)\",\"links\":[],\"name\":\"Louvre-Rivoli\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135500\",\"base_arrival_date_time\":\"20170413T135500\",\"base_departure_date_time\":\"20170413T135500\",\"departure_date_time\":\"20170413T135500\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22079\"},{\"type\":\"external_code\",\"value\":\"OIF59591\"},{\"type\":\"source\",\"value\":\"StopPoint:59591\"}],\"coord\":{\"lat\":\"48.862375\",\"lon\":\"2.336592\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59591\",\"label\":\"Palais-Royal (Mus\\u00e9e du Louvre) (Paris)\",\"links\":[],\"name\":\"Palais-Royal (Mus\\u00e9e du Louvre)\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135700\",\"base_arrival_date_time\":\"20170413T135700\",\"base_departure_date_time\":\"20170413T135700\",\"departure_date_time\":\"20170413T135700\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22075\"},{\"type\":\"external_code\",\"value\":\"OIF59226\"},{\"type\":\"source\",\"value\":\"StopPoint:59226\"}],\"coord\":{\"lat\":\"48.864783\",\"lon\":\"2.329113\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59226\",\"label\":\"Tuileries (Paris)\",\"links\":[],\"name\":\"Tuileries\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T135800\",\"base_arrival_date_time\":\"20170413T135800\",\"base_departure_date_time\":\"20170413T135800\",\"departure_date_time\":\"20170413T135800\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22085\"},{\"type\":\"external_code\",\"value\":\"OIF59235\"},{\"type\":\"source\",\"value\":\"StopPoint:59235\"}],\"coord\":{\"lat\":\"48.865681\",\"lon\":\"2.321212\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59235\",\"label\":\"Concorde (Paris)\",\"links\":[],\"name\":\"Concorde\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140000\",\"base_arrival_date_time\":\"20170413T140000\",\"base_departure_date_time\":\"20170413T140000\",\"departure_date_time\":\"20170413T140000\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22090\"},{\"type\":\"external_code\",\"value\":\"OIF59592\"},{\"type\":\"source\",\"value\":\"StopPoint:59592\"}],\"coord\":{\"lat\":\"48.867747\",\"lon\":\"2.314141\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59592\",\"label\":\"Champs-Elys\\u00e9es-Cl\\u00e9menceau (Paris)\",\"links\":[],\"name\":\"Champs-Elys\\u00e9es-Cl\\u00e9menceau\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140100\",\"base_arrival_date_time\":\"20170413T140100\",\"base_departure_date_time\":\"20170413T140100\",\"departure_date_time\":\"20170413T140100\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22082\"},{\"type\":\"external_code\",\"value\":\"OIF59232\"},{\"type\":\"source\",\"value\":\"StopPoint:59232\"}],\"coord\":{\"lat\":\"48.869014\",\"lon\":\"2.310272\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59232\",\"label\":\"Franklin-Roosevelt (Paris)\",\"links\":[],\"name\":\"Franklin-Roosevelt\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140200\",\"base_arrival_date_time\":\"20170413T140200\",\"base_departure_date_time\":\"20170413T140200\",\"departure_date_time\":\"20170413T140200\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22084\"},{\"type\":\"external_code\",\"value\":\"OIF59234\"},{\"type\":\"source\",\"value\":\"StopPoint:59234\"}],\"coord\":{\"lat\":\"48.872049\",\"lon\":\"2.300788\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59234\",\"label\":\"George V (Paris)\",\"links\":[],\"name\":\"George V\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140400\",\"base_arrival_date_time\":\"20170413T140400\",\"base_departure_date_time\":\"20170413T140400\",\"departure_date_time\":\"20170413T140400\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22086\"},{\"type\":\"external_code\",\"value\":\"OIF59236\"},{\"type\":\"source\",\"value\":\"StopPoint:59236\"}],\"coord\":{\"lat\":\"48.873934\",\"lon\":\"2.295146\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59236\",\"label\":\"Charles de Gaulle-Etoile (Paris)\",\"links\":[],\"name\":\"Charles de Gaulle-Etoile\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140500\",\"base_arrival_date_time\":\"20170413T140500\",\"base_departure_date_time\":\"20170413T140500\",\"departure_date_time\":\"20170413T140500\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22088\"},{\"type\":\"external_code\",\"value\":\"OIF59237\"},{\"type\":\"source\",\"value\":\"StopPoint:59237\"}],\"coord\":{\"lat\":\"48.875676\",\"lon\":\"2.289462\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59237\",\"label\":\"Argentine (Paris)\",\"links\":[],\"name\":\"Argentine\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T140600\",\"base_arrival_date_time\":\"20170413T140600\",\"base_departure_date_time\":\"20170413T140600\",\"departure_date_time\":\"20170413T140600\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22078\"},{\"type\":\"external_code\",\"value\":\"OIF59229\"},{\"type\":\"source\",\"value\":\"StopPoint:59229\"}],\"coord\":{\"lat\":\"48.878009\",\"lon\":\"2.282484\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59229\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\"}}],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59229\",\"name\":\"Porte Maillot (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22078\"},{\"type\":\"external_code\",\"value\":\"OIF59229\"},{\"type\":\"source\",\"value\":\"StopPoint:59229\"}],\"coord\":{\"lat\":\"48.878009\",\"lon\":\"2.282484\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59229\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8738102\"},{\"type\":\"source\",\"value\":\"StopArea:8738102\"}],\"coord\":{\"lat\":\"48.87769\",\"lon\":\"2.282718\"},\"id\":\"stop_area:OIF:SA:8738102\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"timezone\":\"Europe/Paris\"}}},\"type\":\"public_transport\"},{\"arrival_date_time\":\"20170413T141136\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T140600\",\"duration\":336,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59229\",\"name\":\"Porte Maillot (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"22078\"},{\"type\":\"external_code\",\"value\":\"OIF59229\"},{\"type\":\"source\",\"value\":\"StopPoint:59229\"}],\"coord\":{\"lat\":\"48.878009\",\"lon\":\"2.282484\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59229\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8738102\"},{\"type\":\"source\",\"value\":\"StopArea:8738102\"}],\"coord\":{\"lat\":\"48.87769\",\"lon\":\"2.282718\"},\"id\":\"stop_area:OIF:SA:8738102\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.282484,48.878009],[2.283412,48.876572]],\"properties\":[{\"length\":173}],\"type\":\"LineString\"},\"id\":\"section_2_0\",\"links\":[],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59:3813011\",\"name\":\"Porte Maillot (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25325\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813011\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813011\"}],\"coord\":{\"lat\":\"48.876572\",\"lon\":\"2.283412\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813011\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8738102\"},{\"type\":\"source\",\"value\":\"StopArea:8738102\"}],\"coord\":{\"lat\":\"48.87769\",\"lon\":\"2.282718\"},\"id\":\"stop_area:OIF:SA:8738102\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"timezone\":\"Europe/Paris\"}}},\"transfer_type\":\"walking\",\"type\":\"transfer\"},{\"arrival_date_time\":\"20170413T141700\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T141136\",\"duration\":324,\"id\":\"section_3_0\",\"links\":[],\"type\":\"waiting\"},{\"additional_informations\":[\"regular\"],\"arrival_date_time\":\"20170413T143100\",\"base_arrival_date_time\":\"20170413T143100\",\"base_departure_date_time\":\"20170413T141700\",\"co2_emission\":{\"unit\":\"gEC\",\"value\":290.9171},\"departure_date_time\":\"20170413T141700\",\"display_informations\":{\"code\":\"82\",\"color\":\"f68f4b\",\"commercial_mode\":\"Bus\",\"description\":\"\",\"direction\":\"Luxembourg (Paris)\",\"equipments\":[],\"headsign\":\"OIF:82314426-1_383647-1\",\"label\":\"82\",\"links\":[],\"network\":\"RATP\",\"physical_mode\":\"Bus\",\"text_color\":\"000000\"},\"duration\":840,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59:3813011\",\"name\":\"Porte Maillot (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25325\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813011\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813011\"}],\"coord\":{\"lat\":\"48.876572\",\"lon\":\"2.283412\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813011\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8738102\"},{\"type\":\"source\",\"value\":\"StopArea:8738102\"}],\"coord\":{\"lat\":\"48.87769\",\"lon\":\"2.282718\"},\"id\":\"stop_area:OIF:SA:8738102\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.283412,48.876572],[2.284041,48.874262],[2.284711,48.87162],[2.28609,48.869105],[2.289648,48.866886],[2.293055,48.865189],[2.293056,48.864074],[2.291274,48.861207],[2.292774,48.859212]],\"properties\":[{\"length\":2243}],\"type\":\"LineString\"},\"id\":\"section_4_0\",\"links\":[{\"id\":\"vehicle_journey:OIF:82314426-1_383647-1\",\"type\":\"vehicle_journey\"},{\"id\":\"line:OIF:100100082:82OIF442\",\"type\":\"line\"},{\"id\":\"route:OIF:100100082:82_R\",\"type\":\"route\"},{\"id\":\"commercial_mode:bus\",\"type\":\"commercial_mode\"},{\"id\":\"physical_mode:Bus\",\"type\":\"physical_mode\"},{\"id\":\"network:RTP\",\"type\":\"network\"}],\"stop_date_times\":[{\"additional_informations\":[],\"arrival_date_time\":\"20170413T141700\",\"base_arrival_date_time\":\"20170413T141700\",\"base_departure_date_time\":\"20170413T141700\",\"departure_date_time\":\"20170413T141700\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25325\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813011\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813011\"}],\"coord\":{\"lat\":\"48.876572\",\"lon\":\"2.283412\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813011\",\"label\":\"Porte Maillot (Paris)\",\"links\":[],\"name\":\"Porte Maillot\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T141800\",\"base_arrival_date_time\":\"20170413T141800\",\"base_departure_date_time\":\"20170413T141800\",\"departure_date_time\":\"20170413T141800\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25324\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813009\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813009\"}],\"coord\":{\"lat\":\"48.874262\",\"lon\":\"2.284041\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813009\",\"label\":\"Alphand (Paris)\",\"links\":[],\"name\":\"Alphand\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T142000\",\"base_arrival_date_time\":\"20170413T142000\",\"base_departure_date_time\":\"20170413T142000\",\"departure_date_time\":\"20170413T142000\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25326\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813007\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813007\"}],\"coord\":{\"lat\":\"48.87162\",\"lon\":\"2.284711\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813007\",\"label\":\"Foch (Paris)\",\"links\":[],\"name\":\"Foch\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T142200\",\"base_arrival_date_time\":\"20170413T142200\",\"base_departure_date_time\":\"20170413T142200\",\"departure_date_time\":\"20170413T142200\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"23506\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813005\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813005\"}],\"coord\":{\"lat\":\"48.869105\",\"lon\":\"2.28609\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813005\",\"label\":\"Victor Hugo - Poincar\\u00e9 (Paris)\",\"links\":[],\"name\":\"Victor Hugo - Poincar\\u00e9\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T142500\",\"base_arrival_date_time\":\"20170413T142500\",\"base_departure_date_time\":\"20170413T142500\",\"departure_date_time\":\"20170413T142500\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"23496\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813002\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813002\"}],\"coord\":{\"lat\":\"48.866886\",\"lon\":\"2.289648\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813002\",\"label\":\"Kl\\u00e9ber - Boissi\\u00e8re (Paris)\",\"links\":[],\"name\":\"Kl\\u00e9ber - Boissi\\u00e8re\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T142700\",\"base_arrival_date_time\":\"20170413T142700\",\"base_departure_date_time\":\"20170413T142700\",\"departure_date_time\":\"20170413T142700\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"25777\"},{\"type\":\"external_code\",\"value\":\"OIF59:3813000\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3813000\"}],\"coord\":{\"lat\":\"48.865189\",\"lon\":\"2.293055\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3813000\",\"label\":\"L\\u00fcbeck (Paris)\",\"links\":[],\"name\":\"L\\u00fcbeck\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T142800\",\"base_arrival_date_time\":\"20170413T142800\",\"base_departure_date_time\":\"20170413T142800\",\"departure_date_time\":\"20170413T142800\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"23177\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812998\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812998\"}],\"coord\":{\"lat\":\"48.864074\",\"lon\":\"2.293056\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3812998\",\"label\":\"Iena (Paris)\",\"links\":[],\"name\":\"Iena\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T143000\",\"base_arrival_date_time\":\"20170413T143000\",\"base_departure_date_time\":\"20170413T143000\",\"departure_date_time\":\"20170413T143000\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"37376\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812996\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812996\"}],\"coord\":{\"lat\":\"48.861207\",\"lon\":\"2.291274\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3812996\",\"label\":\"Varsovie (Paris)\",\"links\":[],\"name\":\"Varsovie\"}},{\"additional_informations\":[],\"arrival_date_time\":\"20170413T143100\",\"base_arrival_date_time\":\"20170413T143100\",\"base_departure_date_time\":\"20170413T143100\",\"departure_date_time\":\"20170413T143100\",\"links\":[],\"stop_point\":{\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"9107\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812994\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812994\"}],\"coord\":{\"lat\":\"48.859212\",\"lon\":\"2.292774\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3812994\",\"label\":\"Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Tour Eiffel\"}}],\"to\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59:3812994\",\"name\":\"Tour Eiffel (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"9107\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812994\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812994\"}],\"coord\":{\"lat\":\"48.859212\",\"lon\":\"2.292774\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3812994\",\"label\":\"Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Tour Eiffel\",\"stop_area\":{\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"}],\"coord\":{\"lat\":\"48.8572\",\"lon\":\"2.293234\"},\"id\":\"stop_area:OIF:SA:8739305\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"timezone\":\"Europe/Paris\"}}},\"type\":\"public_transport\"},{\"arrival_date_time\":\"20170413T143254\",\"co2_emission\":{\"unit\":\"\",\"value\":0.0},\"departure_date_time\":\"20170413T143100\",\"duration\":114,\"from\":{\"embedded_type\":\"stop_point\",\"id\":\"stop_point:OIF:SP:59:3812994\",\"name\":\"Tour Eiffel (Paris)\",\"quality\":0,\"stop_point\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"9107\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812994\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812994\"},{\"type\":\"ZDEr_ID_REF_A\",\"value\":\"9107\"},{\"type\":\"external_code\",\"value\":\"OIF59:3812994\"},{\"type\":\"source\",\"value\":\"StopPoint:59:3812994\"}],\"commercial_modes\":[{\"id\":\"commercial_mode:bus\",\"name\":\"Bus\"}],\"coord\":{\"lat\":\"48.859212\",\"lon\":\"2.292774\"},\"equipments\":[],\"id\":\"stop_point:OIF:SP:59:3812994\",\"label\":\"Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Tour Eiffel\",\"physical_modes\":[{\"id\":\"physical_mode:Bus\",\"name\":\"Bus\"}],\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"},{\"type\":\"external_code\",\"value\":\"OIF8739305\"},{\"type\":\"source\",\"value\":\"StopArea:8739305\"}],\"coord\":{\"lat\":\"48.8572\",\"lon\":\"2.293234\"},\"id\":\"stop_area:OIF:SA:8739305\",\"label\":\"Champ de Mars Tour Eiffel (Paris)\",\"links\":[],\"name\":\"Champ de Mars Tour Eiffel\",\"timezone\":\"Europe/Paris\"}}},\"geojson\":{\"coordinates\":[[2.292774,48.859212],[2.2928096264,48.8592569129],[2.293044,48.859071],[2.293156,48.858976],[2.293032,48.858893],[2.2922745574,48.8584013995],[2.2922745574,48.8584013995]],\"properties\":[{\"length\":127}],\"type\":\"LineString\"},\"id\":\"section_5_0\",\"links\":[],\"mode\":\"walking\",\"path\":[{\"direction\":0,\"duration\":34,\"length\":38,\"name\":\"Pont d'I\\u00e9na\"},{\"direction\":82,\"duration\":80,\"length\":90,\"name\":\"Quai Branly\"}],\"to\":{\"address\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"},{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8583736\",\"lon\":\"2.2922926\"},\"house_number\":69,\"id\":\"2.2922926;48.8583736\",\"label\":\"69 Quai Branly (Paris)\",\"name\":\"Quai Branly\"},\"embedded_type\":\"address\",\"id\":\"2.2922926;48.8583736\",\"name\":\"69 Quai Branly (Paris)\",\"quality\":0},\"type\":\"street_network\"}],\"status\":\"\",\"tags\":[\"walking\",\"ecologic\"],\"type\":\"less_fallback_walk\"}],\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?to=2.2922926;48.8583736&from=2.3749036;48.8467927&datetime_represents=departure&datetime=20170413T133946\",\"rel\":\"next\",\"templated\":false,\"type\":\"next\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?to=2.2922926;48.8583736&from=2.3749036;48.8467927&datetime_represents=arrival&datetime=20170413T141145\",\"rel\":\"prev\",\"templated\":false,\"type\":\"prev\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?to=2.2922926;48.8583736&from=2.3749036;48.8467927&datetime_represents=departure&datetime=20170413T000000\",\"rel\":\"first\",\"templated\":false,\"type\":\"first\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/journeys?to=2.2922926;48.8583736&from=2.3749036;48.8467927&datetime_represents=arrival&datetime=20170413T235959\",\"rel\":\"last\",\"templated\":false,\"type\":\"last\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/stop_points/{stop_point.id}\",\"rel\":\"stop_points\",\"templated\":true,\"type\":\"stop_point\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/commercial_modes/{commercial_modes.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/stop_areas/{stop_area.id}\",\"rel\":\"stop_areas\",\"templated\":true,\"type\":\"stop_area\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/vehicle_journeys/{vehicle_journey.id}\",\"rel\":\"vehicle_journeys\",\"templated\":true,\"type\":\"vehicle_journey\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/physical_modes/{physical_modes.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_modes\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/routes/{route.id}\",\"rel\":\"routes\",\"templated\":true,\"type\":\"route\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/commercial_modes/{commercial_mode.id}\",\"rel\":\"commercial_modes\",\"templated\":true,\"type\":\"commercial_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/addresses/{address.id}\",\"rel\":\"addresses\",\"templated\":true,\"type\":\"address\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/lines/{line.id}\",\"rel\":\"lines\",\"templated\":true,\"type\":\"line\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/physical_modes/{physical_mode.id}\",\"rel\":\"physical_modes\",\"templated\":true,\"type\":\"physical_mode\"},{\"href\":\"https://api.navitia.io/v1/coverage/fr-idf/networks/{network.id}\",\"rel\":\"networks\",\"templated\":true,\"type\":\"network\"}],\"notes\":[],\"tickets\":[]}",
+	"lines":                "{\"lines\":[{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\",\"text_color\":\"FFFFFF\",\"opening_time\":\"053000\",\"closing_time\":\"014500\",\"network\":{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"links\":[]},\"commercial_mode\":{\"id\":\"commercial_mode:Metro\",\"name\":\"Metro\"},\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}],\"routes\":[],\"geojson\":{\"type\":\"MultiLineString\",\"coordinates\":[[[2.3256,48.8756],[2.3291,48.8706],[2.3418,48.8594],[2.3731,48.8443],[2.3764,48.8297],[2.3669,48.8265]],[[2.3669,48.8265],[2.3764,48.8297],[2.3731,48.8443],[2.3418,48.8594],[2.3291,48.8706],[2.3256,48.8756]]]},\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]},{\"id\":\"line:RAT:M6\",\"name\":\"Nation - Charles de Gaulle Etoile\",\"code\":\"6\",\"color\":\"6ECA97\",\"text_color\":\"000000\",\"opening_time\":\"053000\",\"closing_time\":\"013600\",\"network\":{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"links\":[]},\"commercial_mode\":{\"id\":\"commercial_mode:Metro\",\"name\":\"Metro\"},\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}],\"routes\":[],\"geojson\":{\"type\":\"MultiLineString\",\"coordinates\":[[[2.3958,48.8483],[2.3731,48.8443],[2.295,48.8738]]]},\"links\":[]}],\"disruptions\":[{\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"disruption_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"impact_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b87\",\"status\":\"active\",\"severity\":{\"name\":\"trip canceled\",\"effect\":\"NO_SERVICE\",\"color\":\"FF0000\",\"priority\":4},\"application_periods\":[{\"begin\":\"20170819T000000\",\"end\":\"20170827T235959\"}],\"messages\":[{\"text\":\"Travaux : pas de trafic entre Nation et Gare de Lyon.\",\"channel\":{\"content_type\":\"text/plain\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40962\",\"name\":\"titre\",\"types\":[\"title\"]}}],\"updated_at\":\"20170810T120000\",\"cause\":\"travaux\",\"category\":\"Travaux\",\"impacted_objects\":[{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"code\":\"1\",\"color\":\"FFCD00\"}}},{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\"}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848197\",\"lon\":\"2.395859\"}}}},{\"pt_object\":{\"embedded_type\":\"trip\",\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\",\"quality\":0,\"trip\":{\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\"}},\"impacted_stops\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}},\"cause\":\"travaux\",\"stop_time_effect\":\"deleted\",\"departure_status\":\"deleted\",\"arrival_status\":\"deleted\",\"base_arrival_time\":\"083200\",\"base_departure_time\":\"083230\",\"is_detour\":false}]}]}],\"links\":[],\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":16}}",
+	"networks":             "{\"networks\":[{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"links\":[]}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"physical_modes":       "{\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\",\"commercial_modes\":[{\"id\":\"commercial_mode:Metro\",\"name\":\"Métro\"}]}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"places":               "{\"disruptions\":[],\"feed_publishers\":[{\"id\":\"RAT\",\"license\":\"navitia.io\",\"name\":\"RAT - RATP Paris Metro\",\"url\":\"www.navitia.io\"}],\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/poi_types/{poi_type.id}\",\"rel\":\"poi_types\",\"templated\":true,\"type\":\"poi_type\"},{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/stop_areas/{stop_area.id}\",\"rel\":\"stop_areas\",\"templated\":true,\"type\":\"stop_area\"},{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/pois/{poi.id}\",\"rel\":\"pois\",\"templated\":true,\"type\":\"poi\"},{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/addresses/{address.id}\",\"rel\":\"addresses\",\"templated\":true,\"type\":\"address\"}],\"places\":[{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:RDBAC\",\"name\":\"Rue du Bac (Paris)\",\"quality\":70,\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"RATRDBAC\"},{\"type\":\"source\",\"value\":\"RDBAC\"}],\"coord\":{\"lat\":\"48.855756\",\"lon\":\"2.325569\"},\"id\":\"stop_area:RAT:SA:RDBAC\",\"label\":\"Rue du Bac (Paris)\",\"links\":[],\"name\":\"Rue du Bac\",\"timezone\":\"Europe/Paris\"}},{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:MKFDO\",\"name\":\"Malakoff \\u2014 Rue Etienne Dolet (Malakoff)\",\"quality\":60,\"stop_area\":{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.817406\",\"lon\":\"2.297158\"},\"id\":\"admin:fr:92046\",\"insee\":\"92046\",\"label\":\"Malakoff (92240)\",\"level\":8,\"name\":\"Malakoff\",\"zip_code\":\"92240\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"RATMKFDO\"},{\"type\":\"source\",\"value\":\"MKFDO\"}],\"coord\":{\"lat\":\"48.814668\",\"lon\":\"2.296999\"},\"id\":\"stop_area:RAT:SA:MKFDO\",\"label\":\"Malakoff \\u2014 Rue Etienne Dolet (Malakoff)\",\"links\":[],\"name\":\"Malakoff \\u2014 Rue Etienne Dolet\",\"timezone\":\"Europe/Paris\"}},{\"embedded_type\":\"poi\",\"id\":\"poi:n682262148\",\"name\":\"Rue Chabanais (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8669921\",\"lon\":\"2.3366321\"},\"house_number\":1,\"id\":\"2.3366321;48.8669921\",\"label\":\"1 Rue Chabanais (Paris)\",\"name\":\"Rue Chabanais\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8669921\",\"lon\":\"2.3366321\"},\"id\":\"poi:n682262148\",\"label\":\"Rue Chabanais (Paris)\",\"name\":\"Rue Chabanais\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"name\":\"Rue Chabanais\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"02007\",\"source\":\"cadastre-dgi-fr source : Direction G\\u00e9n\\u00e9rale des Imp\\u00f4ts - Cadastre. Mise \\u00e0 jour : 2010\"}},\"quality\":80},{\"embedded_type\":\"poi\",\"id\":\"poi:n639606894\",\"name\":\"Rue Moncey (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8801859\",\"lon\":\"2.3312932\"},\"house_number\":2,\"id\":\"2.3312932;48.8801859\",\"label\":\"2 Rue Moncey (Paris)\",\"name\":\"Rue Moncey\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8801859\",\"lon\":\"2.3312932\"},\"id\":\"poi:n639606894\",\"label\":\"Rue Moncey (Paris)\",\"name\":\"Rue Moncey\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"N/A\",\"name\":\"Rue Moncey\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"wheelchair\":\"no\"}},\"quality\":80},{\"embedded_type\":\"poi\",\"id\":\"poi:n597860967\",\"name\":\"Rue montgallet (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.844328\",\"lon\":\"2.3896931\"},\"house_number\":39,\"id\":\"2.3896931;48.844328\",\"label\":\"39 Rue Montgallet (Paris)\",\"name\":\"Rue Montgallet\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.844328\",\"lon\":\"2.3896931\"},\"id\":\"poi:n597860967\",\"label\":\"Rue montgallet (Paris)\",\"name\":\"Rue montgallet\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"16\",\"name\":\"Rue montgallet\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"12013\"}},\"quality\":80},{\"embedded_type\":\"poi\",\"id\":\"poi:n439912307\",\"name\":\"Hittorf - Rue Hittorf - 75010 Paris (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8720809\",\"lon\":\"2.3576446\"},\"house_number\":14,\"id\":\"2.3576446;48.8720809\",\"label\":\"14 Rue Hittorf (Paris)\",\"name\":\"Rue Hittorf\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8720809\",\"lon\":\"2.3576446\"},\"id\":\"poi:n439912307\",\"label\":\"Hittorf - Rue Hittorf - 75010 Paris (Paris)\",\"name\":\"Hittorf - Rue Hittorf - 75010 Paris\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"17\",\"name\":\"Hittorf - Rue Hittorf - 75010 Paris\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"10009\"}},\"quality\":70},{\"embedded_type\":\"poi\",\"id\":\"poi:n272853107\",\"name\":\"Rue de Siam (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.861679\",\"lon\":\"2.2753896\"},\"house_number\":1,\"id\":\"2.2753896;48.861679\",\"label\":\"1 Rue de Siam (Paris)\",\"name\":\"Rue de Siam\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.861679\",\"lon\":\"2.2753896\"},\"id\":\"poi:n272853107\",\"label\":\"Rue de Siam (Paris)\",\"name\":\"Rue de Siam\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"16\",\"name\":\"Rue de Siam\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"16017\",\"source\":\"survey\"}},\"quality\":70},{\"embedded_type\":\"poi\",\"id\":\"poi:n340402115\",\"name\":\"Rue des Boulets (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8521875\",\"lon\":\"2.3889688\"},\"house_number\":45,\"id\":\"2.3889688;48.8521875\",\"label\":\"45 Rue des Boulets (Paris)\",\"name\":\"Rue des Boulets\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8521875\",\"lon\":\"2.3889688\"},\"id\":\"poi:n340402115\",\"label\":\"Rue des Boulets (Paris)\",\"name\":\"Rue des Boulets\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"23\",\"name\":\"Rue des Boulets\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"11009\"}},\"quality\":70},{\"embedded_type\":\"poi\",\"id\":\"poi:n272852792\",\"name\":\"Rue Fran\\u00e7ois Ponsard (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8583046\",\"lon\":\"2.2742742\"},\"house_number\":4,\"id\":\"2.2742742;48.8583046\",\"label\":\"4 Chauss\\u00e9e de la Muette (Paris)\",\"name\":\"Chauss\\u00e9e de la Muette\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8583046\",\"lon\":\"2.2742742\"},\"id\":\"poi:n272852792\",\"label\":\"Rue Fran\\u00e7ois Ponsard (Paris)\",\"name\":\"Rue Fran\\u00e7ois Ponsard\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"23\",\"name\":\"Rue Fran\\u00e7ois Ponsard\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"16021\"}},\"quality\":70},{\"embedded_type\":\"poi\",\"id\":\"poi:n439919694\",\"name\":\"Beaubourg - 46 Rue Beaubourg - 75003 Paris (Paris)\",\"poi\":{\"address\":{\"coord\":{\"lat\":\"48.8610006\",\"lon\":\"2.353484\"},\"house_number\":26,\"id\":\"2.353484;48.8610006\",\"label\":\"26 Rue Geoffroy l'Angevin (Paris)\",\"name\":\"Rue Geoffroy l'Angevin\"},\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"coord\":{\"lat\":\"48.8610006\",\"lon\":\"2.353484\"},\"id\":\"poi:n439919694\",\"label\":\"Beaubourg - 46 Rue Beaubourg - 75003 Paris (Paris)\",\"name\":\"Beaubourg - 46 Rue Beaubourg - 75003 Paris\",\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"amenity\":\"bicycle_rental\",\"capacity\":\"18\",\"name\":\"Beaubourg - 46 Rue Beaubourg - 75003 Paris\",\"network\":\"V\\u00e9lib'\",\"operator\":\"JCDecaux\",\"ref\":\"3010\"}},\"quality\":60}]}",
+	"places_nearby":        "{\"places_nearby\":[{\"id\":\"stop_point:RAT:SP:NATIO1\",\"name\":\"Nation (Paris)\",\"embedded_type\":\"stop_point\",\"quality\":0,\"distance\":\"42\",\"stop_point\":{\"id\":\"stop_point:RAT:SP:NATIO1\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lon\":\"2.39594\",\"lat\":\"48.84823\"}}},{\"id\":\"poi:osm:node:2396817261\",\"name\":\"Station Vélib' Place de la Nation (Paris)\",\"embedded_type\":\"poi\",\"quality\":0,\"distance\":\"118\",\"poi\":{\"id\":\"poi:osm:node:2396817261\",\"name\":\"Station Vélib' Place de la Nation\",\"label\":\"Station Vélib' Place de la Nation (Paris)\",\"coord\":{\"lon\":\"2.39594\",\"lat\":\"48.84823\"},\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"}}}],\"pagination\":{\"start_page\":1,\"items_on_page\":2,\"items_per_page\":2,\"total_result\":9},\"links\":[]}",
+	"poi_types":            "{\"poi_types\":[{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},{\"id\":\"poi_type:amenity:parking\",\"name\":\"Parking\"}],\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":2}}",
+	"pois":                 "{\"pois\":[{\"id\":\"poi:osm:node:2396817261\",\"name\":\"Station Vélib' Place de la Nation\",\"label\":\"Station Vélib' Place de la Nation (Paris)\",\"coord\":{\"lon\":\"2.39594\",\"lat\":\"48.84823\"},\"poi_type\":{\"id\":\"poi_type:amenity:bicycle_rental\",\"name\":\"Station VLS\"},\"properties\":{\"capacity\":\"42\",\"network\":\"Vélib' Métropole\",\"ref\":\"12109\"},\"stands\":{\"available_places\":17,\"available_bikes\":23,\"total_stands\":42,\"status\":\"open\"},\"administrative_regions\":[]},{\"id\":\"poi:osm:way:85413372\",\"name\":\"Parc relais Nation\",\"label\":\"Parc relais Nation (Paris)\",\"coord\":{\"lon\":\"2.39712\",\"lat\":\"48.84701\"},\"poi_type\":{\"id\":\"poi_type:amenity:parking\",\"name\":\"Parking\"},\"properties\":{\"capacity\":\"350\",\"park_ride\":\"yes\"},\"address\":{\"id\":\"2.39712;48.84701\",\"name\":\"Place de la Nation\",\"label\":\"Place de la Nation (Paris)\",\"house_number\":0,\"coord\":{\"lon\":\"2.39712\",\"lat\":\"48.84701\"}}}],\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":2},\"links\":[]}",
+	"pt_objects":           "{\"pt_objects\":[{\"id\":\"line:RAT:M14\",\"name\":\"RATP Métro 14 (Saint-Lazare - Olympiades)\",\"embedded_type\":\"line\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\",\"text_color\":\"FFFFFF\",\"commercial_mode\":{\"id\":\"commercial_mode:Metro\",\"name\":\"Métro\"},\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}],\"network\":{\"id\":\"network:RAT:1\",\"name\":\"RATP\"},\"routes\":[],\"links\":[]}},{\"id\":\"route:RAT:M14:1\",\"name\":\"Métro 14 (Olympiades)\",\"embedded_type\":\"route\",\"quality\":0,\"route\":{\"id\":\"route:RAT:M14:1\",\"name\":\"Saint-Lazare - Olympiades\",\"is_frequence\":\"False\",\"direction\":{\"id\":\"stop_area:RAT:SA:OLYMP\",\"name\":\"Olympiades (Paris)\",\"embedded_type\":\"stop_area\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:OLYMP\",\"name\":\"Olympiades\",\"label\":\"Olympiades (Paris)\",\"coord\":{\"lon\":\"2.366\",\"lat\":\"48.826\"}}}}},{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"embedded_type\":\"network\",\"quality\":0,\"network\":{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"links\":[]}}],\"links\":[]}",
+	"route_schedules":      "{\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":10,\"total_result\":1},\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/routes/{route.id}\",\"type\":\"route\",\"rel\":\"routes\",\"templated\":true}],\"disruptions\":[],\"notes\":[],\"feed_publishers\":[],\"exceptions\":[],\"route_schedules\":[{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Charles de Gaulle - Etoile - Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"table\":{\"headers\":[{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-100\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-101\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-102\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-103\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-104\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-105\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-106\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-107\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-108\",\"rel\":\"vehicle_journeys\",\"templated\":false}]},{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"additional_informations\":[\"regular\"],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:RATRM6-109\",\"rel\":\"vehicle_journeys\",\"templated\":false}]}],\"rows\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:DAUM1\",\"name\":\"Daumesnil\",\"label\":\"Daumesnil (Paris)\",\"coord\":{\"lat\":\"48.839426\",\"lon\":\"2.395839\"},\"equipments\":[],\"links\":[]},\"date_times\":[{\"date_time\":\"20170410T113000\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T114100\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T114900\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T120000\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T121200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T122000\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T122900\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T124100\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T125000\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T130000\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"}]},{\"stop_point\":{\"id\":\"stop_point:RAT:SP:BERC1\",\"name\":\"Bel-Air\",\"label\":\"Bel-Air (Paris)\",\"coord\":{\"lat\":\"48.841457\",\"lon\":\"2.400892\"},\"equipments\":[],\"links\":[]},\"date_times\":[{\"date_time\":\"20170410T113200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T114300\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T115100\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T120200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"\",\"additional_informations\":[],\"links\":[]},{\"date_time\":\"20170410T122200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T123100\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T124300\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T125200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"},{\"date_time\":\"20170410T130200\",\"additional_informations\":[],\"links\":[],\"data_freshness\":\"base_schedule\"}]}]},\"additional_informations\":null,\"links\":[{\"type\":\"line\",\"id\":\"line:RAT:M6\"},{\"type\":\"route\",\"id\":\"route:RAT:M6\"}]}]}",
+	"routes":               "{\"routes\":[{\"id\":\"route:RAT:M13:1\",\"name\":\"Châtillon-Montrouge - Asnières-Gennevilliers Les Courtilles\",\"is_frequence\":\"False\",\"direction_type\":\"forward\",\"line\":{\"id\":\"line:RAT:M13\",\"name\":\"Châtillon-Montrouge - Saint-Denis-Université / Asnières-Gennevilliers Les Courtilles\",\"code\":\"13\",\"color\":\"6EC4E8\",\"text_color\":\"000000\"},\"direction\":{\"id\":\"stop_area:RAT:SA:COURT\",\"name\":\"Asnières-Gennevilliers Les Courtilles (Asnières-sur-Seine)\",\"embedded_type\":\"stop_area\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:COURT\",\"name\":\"Asnières-Gennevilliers Les Courtilles\",\"label\":\"Asnières-Gennevilliers Les Courtilles (Asnières-sur-Seine)\",\"coord\":{\"lon\":\"2.284\",\"lat\":\"48.931\"}}},\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}],\"geojson\":{\"type\":\"MultiLineString\",\"coordinates\":[[[2.3013,48.8107],[2.3158,48.8397],[2.3256,48.8756],[2.3157,48.8972],[2.284,48.931]]]},\"links\":[]},{\"id\":\"route:RAT:M13:2\",\"name\":\"Châtillon-Montrouge - Saint-Denis-Université\",\"is_frequence\":\"False\",\"direction_type\":\"forward\",\"line\":{\"id\":\"line:RAT:M13\",\"name\":\"Châtillon-Montrouge - Saint-Denis-Université / Asnières-Gennevilliers Les Courtilles\",\"code\":\"13\",\"color\":\"6EC4E8\",\"text_color\":\"000000\"},\"direction\":{\"id\":\"stop_area:RAT:SA:STDUN\",\"name\":\"Saint-Denis-Université (Saint-Denis)\",\"embedded_type\":\"stop_area\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:STDUN\",\"name\":\"Saint-Denis-Université\",\"label\":\"Saint-Denis-Université (Saint-Denis)\",\"coord\":{\"lon\":\"2.364\",\"lat\":\"48.946\"}}},\"physical_modes\":[{\"id\":\"physical_mode:Metro\",\"name\":\"Métro\"}],\"geojson\":{\"type\":\"MultiLineString\",\"coordinates\":[[[2.3013,48.8107],[2.3158,48.8397],[2.3256,48.8756],[2.359,48.936],[2.364,48.946]]]},\"links\":[]}],\"disruptions\":[],\"links\":[],\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":25,\"total_result\":2}}",
+	"stop_areas":           "{\"disruptions\":[],\"feed_publishers\":[{\"id\":\"RAT\",\"license\":\"navitia.io\",\"name\":\"RAT - RATP Paris Metro\",\"url\":\"www.navitia.io\"}],\"links\":[{\"href\":\"https://api.navitia.io/v1/coverage/sandbox/stop_areas/{stop_areas.id}\",\"templated\":true,\"type\":\"stop_areas\",\"rel\":\"stop_areas\"}],\"pagination\":{\"items_on_page\":1,\"items_per_page\":25,\"start_page\":0,\"total_result\":1},\"stop_areas\":[{\"administrative_regions\":[{\"coord\":{\"lat\":\"48.856609\",\"lon\":\"2.351499\"},\"id\":\"admin:fr:75056\",\"insee\":\"75056\",\"label\":\"Paris\",\"level\":8,\"name\":\"Paris\",\"zip_code\":\"\"}],\"codes\":[{\"type\":\"external_code\",\"value\":\"RATRDBAC\"},{\"type\":\"gtfs_stop_code\",\"value\":\"1757\"},{\"type\":\"source\",\"value\":\"RDBAC\"}],\"coord\":{\"lat\":\"48.855756\",\"lon\":\"2.325569\"},\"id\":\"stop_area:RAT:SA:RDBAC\",\"label\":\"Rue du Bac (Paris)\",\"links\":[],\"name\":\"Rue du Bac\",\"timezone\":\"Europe/Paris\"}]}",
+	"stop_points":          "{\"stop_points\":[{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844652\",\"lon\":\"2.373236\"},\"stop_area\":{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844652\",\"lon\":\"2.373236\"}}}],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"stop_schedules":       "{\"pagination\":{\"start_page\":0,\"items_on_page\":2,\"items_per_page\":10,\"total_result\":2},\"stop_schedules\":[{\"display_informations\":{\"direction\":\"Nation (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Charles de Gaulle - Etoile - Nation\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"stop_point\":{\"id\":\"stop_point:RAT:SP:DAUM1\",\"name\":\"Daumesnil\",\"label\":\"Daumesnil (Paris)\",\"coord\":{\"lat\":\"48.839426\",\"lon\":\"2.395839\"},\"equipments\":[],\"links\":[]},\"route\":{\"id\":\"route:RAT:M6_R\",\"name\":\"Charles de Gaulle - Etoile - Nation\",\"is_frequence\":\"False\",\"links\":[],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848135\",\"lon\":\"2.395906\"},\"links\":[],\"timezone\":\"Europe/Paris\"}}},\"additional_informations\":null,\"date_times\":[{\"date_time\":\"20170427T170300\",\"base_date_time\":\"20170427T170300\",\"data_freshness\":\"realtime\",\"additional_informations\":[],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:20170427T170300\",\"rel\":\"vehicle_journeys\",\"templated\":false,\"internal\":true}]},{\"date_time\":\"20170427T170700\",\"base_date_time\":\"20170427T170700\",\"data_freshness\":\"realtime\",\"additional_informations\":[],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:20170427T170700\",\"rel\":\"vehicle_journeys\",\"templated\":false,\"internal\":true}]},{\"date_time\":\"20170427T171100\",\"base_date_time\":\"20170427T171100\",\"data_freshness\":\"base_schedule\",\"additional_informations\":[],\"links\":[{\"type\":\"vehicle_journey\",\"id\":\"vehicle_journey:RAT:20170427T171100\",\"rel\":\"vehicle_journeys\",\"templated\":false,\"internal\":true}]}],\"links\":[{\"type\":\"line\",\"id\":\"line:RAT:M6\"},{\"type\":\"route\",\"id\":\"route:RAT:M6_R\"}],\"first_datetime\":null,\"last_datetime\":null},{\"display_informations\":{\"direction\":\"Charles de Gaulle - Etoile (Paris)\",\"code\":\"6\",\"network\":\"RATP\",\"links\":[],\"color\":\"75C695\",\"commercial_mode\":\"Metro\",\"text_color\":\"000000\",\"physical_mode\":\"Métro\",\"headsign\":\"Nation - Charles de Gaulle - Etoile\",\"label\":\"6\",\"equipments\":[],\"name\":\"Charles de Gaulle - Etoile - Nation\",\"description\":\"\"},\"stop_point\":{\"id\":\"stop_point:RAT:SP:DAUM1\",\"name\":\"Daumesnil\",\"label\":\"Daumesnil (Paris)\",\"coord\":{\"lat\":\"48.839426\",\"lon\":\"2.395839\"},\"equipments\":[],\"links\":[]},\"route\":{\"id\":\"route:RAT:M6\",\"name\":\"Nation - Charles de Gaulle - Etoile\",\"is_frequence\":\"False\",\"links\":[],\"direction\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848135\",\"lon\":\"2.395906\"},\"links\":[],\"timezone\":\"Europe/Paris\"}}},\"additional_informations\":\"no_departure_this_day\",\"date_times\":[],\"links\":[{\"type\":\"line\",\"id\":\"line:RAT:M6\"},{\"type\":\"route\",\"id\":\"route:RAT:M6\"}],\"first_datetime\":null,\"last_datetime\":null}],\"links\":[],\"disruptions\":[],\"notes\":[],\"feed_publishers\":[],\"exceptions\":[]}",
+	"traffic_reports":      "{\"traffic_reports\":[{\"network\":{\"id\":\"network:RAT:1\",\"name\":\"RATP\",\"links\":[]},\"lines\":[{\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"code\":\"1\",\"color\":\"FFCD00\",\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]},{\"id\":\"line:RAT:M6\",\"name\":\"Nation - Charles de Gaulle Etoile\",\"code\":\"6\",\"color\":\"6ECA97\",\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"4f2a91c0-8e10-11e7-b3d2-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]},{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\",\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]}],\"stop_areas\":[{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lon\":\"2.373\",\"lat\":\"48.844\"},\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]},{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lon\":\"2.396\",\"lat\":\"48.848\"},\"links\":[{\"internal\":true,\"type\":\"disruption\",\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"rel\":\"disruptions\",\"templated\":false}]}]}],\"disruptions\":[{\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"disruption_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"impact_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b87\",\"status\":\"active\",\"severity\":{\"name\":\"trip canceled\",\"effect\":\"NO_SERVICE\",\"color\":\"FF0000\",\"priority\":4},\"application_periods\":[{\"begin\":\"20170819T000000\",\"end\":\"20170827T235959\"}],\"messages\":[{\"text\":\"Travaux : pas de trafic entre Nation et Gare de Lyon.\",\"channel\":{\"content_type\":\"text/plain\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40962\",\"name\":\"titre\",\"types\":[\"title\"]}}],\"updated_at\":\"20170810T120000\",\"cause\":\"travaux\",\"category\":\"Travaux\",\"impacted_objects\":[{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"code\":\"1\",\"color\":\"FFCD00\"}}},{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\"}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848197\",\"lon\":\"2.395859\"}}}},{\"pt_object\":{\"embedded_type\":\"trip\",\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\",\"quality\":0,\"trip\":{\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\"}},\"impacted_stops\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}},\"cause\":\"travaux\",\"stop_time_effect\":\"deleted\",\"departure_status\":\"deleted\",\"arrival_status\":\"deleted\",\"base_arrival_time\":\"083200\",\"base_departure_time\":\"083230\",\"is_detour\":false}]}]},{\"id\":\"4f2a91c0-8e10-11e7-b3d2-005056a47b86\",\"disruption_id\":\"4f2a91c0-8e10-11e7-b3d2-005056a47b86\",\"impact_id\":\"4f2a91c0-8e10-11e7-b3d2-005056a47b87\",\"status\":\"active\",\"severity\":{\"name\":\"perturbation\",\"effect\":\"SIGNIFICANT_DELAYS\",\"color\":\"FF9900\",\"priority\":20},\"application_periods\":[{\"begin\":\"20170821T070000\",\"end\":\"20170821T110000\"}],\"messages\":[{\"text\":\"Trafic perturbé sur la ligne 6 en raison d'un incident technique.\",\"channel\":{\"content_type\":\"text/plain\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40962\",\"name\":\"titre\",\"types\":[\"title\"]}},{\"text\":\"<p>Trafic perturbé sur la ligne 6 en raison d'un <b>incident technique</b>.</p>\",\"channel\":{\"content_type\":\"text/html\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40963\",\"name\":\"web\",\"types\":[\"web\"]}}],\"updated_at\":\"20170821T071500\",\"cause\":\"incident technique\",\"category\":\"Incidents\",\"impacted_objects\":[{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M6\",\"name\":\"Nation - Charles de Gaulle Etoile\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M6\",\"name\":\"Nation - Charles de Gaulle Etoile\",\"code\":\"6\",\"color\":\"6ECA97\"}}}]}],\"links\":[],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+	"vehicle_journeys":     "{\"vehicle_journeys\":[{\"id\":\"vehicle_journey:RAT:RATRM14REGA9128-1_dst_2\",\"name\":\"RATRM14REGA9128\",\"headsign\":\"Olympiades\",\"codes\":[{\"type\":\"source\",\"value\":\"RATRM14REGA9128-1\"}],\"disruptions\":[{\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"internal\":true,\"rel\":\"disruptions\",\"templated\":false,\"type\":\"disruption\"}],\"calendars\":[],\"stop_times\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lon\":\"2.373\",\"lat\":\"48.844\"},\"links\":[]},\"arrival_time\":\"082500\",\"departure_time\":\"082500\",\"utc_arrival_time\":\"072500\",\"utc_departure_time\":\"072500\",\"headsign\":\"Olympiades\",\"pickup_allowed\":true,\"drop_off_allowed\":false},{\"stop_point\":{\"id\":\"stop_point:RAT:SP:BERCY1\",\"name\":\"Bercy\",\"label\":\"Bercy (Paris)\",\"coord\":{\"lon\":\"2.379\",\"lat\":\"48.840\"},\"links\":[]},\"arrival_time\":\"082700\",\"departure_time\":\"082700\",\"utc_arrival_time\":\"072700\",\"utc_departure_time\":\"072700\",\"headsign\":\"Olympiades\",\"pickup_allowed\":true,\"drop_off_allowed\":true},{\"stop_point\":{\"id\":\"stop_point:RAT:SP:COUST1\",\"name\":\"Cour Saint-Émilion\",\"label\":\"Cour Saint-Émilion (Paris)\",\"coord\":{\"lon\":\"2.387\",\"lat\":\"48.833\"},\"links\":[]},\"arrival_time\":\"082830\",\"departure_time\":\"082830\",\"utc_arrival_time\":\"072830\",\"utc_departure_time\":\"072830\",\"headsign\":\"Olympiades\",\"pickup_allowed\":true,\"drop_off_allowed\":true},{\"stop_point\":{\"id\":\"stop_point:RAT:SP:BNFMI1\",\"name\":\"Bibliothèque François Mitterrand\",\"label\":\"Bibliothèque François Mitterrand (Paris)\",\"coord\":{\"lon\":\"2.376\",\"lat\":\"48.829\"},\"links\":[]},\"arrival_time\":\"083000\",\"departure_time\":\"083000\",\"utc_arrival_time\":\"073000\",\"utc_departure_time\":\"073000\",\"headsign\":\"Olympiades\",\"pickup_allowed\":false,\"drop_off_allowed\":true}],\"validity_pattern\":{\"beginning_date\":\"20170814\",\"days\":\"0000001111111\"},\"journey_pattern\":{\"id\":\"journey_pattern:RAT:M14:1\",\"name\":\"journey_pattern:RAT:M14:1\"},\"trip\":{\"id\":\"RATRM14REGA9128-1\",\"name\":\"RATRM14REGA9128\"}}],\"disruptions\":[{\"id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"disruption_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b86\",\"impact_id\":\"9b7c3f2e-8d41-11e7-a2c4-005056a47b87\",\"status\":\"active\",\"severity\":{\"name\":\"trip canceled\",\"effect\":\"NO_SERVICE\",\"color\":\"FF0000\",\"priority\":4},\"application_periods\":[{\"begin\":\"20170819T000000\",\"end\":\"20170827T235959\"}],\"messages\":[{\"text\":\"Travaux : pas de trafic entre Nation et Gare de Lyon.\",\"channel\":{\"content_type\":\"text/plain\",\"id\":\"d7cc9b64-6c8c-11e5-b6d9-005056a40962\",\"name\":\"titre\",\"types\":[\"title\"]}}],\"updated_at\":\"20170810T120000\",\"cause\":\"travaux\",\"category\":\"Travaux\",\"impacted_objects\":[{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M1\",\"name\":\"Château de Vincennes - La Défense\",\"code\":\"1\",\"color\":\"FFCD00\"}}},{\"pt_object\":{\"embedded_type\":\"line\",\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"quality\":0,\"line\":{\"id\":\"line:RAT:M14\",\"name\":\"Saint-Lazare - Olympiades\",\"code\":\"14\",\"color\":\"62259D\"}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:GDLYO\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}}}},{\"pt_object\":{\"embedded_type\":\"stop_area\",\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation (Paris)\",\"quality\":0,\"stop_area\":{\"id\":\"stop_area:RAT:SA:NATIO\",\"name\":\"Nation\",\"label\":\"Nation (Paris)\",\"coord\":{\"lat\":\"48.848197\",\"lon\":\"2.395859\"}}}},{\"pt_object\":{\"embedded_type\":\"trip\",\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\",\"quality\":0,\"trip\":{\"id\":\"RATRM1REGA4213\",\"name\":\"RATRM1REGA4213\"}},\"impacted_stops\":[{\"stop_point\":{\"id\":\"stop_point:RAT:SP:GDLYO1\",\"name\":\"Gare de Lyon\",\"label\":\"Gare de Lyon (Paris)\",\"coord\":{\"lat\":\"48.844705\",\"lon\":\"2.374066\"}},\"cause\":\"travaux\",\"stop_time_effect\":\"deleted\",\"departure_status\":\"deleted\",\"arrival_status\":\"deleted\",\"base_arrival_time\":\"083200\",\"base_departure_time\":\"083230\",\"is_detour\":false}]}]}],\"links\":[],\"pagination\":{\"start_page\":0,\"items_on_page\":1,\"items_per_page\":25,\"total_result\":1}}",
+}
@@ -0,0 +1,106 @@
+//go:build ignore
+// +build ignore
+
+// mkfixtures generates fixtures.go from the responses recorded in the testdata directory of the navitia package,
+// and from those written by hand in navitiatest/testdata for the endpoints without recorded ones.
+//
+// Run it with go generate from the navitiatest directory.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// recorded maps the endpoints to the response recorded for them, in the testdata directory of the navitia package
+var recorded = map[string]string{
+	"access_points":        "access_points/correct/montparnasse.json",
+	"arrivals":             "arrivals/correct/shannon.json",
+	"calendars":            "calendars/correct/weekdays.json",
+	"coverage":             "coverage/correct/fr-idf.json",
+	"datasets":             "datasets/correct/two_datasets.json",
+	"departures":           "departures/correct/shannon.json",
+	"disruptions":          "disruptions/correct/multiple_impacts.json",
+	"equipment_reports":    "equipment_reports/correct/rer_a.json",
+	"freefloatings_nearby": "free_floatings/correct/paris.json",
+	"journeys":             "journeys/correct/b.json",
+	"lines":                "lines/correct/metro.json",
+	"places":               "places/correct/a.json",
+	"places_nearby":        "places_nearby/correct/nation.json",
+	"pois":                 "pois/correct/bike_share.json",
+	"pt_objects":           "pt_objects/correct/metro_14.json",
+	"route_schedules":      "route_schedules/correct/regular.json",
+	"routes":               "routes/correct/metro13.json",
+	"stop_areas":           "codes/correct/gtfs_stop_area.json",
+	"stop_schedules":       "stop_schedules/correct/daumesnil.json",
+	"traffic_reports":      "traffic_reports/correct/ratp.json",
+	"vehicle_journeys":     "vehicle_journeys/correct/m14.json",
+}
+
+func main() {
+	fixtures := make(map[string][]byte)
+	for endpoint, path := range recorded {
+		b, err := ioutil.ReadFile(filepath.Join("..", "testdata", path))
+		if err != nil {
+			fail(err)
+		}
+		fixtures[endpoint] = b
+	}
+
+	written, err := filepath.Glob(filepath.Join("testdata", "*.json"))
+	if err != nil {
+		fail(err)
+	}
+	for _, path := range written {
+		endpoint := strings.TrimSuffix(filepath.Base(path), ".json")
+		if _, ok := fixtures[endpoint]; ok {
+			fail(fmt.Errorf("%s has both a recorded and a written fixture", endpoint))
+		}
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			fail(err)
+		}
+		fixtures[endpoint] = b
+	}
+
+	endpoints := make([]string, 0, len(fixtures))
+	for endpoint := range fixtures {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by mkfixtures.go. DO NOT EDIT.\n\n")
+	buf.WriteString("package navitiatest\n\n")
+	buf.WriteString("// fixtures are the canned responses of the Server, by endpoint\n")
+	buf.WriteString("var fixtures = map[string]string{\n")
+	for _, endpoint := range endpoints {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, fixtures[endpoint]); err != nil {
+			fail(fmt.Errorf("invalid fixture for %s: %v", endpoint, err))
+		}
+		fmt.Fprintf(&buf, "\t%q: %s,\n", endpoint, strconv.Quote(compact.String()))
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		fail(err)
+	}
+	if err := ioutil.WriteFile("fixtures.go", src, 0644); err != nil {
+		fail(err)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintf(os.Stderr, "mkfixtures: %v\n", err)
+	os.Exit(1)
+}
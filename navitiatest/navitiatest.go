@@ -0,0 +1,167 @@
+// Package navitiatest provides an in-process Navitia API answering with canned responses, for testing code built on package navitia
+// without an API key nor network access.
+//
+// Every endpoint supported by package navitia is answered with a response recorded from navitia.io, or written by hand
+// when none was recorded, such as:
+//
+//	func TestNextDeparture(t *testing.T) {
+//		session := navitiatest.NewSession(t)
+//		res, err := session.Scope("fr-idf").Departures(context.Background(), navitia.DeparturesRequest{})
+//		...
+//	}
+//
+// Responses can be replaced endpoint by endpoint, such as to test how errors are handled, see Server.SetFixture & Server.SetError.
+package navitiatest
+
+//go:generate go run mkfixtures.go
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/govitia/navitia"
+)
+
+// A response is a response given by the Server for an endpoint
+type response struct {
+	status int
+	body   []byte
+}
+
+// A Server is a local HTTP server answering the requests of a navitia.Session with canned responses,
+// each endpoint being answered with its fixture whatever the region, object or parameters requested.
+// The endpoint of a request is the last one in its path with a fixture or a response set,
+// such as "departures" for /coverage/fr-idf/stop_areas/stop_area:RAT:SA:GDLYO/departures,
+// "coverage" for requests on regions, and "coords" for the address at a position.
+// Requests to an endpoint without fixture are answered with a 404 "unknown_api" error.
+//
+// It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+	t testing.TB
+
+	mu        sync.Mutex
+	responses map[string]response
+	requests  []*http.Request
+}
+
+// NewServer starts a Server, which is closed when the test ends.
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{t: t, responses: make(map[string]response)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// NewSession starts a Server and returns a Session whose requests are answered by it, see Server.Session.
+func NewSession(t testing.TB, opts ...navitia.Option) *navitia.Session {
+	t.Helper()
+	return NewServer(t).Session(opts...)
+}
+
+// Session returns a Session, created with the given options, whose requests are answered by the server.
+// The test fails if it can't be created.
+func (s *Server) Session(opts ...navitia.Option) *navitia.Session {
+	s.t.Helper()
+
+	session, err := navitia.NewCustom("", s.URL, s.Client(), opts...)
+	if err != nil {
+		s.t.Fatalf("navitiatest: error while creating the session: %v", err)
+	}
+	return session
+}
+
+// SetFixture replaces the response to the requests to an endpoint, such as "journeys", with the given JSON.
+func (s *Server) SetFixture(endpoint string, body []byte) {
+	s.mu.Lock()
+	s.responses[endpoint] = response{status: http.StatusOK, body: body}
+	s.mu.Unlock()
+}
+
+// SetError makes the server answer the requests to an endpoint with an error of the API,
+// such as SetError("journeys", http.StatusNotFound, navitia.RemoteErrNoSolution, "no solution found for this journey").
+func (s *Server) SetError(endpoint string, status int, id navitia.RemoteErrorID, message string) {
+	s.mu.Lock()
+	s.responses[endpoint] = response{status: status, body: errorBody(id, message)}
+	s.mu.Unlock()
+}
+
+// Requests returns the requests received by the server so far, in the order they were received.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*http.Request(nil), s.requests...)
+}
+
+// serveHTTP answers a request with the response set for its endpoint, or its fixture
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	endpoint := pathEndpoint(r.URL.Path, func(name string) bool {
+		_, set := s.responses[name]
+		_, fixed := fixtures[name]
+		return set || fixed
+	})
+	s.requests = append(s.requests, r)
+	resp, ok := s.responses[endpoint]
+	s.mu.Unlock()
+
+	if !ok {
+		if body, found := Fixture(endpoint); found {
+			resp = response{status: http.StatusOK, body: body}
+		} else {
+			resp = response{status: http.StatusNotFound, body: errorBody(navitia.RemoteErrUnknownAPI, "no fixture for the requested endpoint")}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	_, _ = w.Write(resp.body)
+}
+
+// errorBody returns the JSON of an error of the API
+func errorBody(id navitia.RemoteErrorID, message string) []byte {
+	body, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]string{"id": string(id), "message": message},
+	})
+	return body
+}
+
+// pathEndpoint returns the last known endpoint of a request path, or an empty string if there is none.
+// Requests scoped to a region aren't requests on the region, so they are only answered with the "coverage" fixture
+// if the region is the last segment of the path.
+func pathEndpoint(path string, known func(string) bool) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		if !known(segments[i]) {
+			continue
+		}
+		if segments[i] == "coverage" && i < len(segments)-2 {
+			return ""
+		}
+		return segments[i]
+	}
+	return ""
+}
+
+// Fixture returns the canned response to the requests to an endpoint, such as "journeys".
+func Fixture(endpoint string) ([]byte, bool) {
+	body, ok := fixtures[endpoint]
+	return []byte(body), ok
+}
+
+// Endpoints lists the endpoints with a fixture, sorted.
+func Endpoints() []string {
+	endpoints := make([]string, 0, len(fixtures))
+	for endpoint := range fixtures {
+		endpoints = append(endpoints, endpoint)
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
@@ -0,0 +1,153 @@
+package navitiatest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia"
+	"github.com/govitia/navitia/types"
+)
+
+// TestServer_Endpoints checks that the fixture of every endpoint is decoded by the matching request
+func TestServer_Endpoints(t *testing.T) {
+	ctx := context.Background()
+	session := NewSession(t)
+	scope := session.Scope("fr-idf")
+	coords := types.Coordinates{Latitude: 48.845, Longitude: 2.373}
+
+	requests := map[string]func() error{
+		"access_points": func() error { _, err := scope.AccessPoints(ctx, navitia.CollectionRequest{}); return err },
+		"address":       func() error { _, err := scope.AddressAt(ctx, coords); return err },
+		"arrivals":      func() error { _, err := scope.Arrivals(ctx, navitia.ArrivalsRequest{}); return err },
+		"calendars":     func() error { _, err := scope.Calendars(ctx, navitia.CalendarsRequest{}); return err },
+		"commercial_modes": func() error {
+			_, err := scope.CommercialModes(ctx, navitia.CollectionRequest{})
+			return err
+		},
+		"companies": func() error { _, err := scope.Companies(ctx, navitia.CollectionRequest{}); return err },
+		"connections": func() error {
+			_, err := scope.DeparturesSA(ctx, navitia.ConnectionsRequest{}, "stop_area:RAT:SA:GDLYO")
+			return err
+		},
+		"contributors": func() error { _, err := scope.Contributors(ctx, navitia.CollectionRequest{}); return err },
+		"datasets":     func() error { _, err := scope.Datasets(ctx, navitia.CollectionRequest{}); return err },
+		"departures":   func() error { _, err := scope.Departures(ctx, navitia.DeparturesRequest{}); return err },
+		"disruptions":  func() error { _, err := scope.Disruptions(ctx, navitia.DisruptionsRequest{}); return err },
+		"equipment_reports": func() error {
+			_, err := scope.EquipmentReports(ctx, navitia.EquipmentReportsRequest{})
+			return err
+		},
+		"free_floatings": func() error {
+			_, err := scope.FreeFloatingsNearby(ctx, coords, navitia.FreeFloatingsRequest{})
+			return err
+		},
+		"isochrones": func() error {
+			_, err := scope.Isochrones(ctx, navitia.IsochroneRequest{From: "stop_area:RAT:SA:GDLYO"})
+			return err
+		},
+		"journeys": func() error {
+			_, err := scope.Journeys(ctx, navitia.JourneyRequest{From: "stop_area:RAT:SA:GDLYO"})
+			return err
+		},
+		"lines":          func() error { _, err := scope.Lines(ctx, navitia.LinesRequest{}); return err },
+		"networks":       func() error { _, err := scope.Networks(ctx, navitia.CollectionRequest{}); return err },
+		"physical_modes": func() error { _, err := scope.PhysicalModes(ctx, navitia.CollectionRequest{}); return err },
+		"places":         func() error { _, err := scope.Places(ctx, navitia.PlacesRequest{Query: "gare"}); return err },
+		"places_nearby": func() error {
+			_, err := scope.PlacesNearby(ctx, coords, navitia.PlacesNearbyRequest{})
+			return err
+		},
+		"pois":       func() error { _, err := scope.POIs(ctx, navitia.POIsRequest{}); return err },
+		"poi_types":  func() error { _, err := scope.POITypes(ctx, navitia.CollectionRequest{}); return err },
+		"pt_objects": func() error { _, err := scope.PTObjects(ctx, "metro", navitia.PTObjectsRequest{}); return err },
+		"regions":    func() error { _, err := session.Regions(ctx, navitia.RegionRequest{}); return err },
+		"route_schedules": func() error {
+			_, err := scope.RouteSchedules(ctx, navitia.RouteSchedulesRequest{Line: "line:RAT:M1"})
+			return err
+		},
+		"routes":     func() error { _, err := scope.Routes(ctx, navitia.RoutesRequest{}); return err },
+		"stop_areas": func() error { _, err := scope.StopAreas(ctx, navitia.CollectionRequest{}); return err },
+		"stop_point": func() error { _, err := scope.StopPoint(ctx, "stop_point:RAT:SP:GDLYO1"); return err },
+		"stop_schedules": func() error {
+			_, err := scope.StopSchedules(ctx, navitia.StopSchedulesRequest{}, "stop_area:RAT:SA:GDLYO")
+			return err
+		},
+		"traffic_reports": func() error {
+			_, err := scope.TrafficReports(ctx, navitia.TrafficReportsRequest{})
+			return err
+		},
+		"vehicle_journeys": func() error {
+			_, err := scope.VehicleJourneys(ctx, navitia.VehicleJourneyRequest{})
+			return err
+		},
+	}
+	for name, req := range requests {
+		if err := req(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+	}
+}
+
+func TestServer_SetError(t *testing.T) {
+	srv := NewServer(t)
+	srv.SetError("journeys", http.StatusNotFound, navitia.RemoteErrNoSolution, "no solution found for this journey")
+
+	_, err := srv.Session().Journeys(context.Background(), navitia.JourneyRequest{From: "stop_area:RAT:SA:GDLYO"})
+	if !errors.Is(err, navitia.ErrNoSolution) {
+		t.Errorf("expected a no_solution error, got %v", err)
+	}
+
+	// Endpoints without fixture are unknown
+	resp, err := srv.Client().Get(srv.URL + "/coverage/fr-idf/unknown")
+	if err != nil {
+		t.Fatalf("error while requesting an unknown endpoint: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a 404 for an endpoint without fixture, got %s", resp.Status)
+	}
+}
+
+func TestServer_SetFixture(t *testing.T) {
+	srv := NewServer(t)
+	srv.SetFixture("lines", []byte(`{"lines": [{"id": "line:RAT:M14", "name": "14"}]}`))
+
+	res, err := srv.Session().Scope("fr-idf").Lines(context.Background(), navitia.LinesRequest{Count: 1})
+	if err != nil {
+		t.Fatalf("error in Lines: %v", err)
+	}
+	if len(res.Lines) != 1 || res.Lines[0].ID != "line:RAT:M14" {
+		t.Errorf("unexpected lines: %v", res.Lines)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 1 {
+		t.Fatalf("expected 1 request, got %d", len(reqs))
+	}
+	if reqs[0].URL.Path != "/coverage/fr-idf/lines" || reqs[0].URL.Query().Get("count") != "1" {
+		t.Errorf("unexpected request: %s", reqs[0].URL)
+	}
+}
+
+func Test_pathEndpoint(t *testing.T) {
+	known := func(name string) bool {
+		_, ok := fixtures[name]
+		return ok
+	}
+	for path, want := range map[string]string{
+		"/coverage":        "coverage",
+		"/coverage/fr-idf": "coverage",
+		"/coverage/fr-idf/lines/line:RAT:M1/routes":    "routes",
+		"/coverage/fr-idf/coords/2.373;48.845":         "coords",
+		"/coverage/fr-idf/coords/2.373;48.845/pois":    "pois",
+		"/coverage/fr-idf/stop_areas/stop_area:RAT:SA": "stop_areas",
+		"/unknown":                 "",
+		"/coverage/fr-idf/unknown": "",
+	} {
+		if got := pathEndpoint(path, known); got != want {
+			t.Errorf("pathEndpoint(%q): got %q, want %q", path, got, want)
+		}
+	}
+}
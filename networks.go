@@ -0,0 +1,125 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+const (
+	networksEndpoint  string = "networks"
+	companiesEndpoint string = "companies"
+)
+
+// NetworksResults holds the results of a networks request.
+type NetworksResults struct {
+	Networks   []types.Network `json:"networks"`
+	Paging     Paging          `json:"links"`
+	Pagination Pagination      `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of networks available in a NetworksResults
+func (nr *NetworksResults) Count() int {
+	return len(nr.Networks)
+}
+
+// TotalAvailable returns the total number of networks available across all pages.
+func (nr *NetworksResults) TotalAvailable() int {
+	return nr.Pagination.total(len(nr.Networks))
+}
+
+func (nr *NetworksResults) paging() Paging {
+	return nr.Paging
+}
+
+func (nr *NetworksResults) newPage() pagedResults {
+	return &NetworksResults{session: nr.session}
+}
+
+func (nr *NetworksResults) merge(page pagedResults) {
+	next := page.(*NetworksResults)
+	nr.Networks = append(nr.Networks, next.Networks...)
+	nr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the networks, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (nr *NetworksResults) ForEach(ctx context.Context, f func(network types.Network) error) error {
+	return walkPages(ctx, nr.session, nr, func(page pagedResults) error {
+		for _, network := range page.(*NetworksResults).Networks {
+			if err := f(network); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CompaniesResults holds the results of a companies request.
+type CompaniesResults struct {
+	Companies  []types.Company `json:"companies"`
+	Paging     Paging          `json:"links"`
+	Pagination Pagination      `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of companies available in a CompaniesResults
+func (cr *CompaniesResults) Count() int {
+	return len(cr.Companies)
+}
+
+// TotalAvailable returns the total number of companies available across all pages.
+func (cr *CompaniesResults) TotalAvailable() int {
+	return cr.Pagination.total(len(cr.Companies))
+}
+
+func (cr *CompaniesResults) paging() Paging {
+	return cr.Paging
+}
+
+func (cr *CompaniesResults) newPage() pagedResults {
+	return &CompaniesResults{session: cr.session}
+}
+
+func (cr *CompaniesResults) merge(page pagedResults) {
+	next := page.(*CompaniesResults)
+	cr.Companies = append(cr.Companies, next.Companies...)
+	cr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the companies, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (cr *CompaniesResults) ForEach(ctx context.Context, f func(company types.Company) error) error {
+	return walkPages(ctx, cr.session, cr, func(page pagedResults) error {
+		for _, company := range page.(*CompaniesResults).Companies {
+			if err := f(company); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Networks lists the networks of the region, such as "RATP" or "Transilien".
+// Their IDs can then be used to restrict other requests, for example through their Forbidden parameter.
+func (scope *Scope) Networks(ctx context.Context, req CollectionRequest) (*NetworksResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + networksEndpoint
+
+	results := &NetworksResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// Companies lists the companies operating the public transport of the region.
+func (scope *Scope) Companies(ctx context.Context, req CollectionRequest) (*CompaniesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + companiesEndpoint
+
+	results := &CompaniesResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
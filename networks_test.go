@@ -0,0 +1,55 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_Networks checks that the networks of a region are listed with the collection parameters
+func Test_Scope_Networks(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/networks"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if got := r.URL.Query()["forbidden_uris[]"]; len(got) != 1 || got[0] != "network:SNCF" {
+			t.Errorf("unexpected forbidden uris: %v", got)
+		}
+		_, _ = w.Write([]byte(`{
+			"networks": [
+				{"id": "network:RAT:1", "name": "RATP", "links": []},
+				{"id": "network:OIF:439", "name": "Noctilien", "links": []}
+			],
+			"pagination": {"start_page": 0, "items_on_page": 2, "items_per_page": 25, "total_result": 2},
+			"links": []
+		}`))
+	}))
+
+	res, err := session.Scope("fr-idf").Networks(context.Background(), CollectionRequest{Forbidden: []types.ID{"network:SNCF"}})
+	if err != nil {
+		t.Fatalf("error in Networks: %v", err)
+	}
+	if res.Count() != 2 || res.Networks[0].Name != "RATP" {
+		t.Errorf("unexpected networks: %+v", res.Networks)
+	}
+}
+
+// Test_Scope_Companies checks that the companies of a region are listed
+func Test_Scope_Companies(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/companies"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		_, _ = w.Write([]byte(`{"companies": [{"id": "company:RAT:1", "name": "RATP"}], "links": []}`))
+	}))
+
+	res, err := session.Scope("fr-idf").Companies(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in Companies: %v", err)
+	}
+	if res.Count() != 1 || res.Companies[0].ID != "company:RAT:1" {
+		t.Errorf("unexpected companies: %+v", res.Companies)
+	}
+}
@@ -0,0 +1,170 @@
+package navitia
+
+import "time"
+
+// An Option configures a Session, it is given to New or NewCustom.
+type Option func(*Session)
+
+// WithPreferRealtime makes the Session prefer realtime data for schedule-type requests (departures, arrivals, stop & route schedules),
+// while still returning base schedule data when realtime data isn't available.
+//
+// When the request doesn't specify a data freshness, it is first made with data_freshness=realtime. It is then made again
+// with data_freshness=base_schedule if, and only if, the realtime request:
+//   - failed as no realtime data is available: a RemoteError with a 404 status, or an ID such as RemoteErrUnknownAPI
+//     (realtime not being available in the region) or RemoteErrDateOutOfBounds
+//   - or succeeded with no results
+//
+// Other errors, such as invalid requests, authentication errors, transport errors and context cancellation,
+// are returned as-is, without falling back.
+//
+// Requests explicitly specifying a data freshness are left untouched.
+func WithPreferRealtime() Option {
+	return func(s *Session) {
+		s.preferRealtime = true
+	}
+}
+
+// WithMaxConcurrency bounds the number of requests the Session has in flight simultaneously to n,
+// protecting both the client's resources and the API from bursts of requests.
+// This is independent of any rate limiting.
+//
+// A request waits for a slot before being sent, and releases it once its response is fully read.
+// While waiting, the request's context is respected: if it is cancelled, the request returns its error without being sent.
+//
+// If n is zero or negative, the concurrency isn't bounded.
+func WithMaxConcurrency(n int) Option {
+	return func(s *Session) {
+		if n > 0 {
+			s.slots = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithHTTPClient makes the Session send its requests through the given client, such as an *http.Client with a custom transport,
+// instead of the one given to NewCustom or the default one used by New.
+//
+// A nil client is ignored, while a nil *http.Client makes New & NewCustom return an error.
+func WithHTTPClient(client Doer) Option {
+	return func(s *Session) {
+		if client != nil {
+			s.client = client
+			s.chain = nil
+		}
+	}
+}
+
+// WithTimeout bounds the duration of each request made by the Session to d, from waiting for a concurrency slot to reading the response.
+// Each request derives a child context with that timeout from the one it is given, so an earlier deadline of the latter still applies.
+//
+// If d is zero or negative, requests are only bounded by their context.
+func WithTimeout(d time.Duration) Option {
+	return func(s *Session) {
+		if d > 0 {
+			s.timeout = d
+		}
+	}
+}
+
+// WithMaxRequestsPerSecond spaces the Session's requests out so that no more than n are sent per second,
+// such as for batch jobs staying under the API's rate limit.
+// A request waits for its turn before being sent, respecting its context.
+//
+// If n is zero or negative, requests aren't limited.
+func WithMaxRequestsPerSecond(n float64) Option {
+	return func(s *Session) {
+		if n > 0 {
+			s.limiter = &limiter{interval: time.Duration(float64(time.Second) / n)}
+		}
+	}
+}
+
+// WithRateLimitRetries makes the Session retry requests rejected by the API's rate limiting (429 Too Many Requests) up to n times.
+// Before each retry it waits as told by the response's Retry-After header, or else for a delay starting at one second
+// and doubling with each retry.
+//
+// Once out of retries, the *RemoteError of the last response is returned.
+func WithRateLimitRetries(n int) Option {
+	return func(s *Session) {
+		s.rateLimitRetries = n
+	}
+}
+
+// WithRetryPolicy makes the Session retry requests failing transiently as told by policy, see RetryPolicy.
+// The retries are bounded by the request's context, including the timeout set by WithTimeout.
+//
+// Once out of attempts, the last response or error is returned.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(s *Session) {
+		s.retry = &policy
+	}
+}
+
+// WithCache makes the Session cache successful responses in cache, so that identical requests are answered without reaching the API.
+// ttl returns how long the responses of an endpoint (such as "departures") are cached for, if it is nil DefaultCacheTTL is used.
+// Responses with a zero or negative duration aren't cached.
+//
+// Once stale, a response that came with an ETag or Last-Modified header is revalidated with a conditional request,
+// the cached response being used again if the API answers 304 Not Modified.
+//
+// As entries are keyed by request URL only, a Cache shouldn't be shared between sessions using API keys with different access.
+func WithCache(cache Cache, ttl func(endpoint string) time.Duration) Option {
+	return func(s *Session) {
+		if ttl == nil {
+			ttl = DefaultCacheTTL
+		}
+		s.cache = cache
+		s.cacheTTL = ttl
+	}
+}
+
+// WithRawResponse makes the Session keep the raw JSON body of each response on the results decoded from it, see Logging.Raw.
+// This is useful to reproduce decoding issues or to ingest the data as is, at the cost of holding the body in memory along with the results.
+func WithRawResponse() Option {
+	return func(s *Session) {
+		s.keepRaw = true
+	}
+}
+
+// WithRegionTimezones makes the Session set the datetimes of the results scoped to a region in the timezone of that region,
+// as navitia gives them in the local time of the region without any offset: without it, they are decoded as UTC.
+// Likewise, the datetimes of the requests are converted to the local time of the region, rather than sent on their own wall clock.
+//
+// The timezone of each region is fetched with an additional request the first time the region is used,
+// and cached for the lifetime of the Session, see Session.RegionLocation.
+// If it can't be fetched, the request fails with the error.
+func WithRegionTimezones() Option {
+	return func(s *Session) {
+		s.localTimes = true
+	}
+}
+
+// MaxDepth is the deepest level of embedded objects the API gives, see WithDepth
+const MaxDepth uint = 3
+
+// WithDepth sets the depth of the objects embedded in the responses to every request not setting its own, such as with LinesRequest.Depth.
+// Depths above MaxDepth are lowered to it.
+//
+// The depth controls how much of the related objects is given along each object:
+//   - 0 gives only the objects themselves, such as lines without their routes nor network
+//   - 1, the API's default, adds their direct relations, such as the routes, network & modes of a line
+//   - 2 adds the relations of these, such as the stop areas of the routes of a line
+//   - 3 adds everything the API knows of, such as the stop points of these stop areas
+//
+// Lower depths make for much smaller & faster responses, which matters on mobile backends.
+func WithDepth(depth uint) Option {
+	return func(s *Session) {
+		if depth > MaxDepth {
+			depth = MaxDepth
+		}
+		s.depth, s.hasDepth = depth, true
+	}
+}
+
+// WithoutGeoJSON disables the GeoJSON data, such as the shapes of lines or the paths of sections, in the responses to every request
+// not asking for it explicitly, such as with PTObjectsRequest.Geo.
+// As GeoJSON is often the largest part of a response, this cuts down its size a lot when the shapes aren't displayed.
+func WithoutGeoJSON() Option {
+	return func(s *Session) {
+		s.noGeoJSON = true
+	}
+}
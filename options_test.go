@@ -0,0 +1,328 @@
+package navitia
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_WithPreferRealtime checks that a session preferring realtime falls back to base schedule data
+// in a region without realtime.
+func Test_WithPreferRealtime(t *testing.T) {
+	fixture := testData["connections"].correct["shannon.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	// realtimeAnswers are the possible answers of a region without realtime
+	realtimeAnswers := map[string]func(w http.ResponseWriter){
+		"error": func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "no realtime data available"}`))
+		},
+		"empty": func(w http.ResponseWriter) {
+			_, _ = w.Write([]byte(`{"departures": [], "links": []}`))
+		},
+	}
+
+	for name, answer := range realtimeAnswers {
+		answer := answer
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu        sync.Mutex
+				freshness []string
+			)
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				f := r.URL.Query().Get("data_freshness")
+				mu.Lock()
+				freshness = append(freshness, f)
+				mu.Unlock()
+
+				if f == string(types.DataFreshnessRealTime) {
+					answer(w)
+					return
+				}
+				_, _ = w.Write(fixture)
+			})
+
+			session := newMockSession(t, handler, WithPreferRealtime())
+
+			res, err := session.Scope("shannon").DeparturesSA(context.Background(), ConnectionsRequest{}, "stop_area:OEA:SA:1")
+			if err != nil {
+				t.Fatalf("error in DeparturesSA: %v", err)
+			}
+			if len(res.Connections) == 0 {
+				t.Errorf("expected base schedule departures, got none")
+			}
+
+			expected := []string{string(types.DataFreshnessRealTime), string(types.DataFreshnessBaseSchedule)}
+			if len(freshness) != len(expected) || freshness[0] != expected[0] || freshness[1] != expected[1] {
+				t.Errorf("unexpected sequence of requested data freshness: %v, expected %v", freshness, expected)
+			}
+		})
+	}
+
+	// An explicitly requested freshness must be left untouched
+	t.Run("explicit", func(t *testing.T) {
+		var requests int
+		session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if f := r.URL.Query().Get("data_freshness"); f != string(types.DataFreshnessBaseSchedule) {
+				t.Errorf("unexpected data freshness %q", f)
+			}
+			_, _ = w.Write(fixture)
+		}), WithPreferRealtime())
+
+		req := ConnectionsRequest{Freshness: types.DataFreshnessBaseSchedule}
+		if _, err := session.Scope("shannon").DeparturesSA(context.Background(), req, "stop_area:OEA:SA:1"); err != nil {
+			t.Fatalf("error in DeparturesSA: %v", err)
+		}
+		if requests != 1 {
+			t.Errorf("expected a single request, got %d", requests)
+		}
+	})
+
+	// Errors not caused by missing realtime data must be returned without falling back
+	for _, status := range []int{http.StatusBadRequest, http.StatusUnauthorized} {
+		status := status
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			var requests int
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte(`{"id": "bad_format", "message": "invalid parameter"}`))
+			}), WithPreferRealtime())
+
+			_, err := session.Scope("shannon").DeparturesSA(context.Background(), ConnectionsRequest{}, "stop_area:OEA:SA:1")
+			var remote *RemoteError
+			if !errors.As(err, &remote) || remote.StatusCode != status {
+				t.Errorf("expected a remote error with status %d, got %v", status, err)
+			}
+			if requests != 1 {
+				t.Errorf("expected a single request, got %d", requests)
+			}
+		})
+	}
+}
+
+// Test_WithMaxConcurrency checks that the number of requests in flight never exceeds the bound
+func Test_WithMaxConcurrency(t *testing.T) {
+	const (
+		max      = 3
+		requests = 30
+	)
+
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxSeen {
+			maxSeen = inFlight
+		}
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithMaxConcurrency(max))
+
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+				t.Errorf("error in Regions: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxSeen > max {
+		t.Errorf("%d requests were in flight simultaneously, expected at most %d", maxSeen, max)
+	}
+
+	// Waiting for a slot respects the context
+	session.slots <- struct{}{}
+	session.slots <- struct{}{}
+	session.slots <- struct{}{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := session.Regions(ctx, RegionRequest{}); err != context.DeadlineExceeded {
+		t.Errorf("expected the context's error while waiting for a slot, got %v", err)
+	}
+}
+
+// Test_WithTimeout checks that a request taking longer than the timeout is cancelled, while the timeout is reset for each request
+func Test_WithTimeout(t *testing.T) {
+	var slow bool
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slow {
+			select {
+			case <-time.After(250 * time.Millisecond):
+			case <-r.Context().Done():
+			}
+		}
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithTimeout(50*time.Millisecond))
+
+	for i := 0; i < 2; i++ {
+		if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+			t.Fatalf("unexpected error in request #%d: %v", i, err)
+		}
+	}
+
+	slow = true
+	start := time.Now()
+	_, err := session.Regions(context.Background(), RegionRequest{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the request to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Errorf("the request wasn't cancelled in time: took %s", elapsed)
+	}
+}
+
+// doerFunc is a Doer test double
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Test_WithHTTPClient checks that requests go through the injected client
+func Test_WithHTTPClient(t *testing.T) {
+	var requested []string
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.String())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"regions": [{"id": "fr-idf"}]}`)),
+			Request:    req,
+		}, nil
+	})
+
+	session, err := New("key", WithHTTPClient(doer))
+	if err != nil {
+		t.Fatalf("error in New: %v", err)
+	}
+	res, err := session.Regions(context.Background(), RegionRequest{})
+	if err != nil {
+		t.Fatalf("error in Regions: %v", err)
+	}
+
+	if len(requested) != 1 || requested[0] != defaultAPIURL+"/coverage?disable_geojson=true" {
+		t.Errorf("unexpected requests: %v", requested)
+	}
+	if len(res.Regions) != 1 || res.Regions[0].ID != "fr-idf" {
+		t.Errorf("unexpected regions: %+v", res.Regions)
+	}
+}
+
+// Test_NewCustom_NilClient checks that a nil Doer falls back to the default client, while a nil *http.Client is rejected
+func Test_NewCustom_NilClient(t *testing.T) {
+	session, err := NewCustom("key", defaultAPIURL, nil)
+	if err != nil {
+		t.Fatalf("error in NewCustom: %v", err)
+	}
+	if session.client != defaultClient {
+		t.Errorf("expected the default client, got %v", session.client)
+	}
+
+	var client *http.Client
+	if _, err := NewCustom("key", defaultAPIURL, client); err == nil {
+		t.Errorf("expected an error for a nil *http.Client")
+	}
+	if _, err := New("key", WithHTTPClient(client)); err == nil {
+		t.Errorf("expected an error for a nil *http.Client given WithHTTPClient")
+	}
+}
+
+// Test_WithRawResponse checks that the raw body is kept on the results only if asked to, including when answered from the cache
+func Test_WithRawResponse(t *testing.T) {
+	const body = `{"regions": [{"id": "fr-idf"}]}`
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(body))
+	})
+	ctx := context.Background()
+
+	res, err := newMockSession(t, handler).Regions(ctx, RegionRequest{})
+	if err != nil {
+		t.Fatalf("error in Regions: %v", err)
+	}
+	if res.Raw() != nil {
+		t.Errorf("expected no raw body by default, got %q", res.Raw())
+	}
+
+	session := newMockSession(t, handler, WithRawResponse(), WithCache(NewMemoryCache(), nil))
+	for i := 0; i < 2; i++ {
+		res, err := session.Regions(ctx, RegionRequest{})
+		if err != nil {
+			t.Fatalf("error in Regions: %v", err)
+		}
+		if string(res.Raw()) != body {
+			t.Errorf("unexpected raw body (request %d): got %q, expected %q", i, res.Raw(), body)
+		}
+	}
+}
+
+// Test_WithDepth_WithoutGeoJSON checks that the session-wide depth & GeoJSON settings are added to every request,
+// without overriding the ones set by the request itself.
+func Test_WithDepth_WithoutGeoJSON(t *testing.T) {
+	var queries []url.Values
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.Query())
+		_, _ = w.Write([]byte(`{}`))
+	}), WithDepth(5), WithoutGeoJSON())
+	scope := session.Scope("fr-idf")
+	ctx := context.Background()
+
+	if _, err := scope.Journeys(ctx, JourneyRequest{From: "stop_area:RAT:SA:NATIO", To: "stop_area:RAT:SA:GDLYO"}); err != nil {
+		t.Fatalf("error in Journeys: %v", err)
+	}
+	if _, err := scope.Lines(ctx, LinesRequest{Depth: uintPtr(1)}); err != nil {
+		t.Fatalf("error in Lines: %v", err)
+	}
+	if _, err := scope.PTObjects(ctx, "metro", PTObjectsRequest{Geo: true}); err != nil {
+		t.Fatalf("error in PTObjects: %v", err)
+	}
+	if _, err := scope.Routes(ctx, RoutesRequest{Depth: uintPtr(0)}); err != nil {
+		t.Fatalf("error in Routes: %v", err)
+	}
+	if _, err := scope.Departures(ctx, DeparturesRequest{Depth: uintPtr(2)}); err != nil {
+		t.Fatalf("error in Departures: %v", err)
+	}
+
+	expected := []struct{ depth, disableGeoJSON string }{
+		{"3", "true"},
+		{"1", "true"},
+		{"3", "false"},
+		{"0", "true"},
+		{"2", "true"},
+	}
+	if len(queries) != len(expected) {
+		t.Fatalf("expected %d requests, got %d", len(expected), len(queries))
+	}
+	for i, e := range expected {
+		if got := queries[i].Get("depth"); got != e.depth {
+			t.Errorf("request %d: unexpected depth: got %q, expected %q", i, got, e.depth)
+		}
+		if got := queries[i].Get("disable_geojson"); got != e.disableGeoJSON {
+			t.Errorf("request %d: unexpected disable_geojson: got %q, expected %q", i, got, e.disableGeoJSON)
+		}
+	}
+}
@@ -5,22 +5,25 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
 )
 
-// Paging holds potential Previous / Next functions
+// ErrNoPage is returned when following a page the API gave no link to, such as the next page of the last one
+var ErrNoPage = errors.New("no such page")
+
+// Paging holds potential Previous / Next functions.
+// A nil function means the API gave no link to that page.
 type Paging struct {
 	// Next results
 	Next func(ctx context.Context, s *Session, res results) error
 
 	// Previous results
 	Previous func(ctx context.Context, s *Session, res results) error
-}
 
-type link struct {
-	Href      string
-	Rel       string
-	Templated bool
-	Type      string
+	// First and Last results
+	First func(ctx context.Context, s *Session, res results) error
+	Last  func(ctx context.Context, s *Session, res results) error
 }
 
 // createPagingFunc creates a paging func (either Previous or Next)
@@ -33,7 +36,7 @@ func createPagingFunc(url string) func(ctx context.Context, s *Session, res resu
 
 // UnmarshalJSON unmarshals a Paging type from a Links data structure
 func (p *Paging) UnmarshalJSON(b []byte) error {
-	var links []link
+	var links types.Links
 	err := json.Unmarshal(b, &links)
 	if err != nil {
 		return errors.Wrap(err, "error while unmarshalling links")
@@ -44,10 +47,101 @@ func (p *Paging) UnmarshalJSON(b []byte) error {
 		switch l.Type {
 		case "next":
 			p.Next = createPagingFunc(l.Href)
-		case "previous":
+		case "previous", "prev": // Journeys use "prev"
 			p.Previous = createPagingFunc(l.Href)
+		case "first":
+			p.First = createPagingFunc(l.Href)
+		case "last":
+			p.Last = createPagingFunc(l.Href)
 		}
 	}
 
 	return nil
 }
+
+// pagedResults is implemented by results which can be walked through page by page, see walkPages
+type pagedResults interface {
+	results
+	paging() Paging
+
+	// newPage creates the empty results a following page is decoded into
+	newPage() pagedResults
+}
+
+// walkPages calls visit with the given page, then with each following one, fetched with the session as needed.
+//
+// It stops once there's no next page, or at the first error, either from visit or from fetching a page.
+func walkPages(ctx context.Context, s *Session, page pagedResults, visit func(page pagedResults) error) error {
+	for {
+		if err := visit(page); err != nil {
+			return err
+		}
+
+		next := page.paging().Next
+		if next == nil {
+			return nil
+		}
+		if s == nil {
+			return errors.New("can't fetch the next page of results not obtained from a session")
+		}
+
+		page = page.newPage()
+		if err := next(ctx, s, page); err != nil {
+			return errors.Wrap(err, "error while fetching the next page")
+		}
+	}
+}
+
+// defaultMaxPages is the maximum amount of pages fetched for a request with AllPages set, if it doesn't give its own
+const defaultMaxPages uint = 100
+
+// errPageCap stops mergePages once the maximum amount of pages is reached
+var errPageCap = errors.New("maximum amount of pages reached")
+
+// mergeableResults is implemented by paged results whose following pages can be merged into them
+type mergeableResults interface {
+	pagedResults
+
+	// merge appends the objects of a following page, and takes over its paging
+	merge(page pagedResults)
+}
+
+// mergePages fetches the pages following the given results, up to maxPages pages in total (or defaultMaxPages if zero),
+// and merges them into the results.
+func mergePages(ctx context.Context, s *Session, res mergeableResults, maxPages uint) error {
+	if maxPages == 0 {
+		maxPages = defaultMaxPages
+	}
+
+	var pages uint
+	err := walkPages(ctx, s, res, func(page pagedResults) error {
+		if page != res {
+			res.merge(page)
+		}
+		if pages++; pages >= maxPages {
+			return errPageCap
+		}
+		return nil
+	})
+	if err == errPageCap {
+		return nil
+	}
+	return err
+}
+
+// Pagination holds the pagination information given by the API along paginated results
+type Pagination struct {
+	TotalResult  int `json:"total_result"`   // Total number of results available, across all pages
+	StartPage    int `json:"start_page"`     // Index of the current page, starting at 0
+	ItemsPerPage int `json:"items_per_page"` // Number of items per page, see the Count field of requests
+	ItemsOnPage  int `json:"items_on_page"`  // Number of items on the current page
+}
+
+// total returns the total number of results available, given the number of results held.
+// If no pagination was given, only the held results are known to be available.
+func (p Pagination) total(held int) int {
+	if p.TotalResult < held {
+		return held
+	}
+	return p.TotalResult
+}
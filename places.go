@@ -9,10 +9,11 @@ import (
 
 const placesEndpoint = "places"
 
-// PlacesResults doesn't have pagination, as the remote API doesn't support it.
+// PlacesResults doesn't have paging links, as the remote API doesn't support it, but it may report the total number of results, see TotalAvailable.
 // PlacesResults can be sorted, it implements sort.Interface.
 type PlacesResults struct {
-	Places []types.Container `json:"places"`
+	Places     []types.Container `json:"places"`
+	Pagination Pagination        `json:"pagination"`
 
 	Logging `json:"-"`
 
@@ -24,6 +25,12 @@ func (pr *PlacesResults) Len() int {
 	return len(pr.Places)
 }
 
+// TotalAvailable returns the total number of places available across all pages, such as for showing "10 of 340".
+// It is at least the number of places held.
+func (pr *PlacesResults) TotalAvailable() int {
+	return pr.Pagination.total(pr.Len())
+}
+
 // Less reports if the quality of the Place with the index i is less than that of the Place with the index j
 // Note: In most use cases, that's the opposite of the desired behaviour, so simply use sort.Reverse and ta-da !
 func (pr *PlacesResults) Less(i, j int) bool {
@@ -35,41 +42,44 @@ func (pr *PlacesResults) Swap(i, j int) {
 	pr.Places[i], pr.Places[j] = pr.Places[j], pr.Places[i]
 }
 
+// PlaceTypeXXX are the types of places that can be searched, see PlacesRequest.Types
+const (
+	PlaceTypeStopArea  = types.EmbeddedStopArea
+	PlaceTypeStopPoint = types.EmbeddedStopPoint
+	PlaceTypeAddress   = types.EmbeddedAddress
+	PlaceTypePOI       = types.EmbeddedPOI
+	PlaceTypeAdmin     = types.EmbeddedAdmin
+)
+
 // PlacesRequest is the query you need to build before passing it to Places
 type PlacesRequest struct {
-	Query string // The search item
+	Query string `param:"q"` // The search item
 
-	// Types are the type of objects to query
-	// It can either be a stop_area, an address, a poi or an administrative_region
-	Types []string
+	// Types are the type of objects to query, see the PlaceTypeXXX constants.
+	// If empty, all types are searched.
+	Types []string `param:"type[]"`
 
 	// If given it will filter the search by specific admin uris
-	AdminURI []string
+	AdminURI []string `param:"admin_uri[]"`
 
 	// Enables GeoJSON data in the reply. GeoJSON objects can be VERY large ! >1MB.
-	Geo bool
+	Geo bool `param:"disable_geojson,negate"`
 
 	// If given, it will prioritise objects around these coordinates
-	Around types.Coordinates
+	Around types.Coordinates `param:"from"`
 
 	// Maximum amount of results
-	Count uint
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
 }
 
 // toURL formats a Places request to url
 func (req PlacesRequest) toURL() (url.Values, error) {
 	rb := utils.NewRequestBuilder()
-
-	rb.AddString("q", req.Query)
-	rb.AddStringSlice("type[]", req.Types)
-	rb.AddStringSlice("admin_uri[]", req.AdminURI)
-
-	if !req.Geo {
-		rb.AddString("disable_geojson", "true")
-	}
-
-	if req.Count != 0 {
-		rb.AddUInt("count", req.Count)
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
 	}
 	return rb.Values(), nil
 }
@@ -0,0 +1,93 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const placesNearbyEndpoint string = "places_nearby"
+
+// PlacesNearbyResults holds the results of a places nearby request, closest first.
+// The distance of each place is given by its types.Container.Distance.
+type PlacesNearbyResults struct {
+	Places     []types.Container `json:"places_nearby"`
+	Paging     Paging            `json:"links"`
+	Pagination Pagination        `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of places available in a PlacesNearbyResults
+func (pnr *PlacesNearbyResults) Count() int {
+	return len(pnr.Places)
+}
+
+// TotalAvailable returns the total number of places nearby available across all pages.
+func (pnr *PlacesNearbyResults) TotalAvailable() int {
+	return pnr.Pagination.total(len(pnr.Places))
+}
+
+// PlacesNearbyRequest contains the optional parameters for a places nearby request.
+type PlacesNearbyRequest struct {
+	// Maximum distance of the places, in meters (default 500)
+	Distance uint `param:"distance"`
+
+	// Types are the type of objects to query, such as types.EmbeddedStopPoint or types.EmbeddedPOI
+	Types []string `param:"type[]"`
+
+	// Filter restricts the places with a navitia filter
+	Filter string `param:"filter"`
+
+	// Maximum amount of places per page
+	Count uint `param:"count"`
+
+	// StartPage is the index of the requested page, starting at 0
+	StartPage uint `param:"start_page"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+}
+
+func (req PlacesNearbyRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// placesNearby is the internal function used by PlacesNearby functions
+func (s *Session) placesNearby(ctx context.Context, url string, req PlacesNearbyRequest) (*PlacesNearbyResults, error) {
+	results := &PlacesNearbyResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// PlacesNearby lists the places around the given coordinates, closest first, such as the stop points within walking distance.
+func (scope *Scope) PlacesNearby(ctx context.Context, coords types.Coordinates, req PlacesNearbyRequest) (*PlacesNearbyResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/coords/" + coords.String() + "/" + placesNearbyEndpoint
+
+	return scope.session.placesNearby(ctx, reqURL, req)
+}
+
+// PlacesNearbyObject lists the places around an object, closest first.
+// The object is given by its embedded type (such as types.EmbeddedStopArea) and ID, as found in a types.Container.
+//
+// It returns an error if places nearby can't be requested around that type of object.
+func (scope *Scope) PlacesNearbyObject(ctx context.Context, embeddedType string, id types.ID, req PlacesNearbyRequest) (*PlacesNearbyResults, error) {
+	path, err := objectPath(embeddedType, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't request places nearby")
+	}
+
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + path + "/" + placesNearbyEndpoint
+
+	return scope.session.placesNearby(ctx, reqURL, req)
+}
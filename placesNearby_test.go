@@ -0,0 +1,86 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_PlacesNearbyResults_Unmarshal tests unmarshalling for PlacesNearbyResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_PlacesNearbyResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["places_nearby"], reflect.TypeOf(PlacesNearbyResults{}))
+}
+
+// Test_Scope_PlacesNearby checks that places are requested around coordinates with the distance, type & paging parameters,
+// and that their distance is decoded.
+func Test_Scope_PlacesNearby(t *testing.T) {
+	fixture := testData["places_nearby"].correct["nation.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/coords/2.3959;48.8482/places_nearby"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query.Get("distance"); got != "200" {
+			t.Errorf("unexpected distance: %q", got)
+		}
+		if got := query["type[]"]; !reflect.DeepEqual(got, []string{types.EmbeddedStopPoint, types.EmbeddedPOI}) {
+			t.Errorf("unexpected types: %v", got)
+		}
+		if got := query.Get("start_page"); got != "1" {
+			t.Errorf("unexpected start page: %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := PlacesNearbyRequest{
+		Distance:  200,
+		Types:     []string{types.EmbeddedStopPoint, types.EmbeddedPOI},
+		Count:     2,
+		StartPage: 1,
+	}
+	res, err := session.Scope("fr-idf").PlacesNearby(context.Background(), types.Coordinates{Longitude: 2.3959, Latitude: 48.8482}, req)
+	if err != nil {
+		t.Fatalf("error in PlacesNearby: %v", err)
+	}
+
+	if res.Count() != 2 || res.TotalAvailable() != 9 {
+		t.Fatalf("expected 2 places out of 9, got %d out of %d", res.Count(), res.TotalAvailable())
+	}
+	var distances []uint
+	for _, p := range res.Places {
+		distances = append(distances, p.Distance)
+	}
+	if expected := []uint{42, 118}; !reflect.DeepEqual(distances, expected) {
+		t.Errorf("unexpected distances: got %v, expected %v", distances, expected)
+	}
+}
+
+// Test_Scope_PlacesNearbyObject checks that places around an object are requested under its collection,
+// and that unsupported objects are rejected without a request.
+func Test_Scope_PlacesNearbyObject(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/stop_areas/stop_area:RAT:SA:NATIO/places_nearby"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		_, _ = w.Write([]byte(`{"places_nearby": []}`))
+	}))
+	scope := session.Scope("fr-idf")
+
+	if _, err := scope.PlacesNearbyObject(context.Background(), types.EmbeddedStopArea, "stop_area:RAT:SA:NATIO", PlacesNearbyRequest{}); err != nil {
+		t.Errorf("error in PlacesNearbyObject: %v", err)
+	}
+	if _, err := scope.PlacesNearbyObject(context.Background(), types.EmbeddedTrip, "trip:1", PlacesNearbyRequest{}); err == nil {
+		t.Error("expected an error for places nearby a trip")
+	}
+}
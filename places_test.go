@@ -2,6 +2,8 @@ package navitia
 
 import (
 	"context"
+	"encoding/json"
+	"net/url"
 	"reflect"
 	"testing"
 
@@ -51,3 +53,68 @@ func Test_Places(t *testing.T) {
 func Test_PlacesResults_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["places"], reflect.TypeOf(PlacesResults{}))
 }
+
+// Test_PlacesResults_TotalAvailable checks that the total number of results is reported when it is larger than the page
+func Test_PlacesResults_TotalAvailable(t *testing.T) {
+	data := testData["places"].correct["paginated.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	pr := &PlacesResults{}
+	if err := json.Unmarshal(data, pr); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if pr.Len() != 10 {
+		t.Fatalf("expected 10 places on the page, got %d", pr.Len())
+	}
+	if total := pr.TotalAvailable(); total != 340 {
+		t.Errorf("expected 340 places available, got %d", total)
+	}
+
+	// Without pagination, only the places held are known to be available
+	pr.Pagination = Pagination{}
+	if total := pr.TotalAvailable(); total != 10 {
+		t.Errorf("expected 10 places available without pagination, got %d", total)
+	}
+}
+
+// Test_PlacesRequest_toURL checks that the filters, proximity & payload parameters are serialized, and only when set
+func Test_PlacesRequest_toURL(t *testing.T) {
+	t.Parallel()
+
+	values, err := PlacesRequest{Query: "nation"}.toURL()
+	if err != nil {
+		t.Fatalf("error in PlacesRequest.toURL: %v", err)
+	}
+	if expected := (url.Values{"q": {"nation"}, "disable_geojson": {"true"}}); !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values for a simple request: got %v, expected %v", values, expected)
+	}
+
+	req := PlacesRequest{
+		Query:    "nation",
+		Types:    []string{PlaceTypeStopArea, PlaceTypeAddress},
+		AdminURI: []string{"admin:fr:75056"},
+		Geo:      true,
+		Around:   types.Coordinates{Latitude: 48.847002, Longitude: 2.377310},
+		Count:    5,
+		Depth:    uintPtr(2),
+	}
+	values, err = req.toURL()
+	if err != nil {
+		t.Fatalf("error in PlacesRequest.toURL: %v", err)
+	}
+	expected := url.Values{
+		"q":               {"nation"},
+		"type[]":          {"stop_area", "address"},
+		"admin_uri[]":     {"admin:fr:75056"},
+		"from":            {"2.37731;48.847002"},
+		"count":           {"5"},
+		"depth":           {"2"},
+		"disable_geojson": {"false"},
+	}
+	if !reflect.DeepEqual(values, expected) {
+		t.Errorf("unexpected values: got %v, expected %v", values, expected)
+	}
+}
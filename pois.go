@@ -0,0 +1,151 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const (
+	poisEndpoint     string = "pois"
+	poiTypesEndpoint string = "poi_types"
+)
+
+// POIsResults holds the results of a POIs request.
+type POIsResults struct {
+	POIs       []types.POI `json:"pois"`
+	Paging     Paging      `json:"links"`
+	Pagination Pagination  `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of POIs available in a POIsResults
+func (pr *POIsResults) Count() int {
+	return len(pr.POIs)
+}
+
+// TotalAvailable returns the total number of POIs available across all pages.
+func (pr *POIsResults) TotalAvailable() int {
+	return pr.Pagination.total(len(pr.POIs))
+}
+
+func (pr *POIsResults) paging() Paging {
+	return pr.Paging
+}
+
+func (pr *POIsResults) newPage() pagedResults {
+	return &POIsResults{session: pr.session}
+}
+
+// ForEach calls f with each of the POIs, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (pr *POIsResults) ForEach(ctx context.Context, f func(poi types.POI) error) error {
+	return walkPages(ctx, pr.session, pr, func(page pagedResults) error {
+		for _, poi := range page.(*POIsResults).POIs {
+			if err := f(poi); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// POITypesResults holds the results of a POI types request.
+type POITypesResults struct {
+	POITypes   []types.POIType `json:"poi_types"`
+	Paging     Paging          `json:"links"`
+	Pagination Pagination      `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of POI types available in a POITypesResults
+func (ptr *POITypesResults) Count() int {
+	return len(ptr.POITypes)
+}
+
+// TotalAvailable returns the total number of POI types available across all pages.
+func (ptr *POITypesResults) TotalAvailable() int {
+	return ptr.Pagination.total(len(ptr.POITypes))
+}
+
+func (ptr *POITypesResults) paging() Paging {
+	return ptr.Paging
+}
+
+func (ptr *POITypesResults) newPage() pagedResults {
+	return &POITypesResults{session: ptr.session}
+}
+
+func (ptr *POITypesResults) merge(page pagedResults) {
+	next := page.(*POITypesResults)
+	ptr.POITypes = append(ptr.POITypes, next.POITypes...)
+	ptr.Paging = next.Paging
+}
+
+// POIsRequest contains the optional parameters for a POIs request.
+type POIsRequest struct {
+	// Around restricts the POIs to those within Distance of these coordinates
+	Around   types.Coordinates
+	Distance uint `param:"distance"` // In meters
+
+	// Filter restricts the POIs with a navitia filter, such as `poi_type.id=poi_type:amenity:bicycle_rental`
+	Filter string `param:"filter"`
+
+	// Stands requests the realtime availability of bike-share stations, see types.POI.Stands
+	Stands bool `param:"bss_stands"`
+
+	// Maximum amount of POIs per page
+	Count uint `param:"count"`
+
+	// StartPage is the index of the requested page, starting at 0
+	StartPage uint `param:"start_page"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+}
+
+// path returns the path of the coordinates around which the POIs are requested, followed by a slash, if any
+func (req POIsRequest) path() string {
+	if req.Around == (types.Coordinates{}) {
+		return ""
+	}
+	return "coords/" + req.Around.String() + "/"
+}
+
+func (req POIsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// POIs lists the points of interest of the region, such as bike-share stations or park-and-rides,
+// optionally around a position and filtered.
+func (scope *Scope) POIs(ctx context.Context, req POIsRequest) (*POIsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + poisEndpoint
+
+	results := &POIsResults{session: scope.session}
+	err := scope.session.request(ctx, reqURL, req, results)
+	return results, err
+}
+
+// POITypes lists the types of points of interest available in the region, which can be used to filter POIs.
+func (scope *Scope) POITypes(ctx context.Context, req CollectionRequest) (*POITypesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + poiTypesEndpoint
+
+	results := &POITypesResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// POIPlacesNearby lists the places around a point of interest, such as the stop points near a park-and-ride.
+func (scope *Scope) POIPlacesNearby(ctx context.Context, poi types.ID, req PlacesNearbyRequest) (*PlacesNearbyResults, error) {
+	return scope.PlacesNearbyObject(ctx, types.EmbeddedPOI, poi, req)
+}
@@ -0,0 +1,120 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_POIsResults_Unmarshal tests unmarshalling for POIsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_POIsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["pois"], reflect.TypeOf(POIsResults{}))
+}
+
+// Test_Scope_POIs checks that POIs are requested around a position with the distance & filter parameters,
+// and that their stands and properties are decoded.
+func Test_Scope_POIs(t *testing.T) {
+	fixture := testData["pois"].correct["bike_share.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const filter = "poi_type.id=poi_type:amenity:bicycle_rental or poi_type.id=poi_type:amenity:parking"
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/coords/2.3959;48.8482/pois"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query.Get("distance"); got != "300" {
+			t.Errorf("unexpected distance: %q", got)
+		}
+		if got := query.Get("filter"); got != filter {
+			t.Errorf("unexpected filter: %q", got)
+		}
+		if got := query.Get("bss_stands"); got != "true" {
+			t.Errorf("unexpected bss_stands: %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := POIsRequest{
+		Around:   types.Coordinates{Longitude: 2.3959, Latitude: 48.8482},
+		Distance: 300,
+		Filter:   filter,
+		Stands:   true,
+	}
+	res, err := session.Scope("fr-idf").POIs(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in POIs: %v", err)
+	}
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 POIs, got %d", res.Count())
+	}
+
+	station, parking := res.POIs[0], res.POIs[1]
+	if station.Stands == nil || station.Stands.AvailableBikes != 23 || station.Stands.Status != "open" {
+		t.Errorf("unexpected stands: %+v", station.Stands)
+	}
+	if parking.Stands != nil {
+		t.Errorf("expected no stands for a parking, got %+v", parking.Stands)
+	}
+	if parking.Properties["park_ride"] != "yes" || parking.Address == nil {
+		t.Errorf("unexpected parking: %+v", parking)
+	}
+}
+
+// Test_Scope_POITypes checks that the POI types are listed from their own endpoint
+func Test_Scope_POITypes(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/poi_types"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		_, _ = w.Write([]byte(`{"poi_types": [
+			{"id": "poi_type:amenity:bicycle_rental", "name": "Station VLS"},
+			{"id": "poi_type:amenity:parking", "name": "Parking"}
+		]}`))
+	}))
+
+	res, err := session.Scope("fr-idf").POITypes(context.Background(), CollectionRequest{})
+	if err != nil {
+		t.Fatalf("error in POITypes: %v", err)
+	}
+	if res.Count() != 2 || res.POITypes[1].ID != "poi_type:amenity:parking" {
+		t.Errorf("unexpected POI types: %+v", res.POITypes)
+	}
+}
+
+// Test_Scope_POIPlacesNearby checks that the places around a POI are requested under the POI
+func Test_Scope_POIPlacesNearby(t *testing.T) {
+	const poi = "poi:osm:way:85413372"
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/pois/" + poi + "/places_nearby"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if got := r.URL.Query()["type[]"]; !reflect.DeepEqual(got, []string{types.EmbeddedStopPoint}) {
+			t.Errorf("unexpected types: %v", got)
+		}
+		_, _ = w.Write([]byte(`{"places_nearby": [{
+			"id": "stop_point:RAT:SP:NATIO1",
+			"name": "Nation (Paris)",
+			"embedded_type": "stop_point",
+			"quality": 0,
+			"stop_point": {"id": "stop_point:RAT:SP:NATIO1", "name": "Nation", "coord": {"lon": "2.396", "lat": "48.848"}}
+		}]}`))
+	}))
+
+	res, err := session.Scope("fr-idf").POIPlacesNearby(context.Background(), poi, PlacesNearbyRequest{Types: []string{types.EmbeddedStopPoint}})
+	if err != nil {
+		t.Fatalf("error in POIPlacesNearby: %v", err)
+	}
+	if res.Count() != 1 || res.Places[0].EmbeddedType != types.EmbeddedStopPoint {
+		t.Errorf("unexpected places: %+v", res.Places)
+	}
+}
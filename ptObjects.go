@@ -0,0 +1,71 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const ptObjectsEndpoint string = "pt_objects"
+
+// PTObjectsResults holds the results of a public transport objects search.
+//
+// Each result is a Container, whose content can be retrieved with types.Container.PTObject (or Object for stop points).
+type PTObjectsResults struct {
+	PTObjects []types.Container `json:"pt_objects"`
+	Logging   `json:"-"`
+	session   *Session
+}
+
+// Count returns the number of public transport objects available in a PTObjectsResults
+func (pr *PTObjectsResults) Count() int {
+	return len(pr.PTObjects)
+}
+
+// PTObjectsRequest contains the optional parameters for a PTObjects search.
+type PTObjectsRequest struct {
+	// Types are the type of objects to search, such as types.EmbeddedLine or types.EmbeddedNetwork
+	Types []string `param:"type[]"`
+
+	// If given it will filter the search by specific admin uris
+	AdminURI []string `param:"admin_uri[]"`
+
+	// Maximum amount of results
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// Enables GeoJSON data in the reply, such as the shape of the lines.
+	Geo bool `param:"disable_geojson,negate"`
+}
+
+// ptObjectsQuery is a PTObjectsRequest along with the searched text
+type ptObjectsQuery struct {
+	query string
+	PTObjectsRequest
+}
+
+func (q ptObjectsQuery) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+
+	// The query is unexported, as it is given to PTObjects rather than in the request
+	rb.AddString("q", q.query)
+	if err := rb.AddParams(q); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// PTObjects searches the public transport objects of the region (lines, networks, modes, stop areas...) matching the query,
+// such as "metro 14" or "RER B". Unlike Places, addresses and POIs aren't searched.
+func (scope *Scope) PTObjects(ctx context.Context, query string, req PTObjectsRequest) (*PTObjectsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + ptObjectsEndpoint
+
+	results := &PTObjectsResults{session: scope.session}
+	err := scope.session.request(ctx, reqURL, ptObjectsQuery{query, req}, results)
+	return results, err
+}
@@ -0,0 +1,71 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_PTObjectsResults_Unmarshal tests unmarshalling for PTObjectsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_PTObjectsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["pt_objects"], reflect.TypeOf(PTObjectsResults{}))
+}
+
+// Test_Scope_PTObjects checks the search parameters, and that the public transport objects found can be retrieved with their type
+func Test_Scope_PTObjects(t *testing.T) {
+	fixture := testData["pt_objects"].correct["metro_14.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/pt_objects"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		query := r.URL.Query()
+		if got := query.Get("q"); got != "metro 14" {
+			t.Errorf("unexpected query: %q", got)
+		}
+		if got := query["type[]"]; !reflect.DeepEqual(got, []string{types.EmbeddedLine, types.EmbeddedRoute, types.EmbeddedNetwork}) {
+			t.Errorf("unexpected types: %v", got)
+		}
+		if got := query.Get("disable_geojson"); got != "true" {
+			t.Errorf("unexpected disable_geojson: %q", got)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := PTObjectsRequest{Types: []string{types.EmbeddedLine, types.EmbeddedRoute, types.EmbeddedNetwork}}
+	res, err := session.Scope("fr-idf").PTObjects(context.Background(), "metro 14", req)
+	if err != nil {
+		t.Fatalf("error in PTObjects: %v", err)
+	}
+	if res.Count() != 3 {
+		t.Fatalf("expected 3 results, got %d", res.Count())
+	}
+
+	var got []string
+	for _, c := range res.PTObjects {
+		obj, err := c.PTObject()
+		if err != nil {
+			t.Fatalf("error while retrieving the PTObject of %s: %v", c.ID, err)
+		}
+		got = append(got, reflect.TypeOf(obj).String())
+	}
+	expected := []string{"*types.Line", "*types.Route", "*types.Network"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected objects: got %v, expected %v", got, expected)
+	}
+
+	line, _ := res.PTObjects[0].PTObject()
+	if l := line.(*types.Line); l.Code != "14" || l.Network.Name != "RATP" {
+		t.Errorf("unexpected line: %+v", l)
+	}
+}
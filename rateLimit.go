@@ -0,0 +1,95 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// A RateLimit is the state of the API's rate limiting for the key used, as given by the X-RateLimit-* headers of a response.
+type RateLimit struct {
+	Limit     int       // Maximum amount of requests in the current window
+	Remaining int       // Amount of requests left in the current window
+	Reset     time.Time // When the window is reset, zero if not given
+}
+
+// rateLimitResetEpoch is the value above which X-RateLimit-Reset is a unix timestamp rather than a delay in seconds
+const rateLimitResetEpoch = 1e9
+
+// parseRateLimit parses the X-RateLimit-* headers of a response received at the given time.
+// ok is false if the response had no valid limit & remaining amount of requests.
+func parseRateLimit(h http.Header, received time.Time) (rl RateLimit, ok bool) {
+	limitHeader, remainingHeader := h.Get("X-RateLimit-Limit"), h.Get("X-RateLimit-Remaining")
+	if limitHeader == "" || remainingHeader == "" {
+		// Most responses have none, spare the parsing errors
+		return RateLimit{}, false
+	}
+	limit, errLimit := strconv.Atoi(limitHeader)
+	remaining, errRemaining := strconv.Atoi(remainingHeader)
+	if errLimit != nil || errRemaining != nil {
+		return RateLimit{}, false
+	}
+	rl = RateLimit{Limit: limit, Remaining: remaining}
+
+	// The reset is either a delay in seconds or a unix timestamp
+	if reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		if reset > rateLimitResetEpoch {
+			rl.Reset = time.Unix(reset, 0)
+		} else {
+			rl.Reset = received.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return rl, true
+}
+
+// limiter spaces requests out so that no more than a given amount are sent per second
+type limiter struct {
+	mu       sync.Mutex
+	interval time.Duration // Minimum interval between two requests
+	next     time.Time     // When the next request may be sent
+}
+
+// wait waits until a request may be sent, or until the context is done.
+// A nil limiter never waits.
+func (l *limiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	// Reserve the next slot
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := at.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// rateLimitBackoff is the delay before retrying the first time a rate limited request without a Retry-After header, it doubles with each retry
+const rateLimitBackoff = time.Second
+
+// rateLimitDelay returns how long to wait before retrying a rate limited request, given the response & the number of retries already done
+func rateLimitDelay(resp *http.Response, retries int) time.Duration {
+	received := time.Now()
+	if at, ok := parseRetryAfter(resp.Header.Get("Retry-After"), received); ok {
+		return at.Sub(received)
+	}
+	return rateLimitBackoff << uint(retries)
+}
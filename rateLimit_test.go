@@ -0,0 +1,117 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Test_Logging_RateLimit checks that the rate limiting headers are exposed on the results, in both forms of reset
+func Test_Logging_RateLimit(t *testing.T) {
+	reset := time.Date(2017, time.April, 13, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		ok      bool
+		check   func(rl RateLimit) bool
+	}{
+		{
+			name:    "timestamp",
+			headers: map[string]string{"X-RateLimit-Limit": "3000", "X-RateLimit-Remaining": "2990", "X-RateLimit-Reset": "1492092000"},
+			ok:      true,
+			check:   func(rl RateLimit) bool { return rl.Limit == 3000 && rl.Remaining == 2990 && rl.Reset.Equal(reset) },
+		},
+		{
+			name:    "delay",
+			headers: map[string]string{"X-RateLimit-Limit": "3000", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "60"},
+			ok:      true,
+			check: func(rl RateLimit) bool {
+				return rl.Remaining == 0 && rl.Reset.After(time.Now().Add(50*time.Second)) && !rl.Reset.After(time.Now().Add(60*time.Second))
+			},
+		},
+		{name: "missing", ok: false},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range test.headers {
+					w.Header().Set(k, v)
+				}
+				_, _ = w.Write([]byte(`{"regions": []}`))
+			}))
+
+			res, err := session.Regions(context.Background(), RegionRequest{})
+			if err != nil {
+				t.Fatalf("error in Regions: %v", err)
+			}
+			rl, ok := res.RateLimit()
+			if ok != test.ok {
+				t.Fatalf("unexpected ok: got %t, expected %t (%+v)", ok, test.ok, rl)
+			}
+			if test.check != nil && !test.check(rl) {
+				t.Errorf("unexpected rate limit: %+v", rl)
+			}
+		})
+	}
+}
+
+// Test_WithRateLimitRetries checks that rate limited requests are retried, until out of retries
+func Test_WithRateLimitRetries(t *testing.T) {
+	var requests, rejected int
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= rejected {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithRateLimitRetries(2))
+
+	rejected = 2
+	if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+		t.Fatalf("expected the request to succeed once retried, got %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+
+	requests, rejected = 0, 3
+	_, err := session.Regions(context.Background(), RegionRequest{})
+	if remoteErr, ok := err.(*RemoteError); !ok || remoteErr.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected a rate limited *RemoteError once out of retries, got %T: %v", err, err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+// Test_WithMaxRequestsPerSecond checks that requests are spaced out
+func Test_WithMaxRequestsPerSecond(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithMaxRequestsPerSecond(50))
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+			t.Fatalf("error in Regions: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 80*time.Millisecond {
+		t.Errorf("5 requests at 50 per second took %s, expected at least 80ms", elapsed)
+	}
+
+	// Waiting for its turn respects the context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	session.limiter.next = time.Now().Add(time.Second)
+	if _, err := session.Regions(ctx, RegionRequest{}); err != context.Canceled {
+		t.Errorf("expected the context's error while waiting, got %v", err)
+	}
+}
@@ -15,6 +15,9 @@ type RegionResults struct {
 	// The list of regions retrieved
 	Regions []types.Region `json:"Regions"`
 
+	// Pagination information, see TotalAvailable
+	Pagination Pagination `json:"pagination"`
+
 	// Timing information
 	Logging
 
@@ -22,26 +25,25 @@ type RegionResults struct {
 	session *Session
 }
 
+// TotalAvailable returns the total number of regions available across all pages.
+func (rr *RegionResults) TotalAvailable() int {
+	return rr.Pagination.total(len(rr.Regions))
+}
+
 // RegionRequest contains the parameters needed to make a Coverage request
 type RegionRequest struct {
 	// Count is the number of items to return, if count=0, then it will return the default number
 	// BUG: Count doesn't work, server-side.
-	Count uint
+	Count uint `param:"count"`
 
 	// Enables Geo data (in MKT format) in the reply. Geo objects can be large and slower to parse.
-	Geo bool
+	Geo bool `param:"disable_geojson,negate"`
 }
 
 func (req RegionRequest) toURL() (url.Values, error) {
 	rb := utils.NewRequestBuilder()
-
-	if req.Count != 0 {
-		rb.AddUInt("count", req.Count)
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
 	}
-
-	if !req.Geo {
-		rb.AddString("disable_geojson", "true")
-	}
-
 	return rb.Values(), nil
 }
@@ -2,8 +2,12 @@ package navitia
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"reflect"
 	"testing"
+
+	"github.com/govitia/navitia/types"
 )
 
 func Test_Regions(t *testing.T) {
@@ -43,3 +47,50 @@ func Test_Regions(t *testing.T) {
 func Test_RegionResults_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["coverage"], reflect.TypeOf(RegionResults{}))
 }
+
+// Test_RegionByCoordinates checks that the region covering a position is returned, and that a position outside of any region is reported as such
+func Test_RegionByCoordinates(t *testing.T) {
+	var (
+		paris = types.Coordinates{Latitude: 48.847002, Longitude: 2.377310}
+		ocean = types.Coordinates{Latitude: 45.5, Longitude: -30.25}
+	)
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coverage/"+paris.String() {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id": "unknown_object", "message": "No region available for the coordinates"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"regions": [{"id": "fr-idf", "name": "Île-de-France", "status": "running"}]}`))
+	}))
+
+	region, err := session.RegionByCoordinates(context.Background(), paris)
+	if err != nil {
+		t.Fatalf("error in RegionByCoordinates: %v", err)
+	}
+	if region.ID != "fr-idf" || region.Name != "Île-de-France" {
+		t.Errorf("unexpected region: %+v", region)
+	}
+
+	if _, err := session.RegionByCoordinates(context.Background(), ocean); err != ErrOutsideCoverage {
+		t.Errorf("expected ErrOutsideCoverage, got %v", err)
+	}
+}
+
+// Test_RegionByCoordinates_Wrapped checks that a position outside of the coverage is reported as such
+// when the 404 error comes wrapped, such as from a middleware
+func Test_RegionByCoordinates_Wrapped(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL)
+	}))
+	session.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			return nil, fmt.Errorf("cached answer: %w", &RemoteError{StatusCode: http.StatusNotFound, ID: RemoteErrUnknownObject})
+		}
+	})
+
+	ocean := types.Coordinates{Latitude: 45.5, Longitude: -30.25}
+	if _, err := session.RegionByCoordinates(context.Background(), ocean); err != ErrOutsideCoverage {
+		t.Errorf("expected ErrOutsideCoverage, got %v", err)
+	}
+}
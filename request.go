@@ -1,7 +1,14 @@
 package navitia
 
 import (
+	"context"
+	"net/http"
 	"net/url"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
 )
 
 type query interface {
@@ -13,4 +20,84 @@ type results interface {
 	creating()
 	sending()
 	parsing()
+	rateLimited(h http.Header)
+	keepRaw(body []byte)
+}
+
+// CollectionRequest contains the optional parameters for requests listing public transport objects,
+// such as Networks or PhysicalModes.
+type CollectionRequest struct {
+	// Maximum amount of objects per page
+	Count uint `param:"count"`
+
+	// StartPage is the index of the requested page, starting at 0
+	StartPage uint `param:"start_page"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+
+	// AllPages fetches every page, starting from StartPage, and merges them into the returned results, such as for a data dump.
+	// At most MaxPages pages are fetched (100 if zero): compare the results' Count to their TotalAvailable to know if some are missing.
+	AllPages bool
+	MaxPages uint
+}
+
+func (req CollectionRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// collection requests a collection of objects, then fetches and merges its following pages if req.AllPages is set
+func (s *Session) collection(ctx context.Context, url string, req CollectionRequest, res mergeableResults) error {
+	err := s.request(ctx, url, req, res)
+	if err != nil || !req.AllPages {
+		return err
+	}
+	return mergePages(ctx, s, res, req.MaxPages)
+}
+
+// filterTypes are the types of the public transport objects that can be forbidden or allowed in requests, see JourneyRequest.Forbid
+var filterTypes = map[string]bool{
+	types.IDTypeNetwork:        true,
+	types.IDTypeLine:           true,
+	types.IDTypeRoute:          true,
+	types.IDTypeCommercialMode: true,
+	types.IDTypePhysicalMode:   true,
+	types.IDTypeStopArea:       true,
+	types.IDTypeStopPoint:      true,
+}
+
+// addFilters adds ids to the filter list, checking that they are IDs of public transport objects not already in the excluding list,
+// such as adding allowed IDs which aren't forbidden. Nothing is added if an ID is invalid.
+func addFilters(list *[]types.ID, excluding []types.ID, ids []types.ID) error {
+	for _, id := range ids {
+		if typ := id.Type(); !filterTypes[typ] {
+			return errors.Errorf("%q isn't the ID of a network, line, route, mode, stop area or stop point", id)
+		}
+		for _, excluded := range excluding {
+			if id == excluded {
+				return errors.Errorf("%q can't be both allowed & forbidden", id)
+			}
+		}
+	}
+	*list = append(*list, ids...)
+	return nil
+}
+
+// checkEnums returns an error if the traveler type or data freshness of a request is set to an unknown value,
+// as the API would silently fall back to its default rather than report the typo.
+func checkEnums(traveler types.TravelerType, freshness types.DataFreshness) error {
+	if traveler != "" && !traveler.Known() {
+		return errors.Errorf("unknown traveler type %q", traveler)
+	}
+	if freshness != "" && !freshness.Known() {
+		return errors.Errorf("unknown data freshness %q", freshness)
+	}
+	return nil
 }
@@ -0,0 +1,67 @@
+package navitia
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// A RetryPolicy tells how requests failing transiently, such as on a server error or a connection reset, are retried.
+// As the Session only sends GET requests, retrying them is always safe.
+//
+// Rate limited requests are handled separately, see WithRateLimitRetries.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the first one.
+	// If it is lower than two, requests aren't retried.
+	MaxAttempts int
+
+	// Backoff returns how long to wait before the given attempt, starting at 2 for the first retry.
+	// If nil, the delay starts at 100ms and doubles with each retry.
+	Backoff func(attempt int) time.Duration
+
+	// RetryOn reports whether a request should be retried given its response or error, only one of them being non-nil.
+	// If nil, RetryTransient is used.
+	RetryOn func(resp *http.Response, err error) bool
+}
+
+// retryBackoff is the default delay before the first retry
+const retryBackoff = 100 * time.Millisecond
+
+// RetryTransient reports whether a failure is transient: a 5xx response, a network timeout, a connection reset or
+// a connection closed before the response was complete.
+// It is the default RetryPolicy.RetryOn, and may be used to extend it.
+func RetryTransient(resp *http.Response, err error) bool {
+	if err == nil {
+		return resp != nil && resp.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// retries reports whether a request sent attempt times should be retried, given its response or error.
+// A nil policy never retries.
+func (p *RetryPolicy) retries(attempt int, resp *http.Response, err error) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if p.RetryOn == nil {
+		return RetryTransient(resp, err)
+	}
+	return p.RetryOn(resp, err)
+}
+
+// backoff returns how long to wait before the given attempt
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	if p.Backoff == nil {
+		return retryBackoff << uint(attempt-2)
+	}
+	return p.Backoff(attempt)
+}
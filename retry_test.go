@@ -0,0 +1,120 @@
+package navitia
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// noBackoff makes retries immediate
+func noBackoff(int) time.Duration { return 0 }
+
+// Test_WithRetryPolicy checks that transient failures are retried, up to the maximum amount of attempts
+func Test_WithRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int // Status of the failing responses
+		failures    int // Amount of failing responses before a successful one
+		maxAttempts int
+		expected    int // Expected amount of requests
+		succeeds    bool
+	}{
+		{name: "retried", status: http.StatusServiceUnavailable, failures: 2, maxAttempts: 3, expected: 3, succeeds: true},
+		{name: "out of attempts", status: http.StatusBadGateway, failures: 2, maxAttempts: 2, expected: 2},
+		{name: "not transient", status: http.StatusNotFound, failures: 1, maxAttempts: 3, expected: 1},
+		{name: "no retries", status: http.StatusInternalServerError, failures: 1, maxAttempts: 1, expected: 1},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var requests int
+			session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= test.failures {
+					w.WriteHeader(test.status)
+					_, _ = w.Write([]byte(`{"message": "failure"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"regions": []}`))
+			}), WithRetryPolicy(RetryPolicy{MaxAttempts: test.maxAttempts, Backoff: noBackoff}))
+
+			_, err := session.Regions(context.Background(), RegionRequest{})
+			if test.succeeds && err != nil {
+				t.Errorf("expected the request to succeed, got %v", err)
+			}
+			if remoteErr, ok := err.(*RemoteError); !test.succeeds && (!ok || remoteErr.StatusCode != test.status) {
+				t.Errorf("expected a *RemoteError with status %d, got %T: %v", test.status, err, err)
+			}
+			if requests != test.expected {
+				t.Errorf("expected %d requests, got %d", test.expected, requests)
+			}
+		})
+	}
+}
+
+// Test_WithRetryPolicy_ConnectionReset checks that requests failing on a connection reset are retried
+func Test_WithRetryPolicy_ConnectionReset(t *testing.T) {
+	var requests int
+	doer := doerFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if requests == 1 {
+			return nil, errors.Wrap(syscall.ECONNRESET, "read tcp")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(strings.NewReader(`{"regions": []}`)),
+			Request:    req,
+		}, nil
+	})
+
+	session, err := NewCustom("", "http://example.com/v1", doer, WithRetryPolicy(RetryPolicy{MaxAttempts: 2, Backoff: noBackoff}))
+	if err != nil {
+		t.Fatalf("error while creating session: %v", err)
+	}
+	if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+		t.Errorf("expected the request to succeed once retried, got %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+// Test_RetryPolicy_RetryOn checks that a custom RetryOn & Backoff are used
+func Test_RetryPolicy_RetryOn(t *testing.T) {
+	var (
+		requests int
+		attempts []int
+	)
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "not yet"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"regions": []}`))
+	}), WithRetryPolicy(RetryPolicy{
+		MaxAttempts: 5,
+		Backoff: func(attempt int) time.Duration {
+			attempts = append(attempts, attempt)
+			return 0
+		},
+		RetryOn: func(resp *http.Response, err error) bool {
+			return resp != nil && resp.StatusCode == http.StatusNotFound
+		},
+	}))
+
+	if _, err := session.Regions(context.Background(), RegionRequest{}); err != nil {
+		t.Fatalf("expected the request to succeed once retried, got %v", err)
+	}
+	if len(attempts) != 2 || attempts[0] != 2 || attempts[1] != 3 {
+		t.Errorf("expected backoffs before attempts [2 3], got %v", attempts)
+	}
+}
@@ -0,0 +1,150 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const routeSchedulesEndpoint string = "route_schedules"
+
+// RouteSchedulesResults holds the results of a route schedules request: the timetable of each matching route.
+type RouteSchedulesResults struct {
+	RouteSchedules []types.RouteSchedule `json:"route_schedules"`
+	Paging         Paging                `json:"links"`
+	Pagination     Pagination            `json:"pagination"`
+	Logging        `json:"-"`
+	session        *Session
+}
+
+// Count returns the number of route schedules available in a RouteSchedulesResults
+func (rsr *RouteSchedulesResults) Count() int {
+	return len(rsr.RouteSchedules)
+}
+
+// TotalAvailable returns the total number of route schedules available across all pages.
+func (rsr *RouteSchedulesResults) TotalAvailable() int {
+	return rsr.Pagination.total(len(rsr.RouteSchedules))
+}
+
+// passingTimes returns the sorted passing times at the given stop point, across all route schedules.
+// Empty cells are skipped.
+func (rsr *RouteSchedulesResults) passingTimes(at types.ID) []time.Time {
+	var times []time.Time
+	for _, rs := range rsr.RouteSchedules {
+		for _, row := range rs.Table.Rows {
+			if row.StopPoint.ID != at {
+				continue
+			}
+			for _, cell := range row.DateTimes {
+				if !cell.Empty() {
+					times = append(times, cell.DateTime)
+				}
+			}
+		}
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times
+}
+
+// Frequency computes the average headway (the time between two consecutive vehicles) at a given stop point.
+//
+// Only the passing times within window of the first passing time at that stop are taken into account,
+// so that requesting the schedule from 11:30 with a one hour window answers "how often does this line run around noon".
+// Vehicle journeys not serving the stop point are skipped.
+//
+// It returns an error if there are less than two passing times within the window at the stop point.
+func (rsr *RouteSchedulesResults) Frequency(at types.StopPoint, window time.Duration) (time.Duration, error) {
+	times := rsr.passingTimes(at.ID)
+	if len(times) == 0 {
+		return 0, errors.Errorf("no passing time found at stop point %s", at.ID)
+	}
+
+	// Only keep the times within the window
+	end := times[0].Add(window)
+	n := sort.Search(len(times), func(i int) bool { return times[i].After(end) })
+	times = times[:n]
+
+	if len(times) < 2 {
+		return 0, errors.Errorf("not enough passing times at stop point %s within %s to compute a frequency (got %d)", at.ID, window, len(times))
+	}
+
+	return times[len(times)-1].Sub(times[0]) / time.Duration(len(times)-1), nil
+}
+
+// RouteSchedulesRequest contains the parameters for a RouteSchedules request.
+type RouteSchedulesRequest struct {
+	// Line or Route restrict the schedules to those of a line (one per route) or of a single route.
+	// If both are given, Route takes precedence.
+	Line  types.ID
+	Route types.ID
+
+	// From what time on do you want to see the schedules ? (default now)
+	From time.Time `param:"from_datetime"`
+
+	// Maximum duration between From and the retrieved passing times (default 24h)
+	Duration time.Duration `param:"duration,seconds"`
+
+	// Maximum amount of vehicle journeys per schedule, that is of columns in each table
+	ItemsPerSchedule uint `param:"items_per_schedule"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+
+	// Freshness of the data
+	Freshness types.DataFreshness `param:"data_freshness"`
+}
+
+// path returns the path of the object whose schedules are requested, followed by a slash, if any
+func (req RouteSchedulesRequest) path() string {
+	switch {
+	case req.Route != "":
+		return "routes/" + string(req.Route) + "/"
+	case req.Line != "":
+		return "lines/" + string(req.Line) + "/"
+	default:
+		return ""
+	}
+}
+
+func (req RouteSchedulesRequest) toURL() (url.Values, error) {
+	if err := checkEnums("", req.Freshness); err != nil {
+		return nil, err
+	}
+
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// routeSchedules is the internal function used by RouteSchedules functions
+func (s *Session) routeSchedules(ctx context.Context, url string, req RouteSchedulesRequest) (*RouteSchedulesResults, error) {
+	var results *RouteSchedulesResults
+	err := s.withRealtimeFallback(req.Freshness, func(freshness types.DataFreshness) (bool, error) {
+		req.Freshness = freshness
+		results = &RouteSchedulesResults{session: s}
+		err := s.request(ctx, url, req, results)
+		return len(results.RouteSchedules) == 0, err
+	})
+	return results, err
+}
+
+// RouteSchedules requests the timetables of a line or route: for each route, a table of the passing times of its vehicle journeys at its stop points.
+func (scope *Scope) RouteSchedules(ctx context.Context, req RouteSchedulesRequest) (*RouteSchedulesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + routeSchedulesEndpoint
+
+	return scope.session.routeSchedules(ctx, reqURL, req)
+}
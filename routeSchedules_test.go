@@ -0,0 +1,95 @@
+package navitia
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_RouteSchedulesResults_Unmarshal tests unmarshalling for RouteSchedulesResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_RouteSchedulesResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["route_schedules"], reflect.TypeOf(RouteSchedulesResults{}))
+}
+
+// Test_RouteSchedulesResults_Frequency checks the headway computed on a regular service running every ten minutes
+func Test_RouteSchedulesResults_Frequency(t *testing.T) {
+	data := testData["route_schedules"].correct["regular.json"]
+	if len(data) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	res := &RouteSchedulesResults{}
+	if err := json.Unmarshal(data, res); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	const (
+		headway   = 10 * time.Minute
+		tolerance = time.Minute
+	)
+
+	at := types.StopPoint{ID: "stop_point:RAT:SP:DAUM1"}
+	freq, err := res.Frequency(at, time.Hour)
+	if err != nil {
+		t.Fatalf("error in Frequency: %v", err)
+	}
+	if diff := freq - headway; diff > tolerance || diff < -tolerance {
+		t.Errorf("computed frequency %s isn't within %s of %s", freq, tolerance, headway)
+	}
+
+	// A stop point not in the schedule should give an error
+	if _, err := res.Frequency(types.StopPoint{ID: "stop_point:RAT:SP:NOWHERE"}, time.Hour); err == nil {
+		t.Errorf("expected an error for a stop point not in the schedule")
+	}
+
+	// As should a window too narrow to hold two passing times
+	if _, err := res.Frequency(at, time.Minute); err == nil {
+		t.Errorf("expected an error for a window holding a single passing time")
+	}
+}
+
+// Test_Scope_RouteSchedules checks that the schedules of a line are requested and their table decoded
+func Test_Scope_RouteSchedules(t *testing.T) {
+	fixture := testData["route_schedules"].correct["regular.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/lines/line:RAT:M6/route_schedules"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if items := r.URL.Query().Get("items_per_schedule"); items != "5" {
+			t.Errorf("unexpected items_per_schedule: %q", items)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	req := RouteSchedulesRequest{Line: "line:RAT:M6", ItemsPerSchedule: 5}
+	res, err := session.Scope("fr-idf").RouteSchedules(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in RouteSchedules: %v", err)
+	}
+	if res.Count() == 0 {
+		t.Fatal("expected route schedules, got none")
+	}
+
+	table := res.RouteSchedules[0].Table
+	if len(table.Rows) == 0 || len(table.Headers) == 0 {
+		t.Fatalf("expected a non-empty table, got %d rows & %d headers", len(table.Rows), len(table.Headers))
+	}
+	for i, row := range table.Rows {
+		if len(row.DateTimes) != len(table.Headers) {
+			t.Errorf("row %d has %d cells for %d headers", i, len(row.DateTimes), len(table.Headers))
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const routesEndpoint string = "routes"
+
+// RoutesResults holds the results of a routes request.
+type RoutesResults struct {
+	Routes      []types.Route      `json:"routes"`
+	Disruptions []types.Disruption `json:"disruptions"`
+	Paging      Paging             `json:"links"`
+	Pagination  Pagination         `json:"pagination"`
+	Logging     `json:"-"`
+	session     *Session
+}
+
+// Count returns the number of routes available in a RoutesResults
+func (rr *RoutesResults) Count() int {
+	return len(rr.Routes)
+}
+
+// TotalAvailable returns the total number of routes available across all pages.
+func (rr *RoutesResults) TotalAvailable() int {
+	return rr.Pagination.total(len(rr.Routes))
+}
+
+func (rr *RoutesResults) paging() Paging {
+	return rr.Paging
+}
+
+func (rr *RoutesResults) newPage() pagedResults {
+	return &RoutesResults{session: rr.session}
+}
+
+// ForEach calls f with each of the routes, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (rr *RoutesResults) ForEach(ctx context.Context, f func(route types.Route) error) error {
+	return walkPages(ctx, rr.session, rr, func(page pagedResults) error {
+		for _, route := range page.(*RoutesResults).Routes {
+			if err := f(route); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// RoutesRequest contains the optional parameters for a Routes request.
+type RoutesRequest struct {
+	// Line restricts the routes to those of a line, that is its branches and directions
+	Line types.ID
+
+	// Maximum amount of routes
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// DisableGeoJSON skips the shape of the routes, which can be heavy
+	DisableGeoJSON bool `param:"disable_geojson"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+}
+
+// path returns the path of the line whose routes are requested, followed by a slash, if any
+func (req RoutesRequest) path() string {
+	if req.Line == "" {
+		return ""
+	}
+	return linesEndpoint + "/" + string(req.Line) + "/"
+}
+
+func (req RoutesRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// routes is the internal function used by Routes functions
+func (s *Session) routes(ctx context.Context, url string, req RoutesRequest) (*RoutesResults, error) {
+	results := &RoutesResults{session: s}
+	err := s.request(ctx, url, req, results)
+	return results, err
+}
+
+// Routes lists the routes of the region, or of a single line if req.Line is set, with their direction and shape.
+// The routes of a line can then be passed to RouteSchedules.
+func (scope *Scope) Routes(ctx context.Context, req RoutesRequest) (*RoutesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + routesEndpoint
+
+	return scope.session.routes(ctx, reqURL, req)
+}
@@ -0,0 +1,50 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// Test_RoutesResults_Unmarshal tests unmarshalling for RoutesResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_RoutesResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["routes"], reflect.TypeOf(RoutesResults{}))
+}
+
+// Test_Scope_Routes checks that the routes of a line are requested under the line, and that its branches are decoded
+func Test_Scope_Routes(t *testing.T) {
+	fixture := testData["routes"].correct["metro13.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/lines/line:RAT:M13/routes"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").Routes(context.Background(), RoutesRequest{Line: "line:RAT:M13"})
+	if err != nil {
+		t.Fatalf("error in Routes: %v", err)
+	}
+
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 routes, got %d", res.Count())
+	}
+	directions := []string{string(res.Routes[0].Direction.ID), string(res.Routes[1].Direction.ID)}
+	if expected := []string{"stop_area:RAT:SA:COURT", "stop_area:RAT:SA:STDUN"}; !reflect.DeepEqual(directions, expected) {
+		t.Errorf("unexpected directions: got %v, expected %v", directions, expected)
+	}
+	for _, r := range res.Routes {
+		if r.Line.ID != "line:RAT:M13" || r.Geo == nil {
+			t.Errorf("unexpected route %s: line %s, shape %v", r.ID, r.Line.ID, r.Geo)
+		}
+	}
+}
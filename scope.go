@@ -1,11 +1,49 @@
 package navitia
 
 import (
+	"strings"
+
+	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 
 	"github.com/govitia/navitia/types"
 )
 
+// embeddedCollections maps the embedded types of the objects which can be used as a path prefix to their collection
+var embeddedCollections = map[string]string{
+	types.EmbeddedStopArea:       stopAreasEndpoint,
+	types.EmbeddedStopPoint:      stopPointsEndpoint,
+	types.EmbeddedPOI:            poisEndpoint,
+	types.EmbeddedLine:           linesEndpoint,
+	types.EmbeddedRoute:          routesEndpoint,
+	types.EmbeddedNetwork:        networksEndpoint,
+	types.EmbeddedCommercialMode: commercialModesEndpoint,
+}
+
+// objectPath returns the path of an object within a coverage, such as "stop_areas/stop_area:RAT:SA:NATIO"
+func objectPath(embeddedType string, id types.ID) (string, error) {
+	collection, ok := embeddedCollections[embeddedType]
+	if !ok {
+		return "", errors.Errorf("no collection known for objects of type %q", embeddedType)
+	}
+	return collection + "/" + string(id), nil
+}
+
+// idPath returns the path of an object within a coverage given only its ID, such as "stop_areas/stop_area:RAT:SA:NATIO" or "coords/2.377;48.847".
+// The type of the object is deduced from the prefix of its ID, which navitia sets to the embedded type.
+func idPath(id types.ID) (string, error) {
+	str := string(id)
+	if strings.Contains(str, ";") {
+		return "coords/" + str, nil
+	}
+
+	i := strings.Index(str, ":")
+	if i <= 0 {
+		return "", errors.Errorf("can't deduce the type of object %q from its ID", id)
+	}
+	return objectPath(str[:i], id)
+}
+
 // A Scope is a coverage-scoped question, allowing you to query information about a specific region.
 //
 // It is needed for every non-global request you wish to make, and helps have better results with some global request too!
@@ -33,22 +71,17 @@ func (scope *Scope) ArrivalsSP(ctx context.Context, req ConnectionsRequest, reso
 // ArrivalsC requests the arrivals from a point described by coordinates.
 func (s *Session) ArrivalsC(ctx context.Context, req ConnectionsRequest, coords types.Coordinates) (*ConnectionsResults, error) {
 	// Create the URL
-	coordsQ := string(coords.ID())
+	coordsQ := coords.String()
 	scopeURL := s.APIURL + "/coverage/" + coordsQ + "/coords/" + coordsQ + "/" + arrivalsEndpoint
 
 	return s.connections(ctx, scopeURL, req)
 }
 
-// Departures computes a list of Departures according to the parameters given in a specific scope
+// Departures computes a list of Departures according to the parameters given in a specific scope,
+// such as the upcoming departures from a stop area or stop point.
 func (scope *Scope) Departures(ctx context.Context, req DeparturesRequest) (*DeparturesResults, error) {
-	// there is a special case for departures stop areas, it needs to be added before any parameters
-	filterByVJ := ""
-	if req.StopArea != "" {
-		filterByVJ = "stop_areas/" + req.StopArea
-	}
-
 	// Create the URL
-	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + filterByVJ + "/" + departuresEndpoint
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + departuresEndpoint
 
 	return scope.session.departures(ctx, reqURL, req)
 }
@@ -69,8 +102,22 @@ func (scope *Scope) DeparturesSP(ctx context.Context, req ConnectionsRequest, re
 	return scope.session.connections(ctx, scopeURL, req)
 }
 
+// Isochrones computes the zones reachable from (or to) a point in a specific scope, according to the parameters given.
+// If req.Boundary is set, the zones are clipped to it.
+func (scope *Scope) Isochrones(ctx context.Context, req IsochroneRequest) (*IsochronesResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + isochronesEndpoint
+
+	// Call
+	return scope.session.isochrones(ctx, reqURL, req)
+}
+
 // Journeys computes a list of journeys according to the parameters given in a specific scope
 func (scope *Scope) Journeys(ctx context.Context, req JourneyRequest) (*JourneyResults, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Create the URL
 	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + journeysEndpoint
 
@@ -78,6 +125,34 @@ func (scope *Scope) Journeys(ctx context.Context, req JourneyRequest) (*JourneyR
 	return scope.session.journeys(ctx, reqURL, req)
 }
 
+// JourneysFrom computes journeys departing from the given object, such as a stop area, using the object-scoped form of the journeys API.
+// The type of the object is deduced from its ID, which may also be coordinates.
+//
+// As the origin is given by the object, req.From must be empty.
+func (scope *Scope) JourneysFrom(ctx context.Context, from types.ID, req JourneyRequest) (*JourneyResults, error) {
+	if req.From != "" {
+		return nil, errors.Errorf("the origin is already given by the object (%s), yet req.From is set (%s)", from, req.From)
+	}
+
+	// Validate the request as if the origin was given by req.From
+	withOrigin := req
+	withOrigin.From = from
+	if err := withOrigin.Validate(); err != nil {
+		return nil, err
+	}
+
+	path, err := idPath(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't request journeys")
+	}
+
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + path + "/" + journeysEndpoint
+
+	// Call
+	return scope.session.journeys(ctx, reqURL, req)
+}
+
 // Places searches in all geographical objects within a coverage using their names, returning a list of places.
 // It is context aware.
 func (scope *Scope) Places(ctx context.Context, params PlacesRequest) (*PlacesResults, error) {
@@ -101,3 +176,20 @@ func (scope *Scope) VehicleJourneys(ctx context.Context, req VehicleJourneyReque
 
 	return scope.session.vehicleJourneys(ctx, reqURL, req)
 }
+
+// VehicleJourney retrieves a single vehicle journey by its ID, such as one referenced by a journey section (see types.Section.VehicleJourneyID),
+// along with its stop times and disruptions.
+//
+// If the vehicle journey doesn't exist, a *RemoteError with a 404 status code is returned.
+func (scope *Scope) VehicleJourney(ctx context.Context, id types.ID) (*types.VehicleJourney, error) {
+	results, err := scope.VehicleJourneys(ctx, VehicleJourneyRequest{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if results.Count() == 0 {
+		return nil, notFound("vehicle journey", id)
+	}
+	return &results.VehicleJourneys[0], nil
+}
@@ -1,18 +1,23 @@
 package navitia
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
-	"path"
+	"net/url"
 	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
 )
 
 const (
@@ -26,51 +31,165 @@ const (
 
 var defaultClient = &http.Client{}
 
+// A Doer sends HTTP requests, such as an *http.Client.
+// It allows injecting instrumented transports, proxies or test doubles in a Session, see NewCustom and WithHTTPClient.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
 // Session holds a current session, it is thread-safe
 type Session struct {
 	APIKey string
 	APIURL string
 
-	client  *http.Client
+	client  Doer
 	created time.Time
+
+	// preferRealtime is set by WithPreferRealtime
+	preferRealtime bool
+
+	// slots bounds the number of requests in flight, it is nil if unbounded, see WithMaxConcurrency
+	slots chan struct{}
+
+	// timeout bounds the duration of each request, zero if unbounded, see WithTimeout
+	timeout time.Duration
+
+	// limiter spaces requests out, it is nil if unlimited, see WithMaxRequestsPerSecond
+	limiter *limiter
+
+	// rateLimitRetries is the number of times a rate limited request is retried, see WithRateLimitRetries
+	rateLimitRetries int
+
+	// retry is how requests failing transiently are retried, it is nil if they aren't, see WithRetryPolicy
+	retry *RetryPolicy
+
+	// keepRaw is set by WithRawResponse
+	keepRaw bool
+
+	// depth is the default depth set by WithDepth, if hasDepth, and noGeoJSON is set by WithoutGeoJSON
+	depth     uint
+	hasDepth  bool
+	noGeoJSON bool
+
+	// cache stores responses for the duration given by cacheTTL, it is nil if they aren't cached, see WithCache
+	cache    Cache
+	cacheTTL func(endpoint string) time.Duration
+
+	// middlewares wrap the sending of each request, see Use
+	// chain is the resulting function, built on first use and reset when middlewares are added
+	middlewares   []Middleware
+	chain         RoundTripFunc
+	middlewaresMu sync.RWMutex
+
+	// localTimes is set by WithRegionTimezones
+	// timezones caches the timezone of each region, nil if it has none, see RegionLocation
+	localTimes  bool
+	timezones   map[types.ID]*time.Location
+	timezonesMu sync.Mutex
 }
 
 // New creates a new session given an API Key.
 // It acts as a convenience wrapper to NewCustom.
 //
-// Warning: No Timeout is indicated in the default http client, and as such, it is strongly advised to use NewCustom with a custom *http.Client !
-func New(key string) (*Session, error) {
-	return NewCustom(key, path.Clean(defaultAPIURL), defaultClient)
+// Warning: No Timeout is indicated in the default http client, and as such, it is strongly advised to use the WithTimeout option,
+// or NewCustom with a custom *http.Client !
+func New(key string, opts ...Option) (*Session, error) {
+	return NewCustom(key, defaultAPIURL, defaultClient, opts...)
 }
 
-// NewCustom creates a custom new session given an API key, URL to api base & http client
-func NewCustom(key, url string, client *http.Client) (*Session, error) {
-	return &Session{
+// NewCustom creates a custom new session given an API key, URL to api base, http client & options.
+// The client may be any Doer, if it is nil the default client is used.
+// A nil *http.Client isn't a nil Doer though, so it is rejected with an error, as is one given WithHTTPClient.
+func NewCustom(key, url string, client Doer, opts ...Option) (*Session, error) {
+	if client == nil {
+		client = defaultClient
+	}
+
+	s := &Session{
 		APIKey:  key,
 		APIURL:  url,
 		created: time.Now(),
 		client:  client,
-	}, nil
+	}
+
+	// Apply the options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	if c, ok := s.client.(*http.Client); ok && c == nil {
+		return nil, errors.New("NewCustom: nil *http.Client given as the client")
+	}
+
+	return s, nil
+}
+
+// withRealtimeFallback sends a request through send, with the given data freshness.
+// If the Session prefers realtime data and no freshness is given, it is first sent with realtime data, then again with
+// base schedule data if the realtime data is missing or the results are empty, see WithPreferRealtime.
+//
+// send sends the request with the given data freshness, decoding its results, and reports whether they are empty.
+func (s *Session) withRealtimeFallback(freshness types.DataFreshness, send func(types.DataFreshness) (empty bool, err error)) error {
+	if !s.preferRealtime || freshness != "" {
+		_, err := send(freshness)
+		return err
+	}
+
+	empty, err := send(types.DataFreshnessRealTime)
+	switch {
+	case err == nil && !empty:
+		return nil
+	case err != nil && !noRealtimeData(err):
+		return err
+	}
+
+	// Fall back to the base schedule
+	_, err = send(types.DataFreshnessBaseSchedule)
+	return err
 }
 
-// departures is the internal function used by Departures & Arrivals functions
+// noRealtimeData reports whether err is an error returned by the API as no realtime data is available for a request,
+// such as a 404 "unknown_api" in a region without realtime, as opposed to an invalid request.
+func noRealtimeData(err error) bool {
+	var remote *RemoteError
+	if !errors.As(err, &remote) {
+		return false
+	}
+	switch remote.ID {
+	case RemoteErrUnknownAPI, RemoteErrDateOutOfBounds, RemoteErrNoSolution, RemoteErrUnknownObject:
+		return true
+	}
+	return remote.StatusCode == http.StatusNotFound
+}
+
+// connections is the internal function used by Departures & Arrivals functions
 func (s *Session) connections(ctx context.Context, url string, req ConnectionsRequest) (*ConnectionsResults, error) {
-	results := &ConnectionsResults{}
-	err := s.request(ctx, url, req, results)
+	var results *ConnectionsResults
+	err := s.withRealtimeFallback(req.Freshness, func(freshness types.DataFreshness) (bool, error) {
+		req.Freshness = freshness
+		results = &ConnectionsResults{}
+		err := s.request(ctx, url, req, results)
+		return len(results.Connections) == 0, err
+	})
 	return results, err
 }
 
 // departures is the internal function used by Journeys functions
 func (s *Session) departures(ctx context.Context, url string, req DeparturesRequest) (*DeparturesResults, error) {
-	results := &DeparturesResults{session: s}
-	err := s.request(ctx, url, req, results)
+	var results *DeparturesResults
+	err := s.withRealtimeFallback(req.Freshness, func(freshness types.DataFreshness) (bool, error) {
+		req.Freshness = freshness
+		results = &DeparturesResults{session: s}
+		err := s.request(ctx, url, req, results)
+		return len(results.Departures) == 0, err
+	})
 	return results, err
 }
 
 // Departures computes a list of Departures according to the parameters given
 func (s *Session) Departures(ctx context.Context, req DeparturesRequest) (*DeparturesResults, error) {
 	// Create the URL
-	reqURL := s.APIURL + "/" + departuresEndpoint
+	reqURL := s.APIURL + "/" + req.path() + departuresEndpoint
 
 	return s.departures(ctx, reqURL, req)
 }
@@ -78,7 +197,7 @@ func (s *Session) Departures(ctx context.Context, req DeparturesRequest) (*Depar
 // DeparturesC requests the departures from a point described by coordinates.
 func (s *Session) DeparturesC(ctx context.Context, req ConnectionsRequest, coords types.Coordinates) (*ConnectionsResults, error) {
 	// Create the URL
-	coordsQ := string(coords.ID())
+	coordsQ := coords.String()
 	scopeURL := s.APIURL + "/coverage/" + coordsQ + "/coords/" + coordsQ + "/" + departuresEndpoint
 
 	return s.connections(ctx, scopeURL, req)
@@ -88,11 +207,18 @@ func (s *Session) DeparturesC(ctx context.Context, req ConnectionsRequest, coord
 func (s *Session) journeys(ctx context.Context, url string, req JourneyRequest) (*JourneyResults, error) {
 	results := &JourneyResults{session: s}
 	err := s.request(ctx, url, req, results)
+	if err == nil {
+		err = results.failure()
+	}
 	return results, err
 }
 
 // Journeys computes a list of journeys according to the parameters given
 func (s *Session) Journeys(ctx context.Context, req JourneyRequest) (*JourneyResults, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Create the URL
 	reqURL := s.APIURL + "/" + journeysEndpoint
 
@@ -100,6 +226,31 @@ func (s *Session) Journeys(ctx context.Context, req JourneyRequest) (*JourneyRes
 	return s.journeys(ctx, reqURL, req)
 }
 
+// AccessibleJourneys computes a list of journeys accessible to wheelchair users.
+//
+// The request is sent with the wheelchair flag & traveler type set, then the journeys failing the types.Journey.IsFullyAccessible audit
+// are filtered out client-side, as the server sometimes returns journeys with inaccessible transfers.
+// It is context aware.
+func (s *Session) AccessibleJourneys(ctx context.Context, req JourneyRequest) (*JourneyResults, error) {
+	req.Wheelchair = true
+	req.Traveler = types.TravelerInWheelchair
+
+	results, err := s.Journeys(ctx, req)
+	if err != nil {
+		return results, err
+	}
+
+	accessible := results.Journeys[:0]
+	for _, j := range results.Journeys {
+		if ok, _ := j.IsFullyAccessible(); ok {
+			accessible = append(accessible, j)
+		}
+	}
+	results.Journeys = accessible
+
+	return results, nil
+}
+
 // places is the internal function used by Places functions
 func (s *Session) places(ctx context.Context, url string, params PlacesRequest) (*PlacesResults, error) {
 	results := &PlacesResults{session: s}
@@ -155,37 +306,123 @@ func (s *Session) RegionByID(ctx context.Context, req RegionRequest, id types.ID
 // It is context aware.
 func (s *Session) RegionByPos(ctx context.Context, req RegionRequest, coords types.Coordinates) (*RegionResults, error) {
 	// Build the URL
-	coordsQ := string(coords.ID())
+	coordsQ := coords.String()
 	reqURL := s.APIURL + "/" + regionEndpoint + "/" + coordsQ
 
 	// Call and return
 	return s.region(ctx, reqURL, req)
 }
 
+// RegionByCoordinates returns the region covering the given position, so that further requests can be scoped to it.
+// If no region covers it, ErrOutsideCoverage is returned.
+// It is context aware.
+func (s *Session) RegionByCoordinates(ctx context.Context, coords types.Coordinates) (*types.Region, error) {
+	results, err := s.RegionByPos(ctx, RegionRequest{}, coords)
+	if remoteNotFound(err) {
+		return nil, ErrOutsideCoverage
+	} else if err != nil {
+		return nil, errors.Wrap(err, "error while looking up the region")
+	}
+
+	if len(results.Regions) == 0 {
+		return nil, ErrOutsideCoverage
+	}
+	return &results.Regions[0], nil
+}
+
+// RegionContaining returns the ID of the region covering the given position, see RegionByCoordinates.
+// It is context aware.
+func (s *Session) RegionContaining(ctx context.Context, coords types.Coordinates) (types.ID, error) {
+	region, err := s.RegionByCoordinates(ctx, coords)
+	if err != nil {
+		return "", err
+	}
+	return region.ID, nil
+}
+
+// JourneysFromHere computes journeys from the user's position to the given destination, such as for a "from my location" feature.
+// The origin & destination of req are overridden.
+//
+// The request is scoped to the region covering the position, if there's none ErrOutsideCoverage is returned
+// instead of the cryptic error the API would give.
+// It is context aware.
+func (s *Session) JourneysFromHere(ctx context.Context, here types.Coordinates, to types.ID, req JourneyRequest) (*JourneyResults, error) {
+	region, err := s.RegionContaining(ctx, here)
+	if err != nil {
+		return nil, err
+	}
+
+	req.From = types.ID(here.String())
+	req.To = to
+	return s.Scope(region).Journeys(ctx, req)
+}
+
+// ResolveCode finds the places (stop areas & stop points) of a region bearing a given external code.
+// system is the type of the code (eg "source" or "gtfs_stop_code") and value is the code itself.
+//
+// This is the inverse of show_codes: it maps an identifier coming from another system (such as a GTFS stop_id) to the navitia object.
+// As codes aren't guaranteed to be unique, several places may be returned. If nothing matches, the returned slice is empty.
+// It is context aware.
+func (s *Session) ResolveCode(ctx context.Context, region types.ID, system, value string) ([]types.Place, error) {
+	var places []types.Place
+
+	// Search every collection that may hold the code
+	for _, c := range codeCollections {
+		reqURL := s.APIURL + "/" + regionEndpoint + "/" + string(region) + "/" + c.endpoint
+		req := codeRequest{Object: c.object, System: system, Value: value}
+		results := &codeResults{}
+
+		err := s.request(ctx, reqURL, req, results)
+		if remoteNotFound(err) {
+			// No object of this kind bears the code
+			continue
+		} else if err != nil {
+			return places, errors.Wrapf(err, "error while resolving code in %s", c.endpoint)
+		}
+
+		places = append(places, results.places()...)
+	}
+
+	return places, nil
+}
+
 // requestURL requests a url, with the query already encoded in, and decodes the result in res.
+// The datetimes of results scoped to a region are set in its timezone if asked to, see WithRegionTimezones.
 func (s *Session) requestURL(ctx context.Context, url string, res results) error {
+	if err := s.fetch(ctx, url, res); err != nil {
+		return err
+	}
+	return s.localize(ctx, url, res)
+}
+
+// fetch requests a url, with the query already encoded in, and decodes the result in res as given by the API.
+func (s *Session) fetch(ctx context.Context, url string, res results) error {
 	// Store creation time
 	res.creating()
 
-	// Create the request
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		return errors.Wrapf(err, "couldn't create new request (for %s)", url)
+	// Answer from the cache if possible, a stale response being revalidated with a conditional request
+	key, ttl, cached := s.cached(url)
+	if cached != nil && cached.fresh() {
+		res.sending()
+		return s.decodeCached(cached.Body, res)
 	}
 
-	// Add basic auth
-	req.SetBasicAuth(s.APIKey, "")
+	// Bound the request's duration & concurrency until the response is read
+	ctx, release, err := s.acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	// Execute the request
-	resp, err := s.client.Do(req)
+	var header http.Header
+	if cached != nil {
+		header = cached.conditions()
+	}
+	resp, err := s.send(ctx, url, header)
 	res.sending()
-
-	// Check the response
 	if err != nil {
-		return errors.Wrap(err, "error while executing request")
-	}
-	if resp.StatusCode != http.StatusOK {
-		return parseRemoteError(resp)
+		return err
 	}
 
 	// Defer the close
@@ -195,6 +432,17 @@ func (s *Session) requestURL(ctx context.Context, url string, res results) error
 		}
 	}()
 
+	// Check the response
+	res.rateLimited(resp.Header)
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		cached.revalidate(resp.Header, ttl)
+		s.store(key, ttl, *cached)
+		return s.decodeCached(cached.Body, res)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return parseRemoteError(resp)
+	}
+
 	// Check for cancellation
 	select {
 	case <-ctx.Done():
@@ -205,23 +453,149 @@ func (s *Session) requestURL(ctx context.Context, url string, res results) error
 	// Limit the reader
 	reader := io.LimitReader(resp.Body, maxSize)
 
-	// Parse the now limited body
-	dec := json.NewDecoder(reader)
-	err = dec.Decode(res)
+	// Parse the now limited body, storing it in the cache & keeping it if need be
+	var store func(body []byte)
+	if ttl > 0 || s.keepRaw {
+		store = func(body []byte) {
+			if ttl > 0 {
+				s.store(key, ttl, newCachedResponse(body, resp.Header, ttl))
+			}
+			if s.keepRaw {
+				res.keepRaw(body)
+			}
+		}
+	}
+	err = decodeResults(reader, res, store)
 	if err != nil {
-		return errors.Wrap(err, "JSON decoding failed")
+		return err
 	}
 	res.parsing()
 
 	return err
 }
 
+// acquire bounds the duration of a request as set by WithTimeout, and waits for a slot if the concurrency is bounded.
+// The returned context is to be used for the request, and release called once its response is read.
+func (s *Session) acquire(ctx context.Context) (context.Context, func(), error) {
+	cancel := func() {}
+	if s.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+	}
+
+	if s.slots == nil {
+		return ctx, cancel, nil
+	}
+	select {
+	case s.slots <- struct{}{}:
+		return ctx, func() {
+			<-s.slots
+			cancel()
+		}, nil
+	case <-ctx.Done():
+		cancel()
+		return ctx, nil, ctx.Err()
+	}
+}
+
+// send sends a GET request to url with the given header, which may be nil, once allowed by the client-side rate limit if any.
+// Requests rejected by the API's rate limiting are retried as allowed by WithRateLimitRetries,
+// and those failing transiently as allowed by WithRetryPolicy.
+func (s *Session) send(ctx context.Context, url string, header http.Header) (*http.Response, error) {
+	// Retries are counted separately for rate limiting & transient failures
+	var rateLimited, retried int
+	for {
+		if err := s.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		// Create the request
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "couldn't create new request (for %s)", url)
+		}
+
+		// Add the headers & basic auth
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		req.SetBasicAuth(s.APIKey, "")
+
+		resp, err := s.roundTrip()(req)
+
+		// Find out whether to retry, and after which delay
+		var delay time.Duration
+		switch {
+		case err == nil && resp.StatusCode == http.StatusTooManyRequests && rateLimited < s.rateLimitRetries:
+			delay = rateLimitDelay(resp, rateLimited)
+			rateLimited++
+		case ctx.Err() == nil && s.retry.retries(retried+1, resp, err):
+			delay = s.retry.backoff(retried + 2)
+			retried++
+		case err != nil:
+			return nil, errors.Wrap(err, "error while executing request")
+		default:
+			return resp, nil
+		}
+
+		// Wait before retrying, the rejected response is discarded
+		if resp != nil {
+			_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxSize))
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// maxPooledBufferSize is the capacity above which a buffer isn't put back in the pool,
+// so that a few full-region dumps don't pin tens of megabytes for the lifetime of the program
+const maxPooledBufferSize = 1 << 20
+
+// bufferPool holds the buffers responses are read into before being decoded, so that they are reused across requests
+var bufferPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// decodeResults reads a whole response body and decodes it in res.
+// If store isn't nil, it is given the body once successfully decoded, which it must copy to retain.
+//
+// Reading the body in a pooled buffer and decoding it in one go is much cheaper than streaming it through a json.Decoder,
+// which grows its own buffer for each response.
+func decodeResults(r io.Reader, res results, store func(body []byte)) error {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	defer func() {
+		if buf.Cap() > maxPooledBufferSize {
+			return
+		}
+		buf.Reset()
+		bufferPool.Put(buf)
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return errors.Wrap(err, "error while reading response")
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), res); err != nil {
+		return errors.Wrap(err, "JSON decoding failed")
+	}
+	if store != nil {
+		store(buf.Bytes())
+	}
+	return nil
+}
+
 // request does a request given a url, query and results to populate
 func (s *Session) request(ctx context.Context, baseURL string, query query, res results) error {
 	// Encode the parameters
-	values, err := query.toURL()
+	values, err := s.encode(ctx, baseURL, query)
 	if err != nil {
-		return errors.Wrap(err, "error while retrieving url values to be encoded")
+		return err
 	}
 	reqURL := baseURL + "?" + values.Encode()
 
@@ -229,6 +603,35 @@ func (s *Session) request(ctx context.Context, baseURL string, query query, res
 	return s.requestURL(ctx, reqURL, res)
 }
 
+// encode returns the parameters of a request to baseURL, along with the defaults of the Session, see defaults.
+// If the Session was created WithRegionTimezones, its date times are given in the local time of the region, see utils.InLocation.
+func (s *Session) encode(ctx context.Context, baseURL string, q query) (url.Values, error) {
+	loc, err := s.resultsLocation(ctx, baseURL)
+	if err != nil {
+		return nil, err
+	}
+	if loc != nil {
+		q = utils.InLocation(q, loc).(query)
+	}
+
+	values, err := q.toURL()
+	if err != nil {
+		return nil, errors.Wrap(err, "error while retrieving url values to be encoded")
+	}
+	s.defaults(values)
+	return values, nil
+}
+
+// defaults adds the parameters set for every request by WithDepth & WithoutGeoJSON, unless the request sets them already
+func (s *Session) defaults(values url.Values) {
+	if s.hasDepth && values.Get("depth") == "" {
+		values.Set("depth", strconv.FormatUint(uint64(s.depth), 10))
+	}
+	if s.noGeoJSON && values.Get("disable_geojson") == "" {
+		values.Set("disable_geojson", "true")
+	}
+}
+
 // Scope creates a coverage-scoped session given a region ID.
 func (s *Session) Scope(region types.ID) *Scope {
 	return &Scope{region: region, session: s}
@@ -238,7 +641,14 @@ func (s *Session) Scope(region types.ID) *Scope {
 func (s *Session) vehicleJourneys(ctx context.Context, url string, req VehicleJourneyRequest) (*VehicleJourneyResults, error) {
 	results := &VehicleJourneyResults{session: s}
 	err := s.request(ctx, url, req, results)
-	return results, err
+	if err != nil {
+		return results, err
+	}
+
+	for i := range results.VehicleJourneys {
+		results.VehicleJourneys[i].ResolveDisruptions(results.Disruptions)
+	}
+	return results, nil
 }
 
 const vehicleJourneysEndpoint string = "vehicle_journeys"
@@ -0,0 +1,85 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const stopSchedulesEndpoint string = "stop_schedules"
+
+// StopSchedulesResults holds the results of a stop schedules request: the timetable of each route serving the stop.
+type StopSchedulesResults struct {
+	StopSchedules []types.StopSchedule `json:"stop_schedules"`
+	Paging        Paging               `json:"links"`
+	Pagination    Pagination           `json:"pagination"`
+	Logging       `json:"-"`
+	session       *Session
+}
+
+// Count returns the number of stop schedules available in a StopSchedulesResults
+func (ssr *StopSchedulesResults) Count() int {
+	return len(ssr.StopSchedules)
+}
+
+// TotalAvailable returns the total number of stop schedules available across all pages.
+func (ssr *StopSchedulesResults) TotalAvailable() int {
+	return ssr.Pagination.total(len(ssr.StopSchedules))
+}
+
+// StopSchedulesRequest contains the optional parameters for a StopSchedules request.
+type StopSchedulesRequest struct {
+	// From what time on do you want to see the schedules ? (default now)
+	From time.Time `param:"from_datetime"`
+
+	// Maximum duration between From and the retrieved passing times (default 24h)
+	Duration time.Duration `param:"duration,seconds"`
+
+	// Maximum amount of passing times per schedule
+	ItemsPerSchedule uint `param:"items_per_schedule"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+
+	// Freshness of the data
+	Freshness types.DataFreshness `param:"data_freshness"`
+}
+
+func (req StopSchedulesRequest) toURL() (url.Values, error) {
+	if err := checkEnums("", req.Freshness); err != nil {
+		return nil, err
+	}
+
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// stopSchedules is the internal function used by StopSchedules functions
+func (s *Session) stopSchedules(ctx context.Context, url string, req StopSchedulesRequest) (*StopSchedulesResults, error) {
+	var results *StopSchedulesResults
+	err := s.withRealtimeFallback(req.Freshness, func(freshness types.DataFreshness) (bool, error) {
+		req.Freshness = freshness
+		results = &StopSchedulesResults{session: s}
+		err := s.request(ctx, url, req, results)
+		return len(results.StopSchedules) == 0, err
+	})
+	return results, err
+}
+
+// StopSchedules requests the schedules of the routes serving a given StopPoint, such as for a stop display board.
+// Each passing time comes with its data freshness, telling whether it is realtime.
+func (scope *Scope) StopSchedules(ctx context.Context, req StopSchedulesRequest, resource types.ID) (*StopSchedulesResults, error) {
+	// Create the URL
+	scopeURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/stop_points/" + string(resource) + "/" + stopSchedulesEndpoint
+
+	return scope.session.stopSchedules(ctx, scopeURL, req)
+}
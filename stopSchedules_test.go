@@ -0,0 +1,71 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_StopSchedulesResults_Unmarshal tests unmarshalling for StopSchedulesResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_StopSchedulesResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["stop_schedules"], reflect.TypeOf(StopSchedulesResults{}))
+}
+
+// Test_Scope_StopSchedules checks that the schedules at a stop point are decoded with their data freshness,
+// and that realtime is tried first when preferred.
+func Test_Scope_StopSchedules(t *testing.T) {
+	fixture := testData["stop_schedules"].correct["daumesnil.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const stopPoint = "stop_point:RAT:SP:DAUM1"
+	var freshnesses []string
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/stop_points/" + stopPoint + "/stop_schedules"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+
+		freshness := r.URL.Query().Get("data_freshness")
+		freshnesses = append(freshnesses, freshness)
+		if freshness == string(types.DataFreshnessRealTime) {
+			_, _ = w.Write([]byte(`{"stop_schedules": []}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	}), WithPreferRealtime())
+
+	res, err := session.Scope("fr-idf").StopSchedules(context.Background(), StopSchedulesRequest{}, stopPoint)
+	if err != nil {
+		t.Fatalf("error in StopSchedules: %v", err)
+	}
+
+	expected := []string{string(types.DataFreshnessRealTime), string(types.DataFreshnessBaseSchedule)}
+	if !reflect.DeepEqual(freshnesses, expected) {
+		t.Errorf("unexpected requested freshnesses: got %v, expected %v", freshnesses, expected)
+	}
+
+	if res.Count() != 2 {
+		t.Fatalf("expected 2 stop schedules, got %d", res.Count())
+	}
+	ss := res.StopSchedules[0]
+	if len(ss.DateTimes) != 3 {
+		t.Fatalf("expected 3 passing times, got %d", len(ss.DateTimes))
+	}
+	if f := ss.DateTimes[0].DataFreshness; f != types.DataFreshnessRealTime {
+		t.Errorf("expected the first passing time to be realtime, got %q", f)
+	}
+	if f := ss.DateTimes[2].DataFreshness; f != types.DataFreshnessBaseSchedule {
+		t.Errorf("expected the last passing time to be base schedule, got %q", f)
+	}
+	if add := res.StopSchedules[1].Additional; add != "no_departure_this_day" {
+		t.Errorf("unexpected additional information: %q", add)
+	}
+}
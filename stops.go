@@ -0,0 +1,159 @@
+package navitia
+
+import (
+	"context"
+
+	"github.com/govitia/navitia/types"
+)
+
+// StopAreasResults holds the results of a stop areas request.
+type StopAreasResults struct {
+	StopAreas  []types.StopArea `json:"stop_areas"`
+	Paging     Paging           `json:"links"`
+	Pagination Pagination       `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of stop areas available in a StopAreasResults
+func (sar *StopAreasResults) Count() int {
+	return len(sar.StopAreas)
+}
+
+// TotalAvailable returns the total number of stop areas available across all pages.
+func (sar *StopAreasResults) TotalAvailable() int {
+	return sar.Pagination.total(len(sar.StopAreas))
+}
+
+func (sar *StopAreasResults) paging() Paging {
+	return sar.Paging
+}
+
+func (sar *StopAreasResults) newPage() pagedResults {
+	return &StopAreasResults{session: sar.session}
+}
+
+func (sar *StopAreasResults) merge(page pagedResults) {
+	next := page.(*StopAreasResults)
+	sar.StopAreas = append(sar.StopAreas, next.StopAreas...)
+	sar.Paging = next.Paging
+}
+
+// ForEach calls f with each of the stop areas, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (sar *StopAreasResults) ForEach(ctx context.Context, f func(stopArea types.StopArea) error) error {
+	return walkPages(ctx, sar.session, sar, func(page pagedResults) error {
+		for _, stopArea := range page.(*StopAreasResults).StopAreas {
+			if err := f(stopArea); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StopPointsResults holds the results of a stop points request.
+type StopPointsResults struct {
+	StopPoints []types.StopPoint `json:"stop_points"`
+	Paging     Paging            `json:"links"`
+	Pagination Pagination        `json:"pagination"`
+	Logging    `json:"-"`
+	session    *Session
+}
+
+// Count returns the number of stop points available in a StopPointsResults
+func (spr *StopPointsResults) Count() int {
+	return len(spr.StopPoints)
+}
+
+// TotalAvailable returns the total number of stop points available across all pages.
+func (spr *StopPointsResults) TotalAvailable() int {
+	return spr.Pagination.total(len(spr.StopPoints))
+}
+
+func (spr *StopPointsResults) paging() Paging {
+	return spr.Paging
+}
+
+func (spr *StopPointsResults) newPage() pagedResults {
+	return &StopPointsResults{session: spr.session}
+}
+
+func (spr *StopPointsResults) merge(page pagedResults) {
+	next := page.(*StopPointsResults)
+	spr.StopPoints = append(spr.StopPoints, next.StopPoints...)
+	spr.Paging = next.Paging
+}
+
+// ForEach calls f with each of the stop points, then with those of the following pages, which are fetched as needed.
+// It stops at the first error, either returned by f or while fetching a page, and returns it.
+func (spr *StopPointsResults) ForEach(ctx context.Context, f func(stopPoint types.StopPoint) error) error {
+	return walkPages(ctx, spr.session, spr, func(page pagedResults) error {
+		for _, stopPoint := range page.(*StopPointsResults).StopPoints {
+			if err := f(stopPoint); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StopAreas lists the stop areas of the region, a page at a time: use req.StartPage or the results' Paging to get the others.
+func (scope *Scope) StopAreas(ctx context.Context, req CollectionRequest) (*StopAreasResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopAreasEndpoint
+
+	results := &StopAreasResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// StopArea retrieves a single stop area by its ID.
+//
+// If the stop area doesn't exist, a *RemoteError with a 404 status code is returned.
+func (scope *Scope) StopArea(ctx context.Context, id types.ID) (*types.StopArea, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopAreasEndpoint + "/" + string(id)
+
+	results := &StopAreasResults{session: scope.session}
+	err := scope.session.requestURL(ctx, reqURL, results)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if results.Count() == 0 {
+		return nil, notFound("stop area", id)
+	}
+	return &results.StopAreas[0], nil
+}
+
+// StopPoints lists the stop points of the region, a page at a time: use req.StartPage or the results' Paging to get the others.
+func (scope *Scope) StopPoints(ctx context.Context, req CollectionRequest) (*StopPointsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopPointsEndpoint
+
+	results := &StopPointsResults{session: scope.session}
+	err := scope.session.collection(ctx, reqURL, req, results)
+	return results, err
+}
+
+// StopPoint retrieves a single stop point by its ID.
+//
+// If the stop point doesn't exist, a *RemoteError with a 404 status code is returned.
+func (scope *Scope) StopPoint(ctx context.Context, id types.ID) (*types.StopPoint, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopPointsEndpoint + "/" + string(id)
+
+	results := &StopPointsResults{session: scope.session}
+	err := scope.session.requestURL(ctx, reqURL, results)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some instances answer with an empty list rather than a 404
+	if results.Count() == 0 {
+		return nil, notFound("stop point", id)
+	}
+	return &results.StopPoints[0], nil
+}
@@ -0,0 +1,182 @@
+package navitia
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_StopAreas checks that stop areas are listed a page at a time, and that the next page can be followed
+func Test_Scope_StopAreas(t *testing.T) {
+	var session *Session
+	session = newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/stop_areas"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		page := r.URL.Query().Get("start_page")
+		if page == "" {
+			page = "0"
+		}
+		next := ""
+		if page == "1" {
+			next = fmt.Sprintf(`{"type": "next", "href": "%s/coverage/fr-idf/stop_areas?count=1&start_page=2", "templated": false}`, session.APIURL)
+		}
+		fmt.Fprintf(w, `{
+			"stop_areas": [{"id": "stop_area:RAT:SA:P%s", "name": "Page %s"}],
+			"pagination": {"start_page": %s, "items_on_page": 1, "items_per_page": 1, "total_result": 3},
+			"links": [%s]
+		}`, page, page, page, next)
+	}))
+
+	res, err := session.Scope("fr-idf").StopAreas(context.Background(), CollectionRequest{Count: 1, StartPage: 1})
+	if err != nil {
+		t.Fatalf("error in StopAreas: %v", err)
+	}
+	if res.Count() != 1 || res.TotalAvailable() != 3 || res.StopAreas[0].ID != "stop_area:RAT:SA:P1" {
+		t.Fatalf("unexpected first page: %+v (total %d)", res.StopAreas, res.TotalAvailable())
+	}
+
+	if res.Paging.Next == nil {
+		t.Fatal("expected a next page")
+	}
+	next := &StopAreasResults{}
+	if err := res.Paging.Next(context.Background(), session, next); err != nil {
+		t.Fatalf("error while getting the next page: %v", err)
+	}
+	if next.Count() != 1 || next.StopAreas[0].ID != "stop_area:RAT:SA:P2" {
+		t.Errorf("unexpected next page: %+v", next.StopAreas)
+	}
+}
+
+// Test_Scope_StopPoint checks that a single stop point is retrieved by its ID, and that an unknown one gives a 404 RemoteError
+func Test_Scope_StopPoint(t *testing.T) {
+	const id = "stop_point:RAT:SP:DAUM1"
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coverage/fr-idf/stop_points/"+id {
+			_, _ = w.Write([]byte(`{"stop_points": [], "links": []}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"stop_points": [{
+			"id": "` + id + `",
+			"name": "Daumesnil",
+			"label": "Daumesnil (Paris)",
+			"coord": {"lon": "2.395", "lat": "48.839"},
+			"stop_area": {"id": "stop_area:RAT:SA:DAUM", "name": "Daumesnil"}
+		}]}`))
+	}))
+	scope := session.Scope("fr-idf")
+
+	sp, err := scope.StopPoint(context.Background(), id)
+	if err != nil {
+		t.Fatalf("error in StopPoint: %v", err)
+	}
+	if sp.Name != "Daumesnil" || sp.StopArea == nil || sp.StopArea.ID != "stop_area:RAT:SA:DAUM" {
+		t.Errorf("unexpected stop point: %+v", sp)
+	}
+
+	_, err = scope.StopPoint(context.Background(), "stop_point:unknown")
+	if remote, ok := err.(*RemoteError); !ok || remote.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a 404 *RemoteError, got %v", err)
+	}
+}
+
+// newPagedStopAreasSession creates a session whose stop areas come as 3 pages of 2, counting the requests made
+func newPagedStopAreasSession(t *testing.T, requests *int) *Session {
+	var session *Session
+	session = newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page := r.URL.Query().Get("start_page")
+		if page == "" {
+			page = "0"
+		}
+		next := ""
+		if page != "2" {
+			next = fmt.Sprintf(`{"type": "next", "href": "%s/coverage/fr-idf/stop_areas?count=2&start_page=%c", "templated": false}`, session.APIURL, page[0]+1)
+		}
+		fmt.Fprintf(w, `{
+			"stop_areas": [{"id": "stop_area:RAT:SA:P%[1]sA"}, {"id": "stop_area:RAT:SA:P%[1]sB"}],
+			"pagination": {"start_page": %[1]s, "items_on_page": 2, "items_per_page": 2, "total_result": 6},
+			"links": [%[2]s]
+		}`, page, next)
+	}))
+	return session
+}
+
+// Test_StopAreasResults_ForEach checks that every page is walked through, and that an error from the callback stops the walk
+func Test_StopAreasResults_ForEach(t *testing.T) {
+	var requests int
+	session := newPagedStopAreasSession(t, &requests)
+	ctx := context.Background()
+	res, err := session.Scope("fr-idf").StopAreas(ctx, CollectionRequest{Count: 2})
+	if err != nil {
+		t.Fatalf("error in StopAreas: %v", err)
+	}
+
+	var ids []string
+	err = res.ForEach(ctx, func(sa types.StopArea) error {
+		ids = append(ids, string(sa.ID))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error in ForEach: %v", err)
+	}
+	if len(ids) != 6 || ids[0] != "stop_area:RAT:SA:P0A" || ids[5] != "stop_area:RAT:SA:P2B" {
+		t.Errorf("unexpected stop areas: %v", ids)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+
+	// Stop on the first stop area of the second page
+	errStop := errors.New("stop")
+	requests = 0
+	var count int
+	err = res.ForEach(ctx, func(sa types.StopArea) error {
+		count++
+		if sa.ID == "stop_area:RAT:SA:P1A" {
+			return errStop
+		}
+		return nil
+	})
+	if err != errStop {
+		t.Errorf("expected the callback's error, got %v", err)
+	}
+	if count != 3 || requests != 1 {
+		t.Errorf("expected the walk to stop after 3 stop areas & 1 request, got %d & %d", count, requests)
+	}
+}
+
+// Test_Scope_StopAreas_AllPages checks that every page is merged into the results, up to the maximum amount of pages
+func Test_Scope_StopAreas_AllPages(t *testing.T) {
+	var requests int
+	session := newPagedStopAreasSession(t, &requests)
+	scope := session.Scope("fr-idf")
+	ctx := context.Background()
+
+	res, err := scope.StopAreas(ctx, CollectionRequest{Count: 2, AllPages: true})
+	if err != nil {
+		t.Fatalf("error in StopAreas: %v", err)
+	}
+	if res.Count() != 6 || res.Count() != res.TotalAvailable() || res.StopAreas[5].ID != "stop_area:RAT:SA:P2B" {
+		t.Errorf("unexpected stop areas: %+v", res.StopAreas)
+	}
+	if res.Paging.Next != nil {
+		t.Error("expected no next page once every page is merged")
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+
+	requests = 0
+	res, err = scope.StopAreas(ctx, CollectionRequest{Count: 2, AllPages: true, MaxPages: 2})
+	if err != nil {
+		t.Fatalf("error in StopAreas: %v", err)
+	}
+	if res.Count() != 4 || res.TotalAvailable() != 6 || requests != 2 {
+		t.Errorf("expected 4 stop areas out of 6 in 2 requests, got %d out of %d in %d", res.Count(), res.TotalAvailable(), requests)
+	}
+}
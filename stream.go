@@ -0,0 +1,193 @@
+package navitia
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+)
+
+// StreamStopAreas sends the stop areas of the region on out as they are decoded, page after page starting from req.StartPage,
+// then closes out.
+//
+// Unlike StopAreas with AllPages, the responses are never held in memory, which suits full-region dumps:
+// neither the response size limit nor the cache apply. The Session's options setting the parameters of every request,
+// such as WithDepth, and WithRegionTimezones apply as they do to StopAreas.
+// At most req.MaxPages pages are fetched if it is set, req.AllPages being ignored.
+func (scope *Scope) StreamStopAreas(ctx context.Context, req CollectionRequest, out chan<- types.StopArea) error {
+	defer close(out)
+
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopAreasEndpoint
+	return scope.session.stream(ctx, reqURL, req, stopAreasEndpoint, req.MaxPages, func(decode func(v interface{}) error) error {
+		var stopArea types.StopArea
+		if err := decode(&stopArea); err != nil {
+			return err
+		}
+		select {
+		case out <- stopArea:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// StreamStopPoints sends the stop points of the region on out as they are decoded, then closes out, see StreamStopAreas.
+func (scope *Scope) StreamStopPoints(ctx context.Context, req CollectionRequest, out chan<- types.StopPoint) error {
+	defer close(out)
+
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + stopPointsEndpoint
+	return scope.session.stream(ctx, reqURL, req, stopPointsEndpoint, req.MaxPages, func(decode func(v interface{}) error) error {
+		var stopPoint types.StopPoint
+		if err := decode(&stopPoint); err != nil {
+			return err
+		}
+		select {
+		case out <- stopPoint:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// StreamPOIs sends the points of interest matching req on out as they are decoded, page after page starting from req.StartPage,
+// then closes out, see StreamStopAreas.
+func (scope *Scope) StreamPOIs(ctx context.Context, req POIsRequest, out chan<- types.POI) error {
+	defer close(out)
+
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + req.path() + poisEndpoint
+	return scope.session.stream(ctx, reqURL, req, poisEndpoint, 0, func(decode func(v interface{}) error) error {
+		var poi types.POI
+		if err := decode(&poi); err != nil {
+			return err
+		}
+		select {
+		case out <- poi:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// stream requests a collection, then its following pages up to maxPages (unbounded if zero),
+// calling item for each object of the array under key, one at a time, with a function decoding it, see localize.
+func (s *Session) stream(ctx context.Context, baseURL string, query query, key string, maxPages uint, item func(decode func(v interface{}) error) error) error {
+	values, err := s.encode(ctx, baseURL, query)
+	if err != nil {
+		return err
+	}
+
+	loc, err := s.resultsLocation(ctx, baseURL)
+	if err != nil {
+		return err
+	}
+	decode := func(dec *json.Decoder) error {
+		return item(func(v interface{}) error {
+			if err := dec.Decode(v); err != nil {
+				return err
+			}
+			types.Localize(v, loc)
+			return nil
+		})
+	}
+
+	url := baseURL + "?" + values.Encode()
+	for page := uint(0); url != "" && (maxPages == 0 || page < maxPages); page++ {
+		url, err = s.streamPage(ctx, url, key, decode)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// streamPage requests a page of a collection and decodes it as it is received, returning the URL of the next page if any
+func (s *Session) streamPage(ctx context.Context, url string, key string, item func(dec *json.Decoder) error) (next string, err error) {
+	ctx, release, err := s.acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	resp, err := s.send(ctx, url, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Println(err)
+		}
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", parseRemoteError(resp)
+	}
+
+	next, err = decodeStream(json.NewDecoder(resp.Body), key, item)
+	return next, errors.Wrap(err, "error while decoding streamed response")
+}
+
+// decodeStream walks through a response object, calling item for each element of the array under key.
+// Other members are skipped, except for the links from which the URL of the next page is returned.
+func decodeStream(dec *json.Decoder, key string, item func(dec *json.Decoder) error) (next string, err error) {
+	if err := expectDelim(dec, '{'); err != nil {
+		return "", err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", err
+		}
+
+		switch tok {
+		case key:
+			if err := expectDelim(dec, '['); err != nil {
+				return "", errors.Wrapf(err, "unexpected %q member", key)
+			}
+			for dec.More() {
+				if err := item(dec); err != nil {
+					return "", err
+				}
+			}
+			if err := expectDelim(dec, ']'); err != nil {
+				return "", err
+			}
+		case "links":
+			var links types.Links
+			if err := dec.Decode(&links); err != nil {
+				return "", errors.Wrap(err, "error while decoding links")
+			}
+			for _, l := range links {
+				if l.Type == "next" {
+					next = l.Href
+				}
+			}
+		default:
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return next, expectDelim(dec, '}')
+}
+
+// expectDelim reads the next token, which must be the given delimiter
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != delim {
+		return errors.Errorf("expected %s, got %v", delim, tok)
+	}
+	return nil
+}
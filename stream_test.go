@@ -0,0 +1,129 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+)
+
+// Test_Scope_StreamStopAreas checks that every page is streamed in order, up to MaxPages
+func Test_Scope_StreamStopAreas(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxPages uint
+		expected []string
+	}{
+		{
+			name: "all pages",
+			expected: []string{
+				"stop_area:RAT:SA:P0A", "stop_area:RAT:SA:P0B",
+				"stop_area:RAT:SA:P1A", "stop_area:RAT:SA:P1B",
+				"stop_area:RAT:SA:P2A", "stop_area:RAT:SA:P2B",
+			},
+		},
+		{
+			name:     "max pages",
+			maxPages: 1,
+			expected: []string{"stop_area:RAT:SA:P0A", "stop_area:RAT:SA:P0B"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			var requests int
+			session := newPagedStopAreasSession(t, &requests)
+
+			out := make(chan types.StopArea)
+			errc := make(chan error, 1)
+			go func() {
+				errc <- session.Scope("fr-idf").StreamStopAreas(context.Background(), CollectionRequest{Count: 2, MaxPages: test.maxPages}, out)
+			}()
+
+			var ids []string
+			for sa := range out {
+				ids = append(ids, string(sa.ID))
+			}
+			if err := <-errc; err != nil {
+				t.Fatalf("error in StreamStopAreas: %v", err)
+			}
+			if strings.Join(ids, ",") != strings.Join(test.expected, ",") {
+				t.Errorf("unexpected stop areas: got %v, expected %v", ids, test.expected)
+			}
+		})
+	}
+}
+
+// Test_Scope_StreamStopAreas_Cancel checks that streaming stops once the context is cancelled
+func Test_Scope_StreamStopAreas_Cancel(t *testing.T) {
+	var requests int
+	session := newPagedStopAreasSession(t, &requests)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	out := make(chan types.StopArea)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- session.Scope("fr-idf").StreamStopAreas(ctx, CollectionRequest{Count: 2}, out)
+	}()
+
+	<-out
+	cancel()
+	for range out {
+	}
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
+
+// Test_Scope_StreamPOIs checks that members other than the streamed array are skipped, whatever their order
+func Test_Scope_StreamPOIs(t *testing.T) {
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"links": [],
+			"feed_publishers": [{"id": "fp", "name": "publisher"}],
+			"pois": [{"id": "poi:1", "name": "one"}, {"id": "poi:2", "name": "two"}],
+			"pagination": {"total_result": 2}
+		}`))
+	}))
+
+	out := make(chan types.POI, 2)
+	if err := session.Scope("fr-idf").StreamPOIs(context.Background(), POIsRequest{}, out); err != nil {
+		t.Fatalf("error in StreamPOIs: %v", err)
+	}
+	var names []string
+	for poi := range out {
+		names = append(names, poi.Name)
+	}
+	if len(names) != 2 || names[0] != "one" || names[1] != "two" {
+		t.Errorf("unexpected POIs: %v", names)
+	}
+}
+
+// Test_Scope_StreamStopPoints_Defaults checks that the session-wide parameters are added to streamed requests
+func Test_Scope_StreamStopPoints_Defaults(t *testing.T) {
+	var query url.Values
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{"stop_points": [{"id": "stop_point:RAT:SP:NATIO1"}], "links": []}`))
+	}), WithDepth(2), WithoutGeoJSON())
+
+	out := make(chan types.StopPoint, 1)
+	if err := session.Scope("fr-idf").StreamStopPoints(context.Background(), CollectionRequest{}, out); err != nil {
+		t.Fatalf("error in StreamStopPoints: %v", err)
+	}
+	if sp := <-out; sp.ID != "stop_point:RAT:SP:NATIO1" {
+		t.Errorf("unexpected stop point: %s", sp.ID)
+	}
+	if depth, geo := query.Get("depth"), query.Get("disable_geojson"); depth != "2" || geo != "true" {
+		t.Errorf("unexpected parameters: depth=%q, disable_geojson=%q", depth, geo)
+	}
+}
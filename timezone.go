@@ -0,0 +1,101 @@
+package navitia
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+)
+
+// scopedRegion returns the region a request URL is scoped to, such as "fr-idf" for ".../coverage/fr-idf/journeys?...".
+// Requests on the region itself, such as the ones made by RegionByID, aren't scoped to it.
+func (s *Session) scopedRegion(url string) (types.ID, bool) {
+	path := strings.TrimPrefix(url, s.APIURL+"/"+regionEndpoint+"/")
+	if len(path) == len(url) {
+		return "", false
+	}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+
+	i := strings.IndexByte(path, '/')
+	if i <= 0 || i == len(path)-1 {
+		return "", false
+	}
+	return types.ID(path[:i]), true
+}
+
+// location returns the timezone of a region, fetched from the API on first use.
+// It is nil if the API gives none for the region.
+func (s *Session) location(ctx context.Context, region types.ID) (*time.Location, error) {
+	s.timezonesMu.Lock()
+	loc, ok := s.timezones[region]
+	s.timezonesMu.Unlock()
+	if ok {
+		return loc, nil
+	}
+
+	results, err := s.RegionByID(ctx, RegionRequest{}, region)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while fetching the timezone of region %q", region)
+	}
+	if len(results.Regions) != 0 && results.Regions[0].Timezone != "" {
+		loc, err = results.Regions[0].Location()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	s.timezonesMu.Lock()
+	if s.timezones == nil {
+		s.timezones = make(map[types.ID]*time.Location)
+	}
+	s.timezones[region] = loc
+	s.timezonesMu.Unlock()
+	return loc, nil
+}
+
+// RegionLocation returns the timezone of a region, in which the datetimes of its results are given,
+// such as to build the datetime of a request in the local time of the region.
+// It is fetched from the API once per region, and cached for the lifetime of the Session.
+//
+// If the API gives no timezone for the region, an error is returned.
+// It is context aware.
+func (s *Session) RegionLocation(ctx context.Context, region types.ID) (*time.Location, error) {
+	loc, err := s.location(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		return nil, errors.Errorf("no timezone given for region %q", region)
+	}
+	return loc, nil
+}
+
+// localize sets the datetimes of results requested at url in the timezone of the region they were requested in, see types.Localize.
+// Results of requests not scoped to a region, or to one without a known timezone, are left as decoded,
+// as are all results if the Session wasn't created WithRegionTimezones.
+func (s *Session) localize(ctx context.Context, url string, res results) error {
+	loc, err := s.resultsLocation(ctx, url)
+	if err != nil {
+		return err
+	}
+	types.Localize(res, loc)
+	return nil
+}
+
+// resultsLocation returns the timezone in which to set the datetimes of the results requested at url, see localize.
+// It is nil if they are left as decoded.
+func (s *Session) resultsLocation(ctx context.Context, url string) (*time.Location, error) {
+	if !s.localTimes {
+		return nil, nil
+	}
+	region, ok := s.scopedRegion(url)
+	if !ok {
+		return nil, nil
+	}
+	return s.location(ctx, region)
+}
@@ -0,0 +1,118 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Test_WithRegionTimezones checks that the datetimes of scoped results are set in the timezone of their region,
+// which is only fetched once.
+func Test_WithRegionTimezones(t *testing.T) {
+	fixture := testData["journeys"].correct["a.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	var coverageRequests int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/coverage/fr-idf" {
+			atomic.AddInt32(&coverageRequests, 1)
+			_, _ = w.Write([]byte(`{"regions": [{"id": "fr-idf", "timezone": "Europe/Paris"}]}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	})
+	req := JourneyRequest{From: "stop_area:RAT:SA:NATIO", To: "stop_area:RAT:SA:GDLYO"}
+
+	base, err := newMockSession(t, handler).Scope("fr-idf").Journeys(context.Background(), req)
+	if err != nil {
+		t.Fatalf("error in Journeys: %v", err)
+	}
+	if len(base.Journeys) == 0 {
+		t.Fatal("no journeys in the fixture")
+	}
+	if coverageRequests != 0 {
+		t.Fatalf("expected no timezone lookup without WithRegionTimezones, got %d", coverageRequests)
+	}
+
+	session := newMockSession(t, handler, WithRegionTimezones())
+	for i := 0; i < 2; i++ {
+		res, err := session.Scope("fr-idf").Journeys(context.Background(), req)
+		if err != nil {
+			t.Fatalf("error in Journeys: %v", err)
+		}
+
+		got, decoded := res.Journeys[0].Departure, base.Journeys[0].Departure
+		if got.Location().String() != "Europe/Paris" {
+			t.Errorf("expected the departure in Europe/Paris, got %s", got.Location())
+		}
+		if got.Format(time.ANSIC) != decoded.Format(time.ANSIC) {
+			t.Errorf("the wall clock changed: got %s, expected %s", got, decoded)
+		}
+	}
+	if coverageRequests != 1 {
+		t.Errorf("expected the timezone to be fetched once, got %d requests", coverageRequests)
+	}
+
+	loc, err := session.RegionLocation(context.Background(), "fr-idf")
+	if err != nil || loc.String() != "Europe/Paris" {
+		t.Errorf("unexpected location of fr-idf: %v (%v)", loc, err)
+	}
+}
+
+// Test_WithRegionTimezones_Request checks that the datetimes of requests scoped to a region are sent in its local time,
+// while dates are left as is.
+func Test_WithRegionTimezones_Request(t *testing.T) {
+	var query url.Values
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/coverage/jp-kanto" {
+			_, _ = w.Write([]byte(`{"regions": [{"id": "jp-kanto", "timezone": "Asia/Tokyo"}]}`))
+			return
+		}
+		query = r.URL.Query()
+		_, _ = w.Write([]byte(`{}`))
+	})
+	req := JourneyRequest{
+		From: "stop_area:JR:SA:TOKYO",
+		To:   "stop_area:JR:SA:SHINJUKU",
+		Date: time.Date(2020, 7, 1, 23, 30, 0, 0, time.UTC),
+	}
+	calendars := CalendarsRequest{StartDate: time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name             string
+		opts             []Option
+		datetime, starts string
+	}{
+		{"utc", nil, "20200701T233000", "20200701"},
+		{"local", []Option{WithRegionTimezones()}, "20200702T083000", "20200701"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			scope := newMockSession(t, handler, tt.opts...).Scope("jp-kanto")
+
+			if _, err := scope.Journeys(context.Background(), req); err != nil {
+				t.Fatalf("error in Journeys: %v", err)
+			}
+			if got := query.Get("datetime"); got != tt.datetime {
+				t.Errorf("unexpected datetime: got %q, expected %q", got, tt.datetime)
+			}
+
+			if _, err := scope.Calendars(context.Background(), calendars); err != nil {
+				t.Fatalf("error in Calendars: %v", err)
+			}
+			if got := query.Get("start_date"); got != tt.starts {
+				t.Errorf("unexpected start_date: got %q, expected %q", got, tt.starts)
+			}
+		})
+	}
+
+	if !req.Date.Equal(time.Date(2020, 7, 1, 23, 30, 0, 0, time.UTC)) || req.Date.Location() != time.UTC {
+		t.Errorf("the request was modified: %s", req.Date)
+	}
+}
@@ -0,0 +1,84 @@
+package navitia
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/govitia/navitia/types"
+	"github.com/govitia/navitia/utils"
+)
+
+const trafficReportsEndpoint string = "traffic_reports"
+
+// TrafficReportsResults holds the results of a traffic reports request: one report per disrupted network.
+//
+// The disruptions of each report are resolved once the results are received, see types.TrafficReport.
+type TrafficReportsResults struct {
+	TrafficReports []types.TrafficReport `json:"traffic_reports"`
+	Disruptions    []types.Disruption    `json:"disruptions"`
+	Paging         Paging                `json:"links"`
+	Pagination     Pagination            `json:"pagination"`
+	Logging        `json:"-"`
+	session        *Session
+}
+
+// Count returns the number of traffic reports available in a TrafficReportsResults
+func (trr *TrafficReportsResults) Count() int {
+	return len(trr.TrafficReports)
+}
+
+// TotalAvailable returns the total number of traffic reports available across all pages.
+func (trr *TrafficReportsResults) TotalAvailable() int {
+	return trr.Pagination.total(len(trr.TrafficReports))
+}
+
+// TrafficReportsRequest contains the optional parameters for a TrafficReports request.
+type TrafficReportsRequest struct {
+	// Only return the disruptions active during this period
+	Since time.Time `param:"since"`
+	Until time.Time `param:"until"`
+
+	// Language of the disruption messages, where the data provides translations (e.g "fr-FR")
+	Language string `param:"language"`
+
+	// Maximum amount of reports
+	Count uint `param:"count"`
+
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// ForbiddenURIs
+	Forbidden []types.ID `param:"forbidden_uris[]"`
+}
+
+func (req TrafficReportsRequest) toURL() (url.Values, error) {
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
+	return rb.Values(), nil
+}
+
+// trafficReports is the internal function used by TrafficReports functions
+func (s *Session) trafficReports(ctx context.Context, url string, req TrafficReportsRequest) (*TrafficReportsResults, error) {
+	results := &TrafficReportsResults{session: s}
+	err := s.request(ctx, url, req, results)
+	if err != nil {
+		return results, err
+	}
+
+	for i := range results.TrafficReports {
+		results.TrafficReports[i].ResolveDisruptions(results.Disruptions)
+	}
+	return results, nil
+}
+
+// TrafficReports requests the current state of the networks of the region: for each disrupted network,
+// the impacted lines and stop areas along with the disruptions affecting them.
+func (scope *Scope) TrafficReports(ctx context.Context, req TrafficReportsRequest) (*TrafficReportsResults, error) {
+	// Create the URL
+	reqURL := scope.session.APIURL + "/coverage/" + string(scope.region) + "/" + trafficReportsEndpoint
+
+	return scope.session.trafficReports(ctx, reqURL, req)
+}
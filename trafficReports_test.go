@@ -0,0 +1,72 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// Test_TrafficReportsResults_Unmarshal tests unmarshalling for TrafficReportsResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_TrafficReportsResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["traffic_reports"], reflect.TypeOf(TrafficReportsResults{}))
+}
+
+// Test_Scope_TrafficReports checks the request parameters, and that the disruptions of the impacted lines and stop areas are resolved.
+func Test_Scope_TrafficReports(t *testing.T) {
+	fixture := testData["traffic_reports"].correct["ratp.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if expected := "/coverage/fr-idf/traffic_reports"; r.URL.Path != expected {
+			t.Errorf("unexpected path: got %s, expected %s", r.URL.Path, expected)
+		}
+		if lang := r.URL.Query().Get("language"); lang != "fr-FR" {
+			t.Errorf("unexpected language: got %q, expected %q", lang, "fr-FR")
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	res, err := session.Scope("fr-idf").TrafficReports(context.Background(), TrafficReportsRequest{Language: "fr-FR"})
+	if err != nil {
+		t.Fatalf("error in TrafficReports: %v", err)
+	}
+
+	if res.Count() != 1 {
+		t.Fatalf("expected 1 traffic report, got %d", res.Count())
+	}
+	report := res.TrafficReports[0]
+	if report.Network.Name != "RATP" {
+		t.Errorf("unexpected network: %q", report.Network.Name)
+	}
+	if n := len(report.Network.Disruptions()); n != 0 {
+		t.Errorf("expected the network itself not to be disrupted, got %d disruptions", n)
+	}
+
+	if len(report.Lines) != 3 {
+		t.Fatalf("expected 3 disrupted lines, got %d", len(report.Lines))
+	}
+	for _, l := range report.Lines {
+		if len(l.Disruptions()) != 1 {
+			t.Errorf("expected line %s to have 1 disruption, got %d", l.ID, len(l.Disruptions()))
+		}
+	}
+	if effect := report.Lines[1].Disruptions()[0].Severity.Effect; effect != "SIGNIFICANT_DELAYS" {
+		t.Errorf("unexpected effect for line %s: %q", report.Lines[1].ID, effect)
+	}
+
+	if len(report.StopAreas) != 2 {
+		t.Fatalf("expected 2 disrupted stop areas, got %d", len(report.StopAreas))
+	}
+	for _, sa := range report.StopAreas {
+		if len(sa.Disruptions()) != 1 || sa.Disruptions()[0].ID != report.Lines[0].Disruptions()[0].ID {
+			t.Errorf("expected stop area %s to share line %s's disruption", sa.ID, report.Lines[0].ID)
+		}
+	}
+}
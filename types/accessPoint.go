@@ -0,0 +1,212 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// A PathwayMode is the kind of way a pathway is, as in GTFS' pathways.txt
+type PathwayMode int
+
+// PathwayModeXXX are the known pathway modes
+const (
+	PathwayModeWalkway        PathwayMode = 1
+	PathwayModeStairs         PathwayMode = 2
+	PathwayModeMovingSidewalk PathwayMode = 3
+	PathwayModeEscalator      PathwayMode = 4
+	PathwayModeElevator       PathwayMode = 5
+	PathwayModeFareGate       PathwayMode = 6
+	PathwayModeExitGate       PathwayMode = 7
+)
+
+// A Pathway describes the way between an access point and a stop point.
+type Pathway struct {
+	// Whether the access point can be used to enter and/or to leave the station
+	IsEntrance bool `json:"is_entrance"`
+	IsExit     bool `json:"is_exit"`
+
+	// Mode of the pathway, zero if unknown
+	Mode PathwayMode `json:"pathway_mode"`
+
+	// Length of the pathway in meters, zero if unknown
+	Length uint `json:"length"`
+
+	// Average time needed to walk the pathway, zero if unknown
+	TraversalTime time.Duration `json:"traversal_time"`
+
+	// Accessibility details, zero if unknown
+	StairCount int     `json:"stair_count"`
+	MaxSlope   float64 `json:"max_slope"` // Ratio of the slope, negative when going down
+	MinWidth   float64 `json:"min_width"` // In meters
+
+	// Signage to follow, when going to the stop point and when going back to the access point
+	SignpostedAs         string `json:"signposted_as"`
+	ReversedSignpostedAs string `json:"reversed_signposted_as"`
+}
+
+// jsonPathway define the JSON implementation of Pathway struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonPathway struct {
+	// Pointers to the corresponding real values
+	IsEntrance           *bool        `json:"is_entrance"`
+	IsExit               *bool        `json:"is_exit"`
+	Mode                 *PathwayMode `json:"pathway_mode"`
+	Length               *uint        `json:"length"`
+	StairCount           *int         `json:"stair_count"`
+	MaxSlope             *float64     `json:"max_slope"`
+	MinWidth             *float64     `json:"min_width"`
+	SignpostedAs         *string      `json:"signposted_as"`
+	ReversedSignpostedAs *string      `json:"reversed_signposted_as"`
+
+	// Value to process
+	TraversalTime int64 `json:"traversal_time"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a Pathway
+func (p *Pathway) UnmarshalJSON(b []byte) error {
+	data := &jsonPathway{
+		IsEntrance:           &p.IsEntrance,
+		IsExit:               &p.IsExit,
+		Mode:                 &p.Mode,
+		Length:               &p.Length,
+		StairCount:           &p.StairCount,
+		MaxSlope:             &p.MaxSlope,
+		MinWidth:             &p.MinWidth,
+		SignpostedAs:         &p.SignpostedAs,
+		ReversedSignpostedAs: &p.ReversedSignpostedAs,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling Pathway: %w", err)
+	}
+
+	// As the given duration is in second, let's multiply it by one second to have the correct value
+	p.TraversalTime = time.Duration(data.TraversalTime) * time.Second
+
+	return nil
+}
+
+// json returns the JSON representation of a Pathway
+func (p *Pathway) json() jsonPathway {
+	return jsonPathway{
+		IsEntrance:           &p.IsEntrance,
+		IsExit:               &p.IsExit,
+		Mode:                 &p.Mode,
+		Length:               &p.Length,
+		StairCount:           &p.StairCount,
+		MaxSlope:             &p.MaxSlope,
+		MinWidth:             &p.MinWidth,
+		SignpostedAs:         &p.SignpostedAs,
+		ReversedSignpostedAs: &p.ReversedSignpostedAs,
+		TraversalTime:        int64(p.TraversalTime / time.Second),
+	}
+}
+
+// MarshalJSON implements json.Marshaler for a Pathway
+func (p Pathway) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.json())
+}
+
+// An AccessPoint is an entrance and/or exit of a station, as listed in a StopPoint.
+// Its Pathway describes the way from the access point to the stop point.
+type AccessPoint struct {
+	ID    ID          `json:"id"`
+	Name  string      `json:"name"`
+	Coord Coordinates `json:"coord"`
+
+	Pathway
+}
+
+// jsonAccessPoint define the JSON implementation of AccessPoint struct
+type jsonAccessPoint struct {
+	ID    *ID          `json:"id"`
+	Name  *string      `json:"name"`
+	Coord *Coordinates `json:"coord"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for an AccessPoint.
+// The pathway is given at the same level as the access point itself.
+func (ap *AccessPoint) UnmarshalJSON(b []byte) error {
+	data := &jsonAccessPoint{
+		ID:    &ap.ID,
+		Name:  &ap.Name,
+		Coord: &ap.Coord,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling AccessPoint: %w", err)
+	}
+
+	return json.Unmarshal(b, &ap.Pathway)
+}
+
+// MarshalJSON implements json.Marshaler for an AccessPoint, its pathway being written alongside its other fields
+func (ap AccessPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		jsonAccessPoint
+		jsonPathway
+	}{
+		jsonAccessPoint: jsonAccessPoint{
+			ID:    &ap.ID,
+			Name:  &ap.Name,
+			Coord: &ap.Coord,
+		},
+		jsonPathway: ap.Pathway.json(),
+	})
+}
+
+// A Via is an access point through which a street network or transfer section enters or leaves a station.
+// The path segments going through it reference it, see Section.Via.
+type Via struct {
+	ID          ID          `json:"id"`
+	Name        string      `json:"name"`
+	AccessPoint AccessPoint `json:"access_point"`
+
+	Pathway
+}
+
+// jsonVia define the JSON implementation of Via struct
+type jsonVia struct {
+	ID          *ID          `json:"id"`
+	Name        *string      `json:"name"`
+	AccessPoint *AccessPoint `json:"access_point"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a Via.
+// As for an AccessPoint, the pathway is given at the same level as the via itself.
+func (v *Via) UnmarshalJSON(b []byte) error {
+	data := &jsonVia{
+		ID:          &v.ID,
+		Name:        &v.Name,
+		AccessPoint: &v.AccessPoint,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling Via: %w", err)
+	}
+
+	return json.Unmarshal(b, &v.Pathway)
+}
+
+// MarshalJSON implements json.Marshaler for a Via, its pathway being written alongside its other fields
+func (v Via) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		jsonVia
+		jsonPathway
+	}{
+		jsonVia: jsonVia{
+			ID:          &v.ID,
+			Name:        &v.Name,
+			AccessPoint: &v.AccessPoint,
+		},
+		jsonPathway: v.Pathway.json(),
+	})
+}
@@ -0,0 +1,62 @@
+package types
+
+// hasEquipment reports whether eq is in the list of equipments
+func hasEquipment(equipments []Equipment, eq Equipment) bool {
+	for _, e := range equipments {
+		if e == eq {
+			return true
+		}
+	}
+	return false
+}
+
+// stopPointHas reports whether the container holds a stop point with the given equipment.
+// If the container doesn't hold a stop point, there is nothing to check and it returns true.
+func stopPointHas(c Container, eq Equipment) bool {
+	if c.EmbeddedType != EmbeddedStopPoint {
+		return true
+	}
+	obj, err := c.Object()
+	if err != nil {
+		return false
+	}
+	sp, ok := obj.(*StopPoint)
+	return ok && hasEquipment(sp.Equipments, eq)
+}
+
+// accessible reports whether a section is known to be accessible to wheelchair users.
+//
+// For public transport sections, the vehicle must be wheelchair accessible and the stop points where the traveller boards and alights
+// must allow wheelchair boarding.
+// For transfer & street network sections, the stop points they lead from or to must allow wheelchair boarding,
+// EquipmentWheelchairAccessibility being an equipment of vehicles.
+// Other sections (waiting, stay in...) are always accessible.
+func (s Section) accessible() bool {
+	switch s.Type {
+	case SectionPublicTransport, SectionOnDemandTransport:
+		if !hasEquipment(s.Display.Equipments, EquipmentWheelchairAccessibility) || len(s.StopTimes) == 0 {
+			return false
+		}
+		board, alight := s.StopTimes[0].StopPoint, s.StopTimes[len(s.StopTimes)-1].StopPoint
+		return hasEquipment(board.Equipments, EquipmentWheelchairBoarding) && hasEquipment(alight.Equipments, EquipmentWheelchairBoarding)
+	case SectionTransfer, SectionStreetNetwork, SectionCrowFly:
+		return stopPointHas(s.From, EquipmentWheelchairBoarding) && stopPointHas(s.To, EquipmentWheelchairBoarding)
+	default:
+		return true
+	}
+}
+
+// IsFullyAccessible reports whether every section of the journey is accessible to wheelchair users, and if not, returns the offending sections.
+//
+// This audits the equipments the API reports for each vehicle & stop point, rather than relying on the request-level wheelchair flag,
+// allowing apps to explain why a journey isn't accessible. As missing equipment information can't be told apart from missing equipment,
+// a section lacking it is reported as inaccessible.
+func (j Journey) IsFullyAccessible() (bool, []Section) {
+	var offending []Section
+	for _, s := range j.Sections {
+		if !s.accessible() {
+			offending = append(offending, s)
+		}
+	}
+	return len(offending) == 0, offending
+}
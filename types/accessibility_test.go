@@ -0,0 +1,39 @@
+package types
+
+import "testing"
+
+// TestJourney_IsFullyAccessible checks that the sections leading to or from a stop point without wheelchair boarding are flagged, and only them
+func TestJourney_IsFullyAccessible(t *testing.T) {
+	data := testData["journey"].correct["accessibility.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	ok, offending := j.IsFullyAccessible()
+	if ok {
+		t.Fatalf("expected the journey not to be fully accessible")
+	}
+	want := []ID{"section_4_0", "section_5_0"}
+	if len(offending) != len(want) {
+		t.Fatalf("expected %d offending sections, got %d", len(want), len(offending))
+	}
+	for i, s := range offending {
+		if s.ID != want[i] {
+			t.Errorf("unexpected offending section #%d: %s (%s), want %s", i, s.ID, s.Type, want[i])
+		}
+	}
+
+	// Journeys without any equipment information aren't known to be accessible
+	other := &Journey{}
+	if err := other.UnmarshalJSON(testData["journey"].correct["a0.json"]); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if ok, _ := other.IsFullyAccessible(); ok {
+		t.Errorf("expected a journey without equipment information not to be fully accessible")
+	}
+}
@@ -1,6 +1,67 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// An ActivePeriod is a period during which a Calendar is active.
+// Begin is the first day of the period, End is the day after its last one.
 type ActivePeriod struct {
+	Begin time.Time `json:"begin"`
+	End   time.Time `json:"end"`
+}
+
+// jsonActivePeriod define the JSON implementation of ActivePeriod struct
+type jsonActivePeriod struct {
+	// Values to process
 	Begin string `json:"begin"`
 	End   string `json:"end"`
 }
+
+// UnmarshalJSON implements json.Unmarshaller for an ActivePeriod
+func (ap *ActivePeriod) UnmarshalJSON(b []byte) error {
+	data := &jsonActivePeriod{}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling ActivePeriod: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"ActivePeriod", b}
+
+	ap.Begin, err = parseDateTime(data.Begin)
+	if err != nil {
+		return gen.err(err, "Begin", "begin", data.Begin, "parseDateTime failed")
+	}
+	ap.End, err = parseDateTime(data.End)
+	if err != nil {
+		return gen.err(err, "End", "end", data.End, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for an ActivePeriod
+func (ap ActivePeriod) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonActivePeriod{
+		Begin: formatDate(ap.Begin),
+		End:   formatDate(ap.End),
+	})
+}
+
+// Contains reports whether the given day is within the period.
+// Only the date is taken into account, in the location of the period.
+func (ap ActivePeriod) Contains(day time.Time) bool {
+	day = date(day, ap.Begin.Location())
+	return !day.Before(ap.Begin) && day.Before(ap.End)
+}
+
+// date returns the midnight starting the given day in loc, discarding its time
+func date(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
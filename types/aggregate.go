@@ -0,0 +1,93 @@
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// A ModeDuration is the time spent using a mode during a journey, see Journey.DurationByModeSorted
+type ModeDuration struct {
+	Mode     string
+	Duration time.Duration
+}
+
+// mode returns the key under which the section's duration is aggregated:
+// the physical mode for public transport sections, the mode for street network sections (eg "walking"), and the section type otherwise.
+func (s Section) mode() string {
+	switch {
+	case s.Type == SectionPublicTransport || s.Type == SectionOnDemandTransport:
+		return string(s.Display.PhysicalMode)
+	case s.Mode != "":
+		return s.Mode
+	default:
+		return string(s.Type)
+	}
+}
+
+// DurationByMode returns the time spent in each mode of the journey.
+//
+// Public transport sections are aggregated under their physical mode (eg "Métro"), street network sections under their mode (eg "walking"),
+// and the other sections under their type (eg "waiting").
+// See DurationByModeSorted for a deterministic ordering.
+func (j Journey) DurationByMode() map[string]time.Duration {
+	durations := make(map[string]time.Duration)
+	for _, s := range j.Sections {
+		durations[s.mode()] += s.Duration
+	}
+	return durations
+}
+
+// DurationByModeSorted is the same as DurationByMode, but returns a slice sorted by decreasing duration, then by mode.
+// Its order is deterministic, making it suitable for display & tests.
+func (j Journey) DurationByModeSorted() []ModeDuration {
+	durations := j.DurationByMode()
+
+	sorted := make([]ModeDuration, 0, len(durations))
+	for mode, d := range durations {
+		sorted = append(sorted, ModeDuration{Mode: mode, Duration: d})
+	}
+	sort.Slice(sorted, func(i, k int) bool {
+		if sorted[i].Duration != sorted[k].Duration {
+			return sorted[i].Duration > sorted[k].Duration
+		}
+		return sorted[i].Mode < sorted[k].Mode
+	})
+	return sorted
+}
+
+// A SectionCost details the time spent in a section of a journey, see Journey.CostBreakdown
+type SectionCost struct {
+	ID   ID
+	Type SectionType
+
+	// Duration is the actual duration of the section
+	Duration time.Duration
+
+	// BaseDuration is the duration of the section according to the base schedule.
+	// It is zero if the section has no base schedule (street network, transfer, waiting sections...).
+	BaseDuration time.Duration
+
+	// Delay is the difference between the actual and base arrival, zero if there is no base schedule
+	Delay time.Duration
+}
+
+// CostBreakdown lists the duration of each section of the journey, in order.
+//
+// This helps understanding why a journey was chosen over another, as their durations add up to the journey's duration.
+// Navitia doesn't expose the internal penalties it applies (eg for transfers), so they aren't part of the breakdown.
+func (j Journey) CostBreakdown() []SectionCost {
+	costs := make([]SectionCost, 0, len(j.Sections))
+	for _, s := range j.Sections {
+		cost := SectionCost{
+			ID:       s.ID,
+			Type:     s.Type,
+			Duration: s.Duration,
+		}
+		if !s.BaseDeparture.IsZero() && !s.BaseArrival.IsZero() {
+			cost.BaseDuration = s.BaseArrival.Sub(s.BaseDeparture)
+			cost.Delay = s.Delay()
+		}
+		costs = append(costs, cost)
+	}
+	return costs
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestJourney_DurationByModeSorted checks that the sorted output doesn't depend on the order of the sections
+func TestJourney_DurationByModeSorted(t *testing.T) {
+	sections := []Section{
+		{Type: SectionStreetNetwork, Mode: ModeWalking, Duration: 5 * time.Minute},
+		{Type: SectionPublicTransport, Display: Display{PhysicalMode: "Métro"}, Duration: 12 * time.Minute},
+		{Type: SectionWaiting, Duration: 3 * time.Minute},
+		{Type: SectionPublicTransport, Display: Display{PhysicalMode: "Bus"}, Duration: 3 * time.Minute},
+		{Type: SectionStreetNetwork, Mode: ModeWalking, Duration: 2 * time.Minute},
+	}
+
+	expected := []ModeDuration{
+		{"Métro", 12 * time.Minute},
+		{ModeWalking, 7 * time.Minute},
+		{"Bus", 3 * time.Minute},
+		{string(SectionWaiting), 3 * time.Minute},
+	}
+
+	// Try every rotation of the sections, and repeat to shake up map iteration order
+	for i := range sections {
+		rotated := append(append([]Section{}, sections[i:]...), sections[:i]...)
+		for n := 0; n < 10; n++ {
+			got := Journey{Sections: rotated}.DurationByModeSorted()
+			if !reflect.DeepEqual(got, expected) {
+				t.Fatalf("rotation %d: got %v, expected %v", i, got, expected)
+			}
+		}
+	}
+}
+
+// TestJourney_CostBreakdown checks that the breakdown adds up to the journey's duration, and that delays are reported
+func TestJourney_CostBreakdown(t *testing.T) {
+	data := testData["journey"].correct["delayed.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	costs := j.CostBreakdown()
+	if len(costs) != len(j.Sections) {
+		t.Fatalf("expected %d section costs, got %d", len(j.Sections), len(costs))
+	}
+
+	var total time.Duration
+	for _, c := range costs {
+		total += c.Duration
+	}
+	if diff := total - j.Duration; diff < -time.Minute || diff > time.Minute {
+		t.Errorf("breakdown sums to %s, journey duration is %s", total, j.Duration)
+	}
+
+	// The second metro is delayed by a minute
+	if c := costs[4]; c.BaseDuration != 8*time.Minute || c.Delay != time.Minute {
+		t.Errorf("unexpected cost for the delayed section: %#v", c)
+	}
+	// Walking sections have no base schedule
+	if c := costs[0]; c.BaseDuration != 0 || c.Delay != 0 {
+		t.Errorf("unexpected cost for a street network section: %#v", c)
+	}
+}
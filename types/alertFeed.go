@@ -0,0 +1,335 @@
+package types
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// EffectXXX are the effects given by GTFS-RT feeds which have no JourneyStatusXXX counterpart
+const (
+	// The disruption has no effect on the service, such as an informational message.
+	EffectNoEffect Effect = "NO_EFFECT"
+
+	// The disruption affects the accessibility of the service, such as a broken elevator.
+	EffectAccessibilityIssue Effect = "ACCESSIBILITY_ISSUE"
+)
+
+// alertEffects are the effects of a GTFS-RT alert, by their value in the feed
+var alertEffects = map[uint64]Effect{
+	1:  EffectNoService,
+	2:  JourneyStatusReducedService,
+	3:  JourneyStatusSignificantDelay,
+	4:  JourneyStatusDetour,
+	5:  JourneyStatusAdditionalService,
+	6:  JourneyStatusModifiedService,
+	7:  JourneyStatusOtherEffect,
+	8:  JourneyStatusUnknownEffect,
+	9:  JourneyStatusStopMoved,
+	10: EffectNoEffect,
+	11: EffectAccessibilityIssue,
+}
+
+// alertCauses are the causes of a GTFS-RT alert, by their value in the feed
+var alertCauses = map[uint64]string{
+	1:  "UNKNOWN_CAUSE",
+	2:  "OTHER_CAUSE",
+	3:  "TECHNICAL_PROBLEM",
+	4:  "STRIKE",
+	5:  "DEMONSTRATION",
+	6:  "ACCIDENT",
+	7:  "HOLIDAY",
+	8:  "WEATHER",
+	9:  "MAINTENANCE",
+	10: "CONSTRUCTION",
+	11: "POLICE_ACTIVITY",
+	12: "MEDICAL_EMERGENCY",
+}
+
+// alertSeverities are the severity levels of a GTFS-RT alert, by their value in the feed
+var alertSeverities = map[uint64]string{
+	1: "UNKNOWN_SEVERITY",
+	2: "INFO",
+	3: "WARNING",
+	4: "SEVERE",
+}
+
+// Names of the channels of the messages decoded from a GTFS-RT alert, see ParseAlertFeed
+const (
+	AlertChannelHeader      = "header"
+	AlertChannelDescription = "description"
+)
+
+// ParseAlertFeed decodes the alerts of a GTFS-RT feed, in its protobuf encoding, into Disruptions.
+// This is the format of the realtime disruption feeds published by Navitia and its Chaos backend,
+// so that disruptions read from such a feed are handled as the ones returned by the API.
+//
+// Each alert gives a Disruption:
+//   - its ID is the one of the feed entity, and it is updated at the time of the feed
+//   - its Periods are the active periods of the alert, and its Status is computed at the time of the feed
+//   - its Severity holds the effect and the severity level of the alert, and its Cause the cause of the alert, such as "STRIKE"
+//   - each informed entity gives an ImpactedObject, of the most specific object given: trip, stop point, line (GTFS route) or network (GTFS agency)
+//   - its header & description give a DisruptionMessage each, in the given language if translated to it,
+//     on a plain-text Channel named AlertChannelHeader or AlertChannelDescription
+//
+// The IDs of the impacted objects are given as they are in the feed, which are Navitia IDs for Navitia's feeds,
+// and only their ID is known: their other fields are left empty.
+// Deleted entities, trip updates, vehicle positions and the extensions of the Chaos feeds are skipped.
+func ParseAlertFeed(b []byte, language string) ([]Disruption, error) {
+	var (
+		updated     time.Time
+		disruptions []Disruption
+	)
+	err := readMessage(b, func(field uint64, v wireValue) error {
+		switch field {
+		case 1: // header
+			return readMessage(v.bytes, func(field uint64, v wireValue) error {
+				if field == 3 { // timestamp
+					updated = time.Unix(int64(v.varint), 0).UTC()
+				}
+				return nil
+			})
+		case 2: // entity
+			d, ok, err := parseAlertEntity(v.bytes, language)
+			if err != nil {
+				return err
+			}
+			if ok {
+				disruptions = append(disruptions, d)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ParseAlertFeed: invalid feed")
+	}
+
+	for i := range disruptions {
+		disruptions[i].LastUpdated = updated
+		disruptions[i].Status = periodsStatus(disruptions[i].Periods, updated)
+	}
+	return disruptions, nil
+}
+
+// parseAlertEntity decodes a feed entity, ok is false if it isn't an alert or is deleted
+func parseAlertEntity(b []byte, language string) (d Disruption, ok bool, err error) {
+	var deleted bool
+	err = readMessage(b, func(field uint64, v wireValue) error {
+		switch field {
+		case 1: // id
+			d.ID = ID(v.bytes)
+		case 2: // is_deleted
+			deleted = v.varint != 0
+		case 5: // alert
+			ok = true
+			return parseAlert(v.bytes, language, &d)
+		}
+		return nil
+	})
+	if err != nil {
+		return d, false, errors.Wrapf(err, "invalid entity %q", d.ID)
+	}
+	return d, ok && !deleted, nil
+}
+
+// parseAlert decodes an alert into d
+func parseAlert(b []byte, language string, d *Disruption) error {
+	return readMessage(b, func(field uint64, v wireValue) error {
+		switch field {
+		case 1: // active_period
+			return parseTimeRange(v.bytes, d)
+		case 5: // informed_entity
+			return parseEntitySelector(v.bytes, d)
+		case 6: // cause
+			d.Cause = alertCauses[v.varint]
+		case 7: // effect
+			d.Severity.Effect = alertEffects[v.varint]
+		case 10, 11: // header_text, description_text
+			text, err := parseTranslatedString(v.bytes, language)
+			if err != nil || text == "" {
+				return err
+			}
+			channel := AlertChannelHeader
+			if field == 11 {
+				channel = AlertChannelDescription
+			}
+			d.Messages = append(d.Messages, DisruptionMessage{
+				Text:    text,
+				Channel: &Channel{Name: channel, ContentType: ChannelContentText},
+			})
+		case 14: // severity_level
+			d.Severity.Name = alertSeverities[v.varint]
+		}
+		return nil
+	})
+}
+
+// parseTimeRange decodes an active period of an alert, a missing bound being left as the zero time
+func parseTimeRange(b []byte, d *Disruption) error {
+	var p Period
+	err := readMessage(b, func(field uint64, v wireValue) error {
+		switch field {
+		case 1: // start
+			p.Begin = time.Unix(int64(v.varint), 0).UTC()
+		case 2: // end
+			p.End = time.Unix(int64(v.varint), 0).UTC()
+		}
+		return nil
+	})
+	d.Periods = append(d.Periods, p)
+	return err
+}
+
+// parseEntitySelector decodes an informed entity of an alert into an ImpactedObject
+func parseEntitySelector(b []byte, d *Disruption) error {
+	var agency, route, trip, stop string
+	err := readMessage(b, func(field uint64, v wireValue) error {
+		switch field {
+		case 1: // agency_id
+			agency = string(v.bytes)
+		case 2: // route_id
+			route = string(v.bytes)
+		case 4: // trip
+			return readMessage(v.bytes, func(field uint64, v wireValue) error {
+				if field == 1 { // trip_id
+					trip = string(v.bytes)
+				}
+				return nil
+			})
+		case 5: // stop_id
+			stop = string(v.bytes)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var obj Object
+	c := Container{mu: &sync.RWMutex{}}
+	switch {
+	case trip != "":
+		c.ID, c.EmbeddedType, obj = ID(trip), EmbeddedTrip, &Trip{ID: ID(trip)}
+	case stop != "":
+		c.ID, c.EmbeddedType, obj = ID(stop), EmbeddedStopPoint, &StopPoint{ID: ID(stop)}
+	case route != "":
+		c.ID, c.EmbeddedType, obj = ID(route), EmbeddedLine, &Line{ID: ID(route)}
+	case agency != "":
+		c.ID, c.EmbeddedType, obj = ID(agency), EmbeddedNetwork, &Network{ID: agency}
+	default:
+		return nil
+	}
+	c.embeddedObject = obj
+	d.Impacted = append(d.Impacted, ImpactedObject{Object: c})
+	return nil
+}
+
+// parseTranslatedString returns the translation of a text in the given language,
+// falling back to the untranslated one, then to the first one
+func parseTranslatedString(b []byte, language string) (string, error) {
+	var first, untranslated, translated string
+	err := readMessage(b, func(field uint64, v wireValue) error {
+		if field != 1 { // translation
+			return nil
+		}
+		var text, lang string
+		err := readMessage(v.bytes, func(field uint64, v wireValue) error {
+			switch field {
+			case 1:
+				text = string(v.bytes)
+			case 2:
+				lang = string(v.bytes)
+			}
+			return nil
+		})
+		if first == "" {
+			first = text
+		}
+		if lang == "" && untranslated == "" {
+			untranslated = text
+		}
+		if language != "" && lang == language && translated == "" {
+			translated = text
+		}
+		return err
+	})
+	for _, text := range [...]string{translated, untranslated, first} {
+		if text != "" {
+			return text, err
+		}
+	}
+	return "", err
+}
+
+// periodsStatus returns the status of a disruption active during the given periods at the given time,
+// see the DisruptionStatusXXX constants. It is empty if the time or the periods are unknown.
+func periodsStatus(periods []Period, at time.Time) string {
+	if at.IsZero() || len(periods) == 0 {
+		return ""
+	}
+	status := DisruptionStatusPast
+	for _, p := range periods {
+		switch {
+		case !p.Begin.IsZero() && at.Before(p.Begin):
+			status = DisruptionStatusFuture
+		case p.End.IsZero() || at.Before(p.End):
+			return DisruptionStatusActive
+		}
+	}
+	return status
+}
+
+// A wireValue is a value of a protobuf message, either a varint (including fixed-size values) or bytes (length-delimited values)
+type wireValue struct {
+	varint uint64
+	bytes  []byte
+}
+
+// readMessage reads the fields of a protobuf message, calling fn for each of them.
+// Groups, deprecated since proto2, aren't supported.
+func readMessage(b []byte, fn func(field uint64, v wireValue) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errors.New("invalid field key")
+		}
+		b = b[n:]
+
+		field, wireType := key>>3, key&7
+		var v wireValue
+		switch wireType {
+		case 0: // varint
+			v.varint, n = binary.Uvarint(b)
+			if n <= 0 {
+				return errors.Errorf("invalid varint in field %d", field)
+			}
+		case 1: // 64-bit
+			if n = 8; len(b) < n {
+				return errors.Errorf("truncated 64-bit value in field %d", field)
+			}
+			v.varint = binary.LittleEndian.Uint64(b)
+		case 2: // length-delimited
+			length, m := binary.Uvarint(b)
+			if m <= 0 || length > math.MaxInt32 || uint64(len(b)-m) < length {
+				return errors.Errorf("invalid length of field %d", field)
+			}
+			v.bytes, n = b[m:m+int(length)], m+int(length)
+		case 5: // 32-bit
+			if n = 4; len(b) < n {
+				return errors.Errorf("truncated 32-bit value in field %d", field)
+			}
+			v.varint = uint64(binary.LittleEndian.Uint32(b))
+		default:
+			return errors.Errorf("unsupported wire type %d in field %d", wireType, field)
+		}
+		b = b[n:]
+
+		if err := fn(field, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
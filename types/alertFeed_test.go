@@ -0,0 +1,157 @@
+package types
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+// appendUvarint appends a varint to b
+func appendUvarint(b []byte, v uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	return append(b, buf[:binary.PutUvarint(buf, v)]...)
+}
+
+// protoVarint encodes a varint field of a protobuf message
+func protoVarint(field, v uint64) []byte {
+	return appendUvarint(appendUvarint(nil, field<<3), v)
+}
+
+// protoBytes encodes a length-delimited field of a protobuf message, such as a string or an embedded message
+func protoBytes(field uint64, parts ...[]byte) []byte {
+	var msg []byte
+	for _, p := range parts {
+		msg = append(msg, p...)
+	}
+	b := appendUvarint(nil, field<<3|2)
+	b = appendUvarint(b, uint64(len(msg)))
+	return append(b, msg...)
+}
+
+// protoString encodes a string field of a protobuf message
+func protoString(field uint64, s string) []byte {
+	return protoBytes(field, []byte(s))
+}
+
+// protoTranslation encodes a TranslatedString.Translation
+func protoTranslation(text, lang string) []byte {
+	if lang == "" {
+		return protoBytes(1, protoString(1, text))
+	}
+	return protoBytes(1, protoString(1, text), protoString(2, lang))
+}
+
+func TestParseAlertFeed(t *testing.T) {
+	updated := time.Date(2018, 5, 1, 10, 0, 0, 0, time.UTC)
+	start, end := updated.Add(-time.Hour), updated.Add(2*time.Hour)
+
+	feed := append(
+		protoBytes(1, protoString(1, "2.0"), protoVarint(3, uint64(updated.Unix()))),
+		protoBytes(2,
+			protoString(1, "alert:1"),
+			protoBytes(5,
+				protoBytes(1, protoVarint(1, uint64(start.Unix())), protoVarint(2, uint64(end.Unix()))),
+				protoBytes(5, protoString(2, "line:RAT:M1")),
+				protoBytes(5, protoString(2, "line:RAT:M1"), protoString(5, "stop_point:RAT:SP:CHAT1")),
+				protoBytes(5, protoBytes(4, protoString(1, "vehicle_journey:RAT:1234"))),
+				protoVarint(6, 4),
+				protoVarint(7, 1),
+				protoBytes(10, protoTranslation("Strike", ""), protoTranslation("Grève", "fr")),
+				protoBytes(11, protoTranslation("No service on line 1", "en")),
+				protoVarint(14, 4),
+				// Unknown fields, such as extensions, are skipped
+				protoBytes(1000, protoString(1, "chaos")),
+			),
+		)...,
+	)
+	// Deleted entities and the entities which aren't alerts are skipped
+	feed = append(feed, protoBytes(2, protoString(1, "alert:2"), protoVarint(2, 1), protoBytes(5, protoVarint(7, 3)))...)
+	feed = append(feed, protoBytes(2, protoString(1, "update:1"), protoBytes(3, protoString(1, "trip")))...)
+
+	disruptions, err := ParseAlertFeed(feed, "fr")
+	if err != nil {
+		t.Fatalf("error in ParseAlertFeed: %v", err)
+	}
+	if len(disruptions) != 1 {
+		t.Fatalf("expected 1 disruption, got %d: %#v", len(disruptions), disruptions)
+	}
+	d := disruptions[0]
+
+	if d.ID != "alert:1" || d.Cause != "STRIKE" || d.Status != DisruptionStatusActive || !d.LastUpdated.Equal(updated) {
+		t.Errorf("unexpected disruption: %#v", d)
+	}
+	if d.Severity.Effect != EffectNoService || d.Severity.Name != "SEVERE" {
+		t.Errorf("unexpected severity: %#v", d.Severity)
+	}
+	if len(d.Periods) != 1 || !d.Periods[0].Begin.Equal(start) || !d.Periods[0].End.Equal(end) {
+		t.Errorf("unexpected periods: %v", d.Periods)
+	}
+
+	wantImpacted := []struct {
+		id  ID
+		typ string
+	}{
+		{"line:RAT:M1", EmbeddedLine},
+		{"stop_point:RAT:SP:CHAT1", EmbeddedStopPoint},
+		{"vehicle_journey:RAT:1234", EmbeddedTrip},
+	}
+	if len(d.Impacted) != len(wantImpacted) {
+		t.Fatalf("expected %d impacted objects, got %d", len(wantImpacted), len(d.Impacted))
+	}
+	for i, want := range wantImpacted {
+		c := d.Impacted[i].Object
+		if c.ID != want.id || c.EmbeddedType != want.typ {
+			t.Errorf("impacted object #%d: got %s %q, want %s %q", i, c.EmbeddedType, c.ID, want.typ, want.id)
+		}
+	}
+	if line, err := d.Impacted[0].Object.Object(); err != nil || line.(*Line).ID != "line:RAT:M1" {
+		t.Errorf("unexpected impacted object: %#v (%v)", line, err)
+	}
+
+	msgs := d.MessagesFor(ChannelContentText)
+	if len(msgs) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(msgs))
+	}
+	if msgs[0].Text != "Grève" || msgs[0].Channel.Name != AlertChannelHeader {
+		t.Errorf("unexpected header: %#v", msgs[0])
+	}
+	if msgs[1].Text != "No service on line 1" || msgs[1].Channel.Name != AlertChannelDescription {
+		t.Errorf("unexpected description: %#v", msgs[1])
+	}
+}
+
+func TestParseAlertFeed_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"truncated":  protoBytes(2, protoString(1, "alert:1"))[:5],
+		"group":      {1<<3 | 3},
+		"no varint":  {1 << 3},
+		"bad entity": protoBytes(2, protoBytes(5, []byte{0xff})),
+	}
+	for name, feed := range tests {
+		if _, err := ParseAlertFeed(feed, ""); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func Test_periodsStatus(t *testing.T) {
+	at := time.Date(2018, 5, 1, 10, 0, 0, 0, time.UTC)
+	past := Period{Begin: at.Add(-2 * time.Hour), End: at.Add(-time.Hour)}
+	future := Period{Begin: at.Add(time.Hour), End: at.Add(2 * time.Hour)}
+	open := Period{Begin: at.Add(-time.Hour)}
+
+	tests := []struct {
+		periods []Period
+		want    string
+	}{
+		{nil, ""},
+		{[]Period{past}, DisruptionStatusPast},
+		{[]Period{past, future}, DisruptionStatusFuture},
+		{[]Period{future, open}, DisruptionStatusActive},
+	}
+	for i, tc := range tests {
+		if got := periodsStatus(tc.periods, at); got != tc.want {
+			t.Errorf("#%d: got %q, want %q", i, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import "github.com/pkg/errors"
+
+// A BoundingBox is a geographical rectangle delimited by its south-west and north-east corners.
+//
+// If SW.Longitude is greater than NE.Longitude, the box crosses the antimeridian (180th meridian).
+type BoundingBox struct {
+	SW Coordinates // South-West corner
+	NE Coordinates // North-East corner
+}
+
+// NewBoundingBox returns the bounding box enclosing all the given coordinates.
+// It returns an error if no coordinates are given.
+func NewBoundingBox(coords []Coordinates) (BoundingBox, error) {
+	if len(coords) == 0 {
+		return BoundingBox{}, errors.New("NewBoundingBox: can't create a bounding box from no coordinates")
+	}
+
+	b := BoundingBox{SW: coords[0], NE: coords[0]}
+	for _, c := range coords[1:] {
+		b = b.Expand(c)
+	}
+	return b, nil
+}
+
+// crossesAntimeridian reports whether the box crosses the antimeridian.
+func (b BoundingBox) crossesAntimeridian() bool {
+	return b.SW.Longitude > b.NE.Longitude
+}
+
+// width returns the longitudinal span of the box, in degrees.
+func (b BoundingBox) width() float64 {
+	if b.crossesAntimeridian() {
+		return b.NE.Longitude - b.SW.Longitude + 360
+	}
+	return b.NE.Longitude - b.SW.Longitude
+}
+
+// containsLongitude reports whether the longitude lies within the longitudinal span of the box, edges included.
+func (b BoundingBox) containsLongitude(lon float64) bool {
+	if b.crossesAntimeridian() {
+		return lon >= b.SW.Longitude || lon <= b.NE.Longitude
+	}
+	return lon >= b.SW.Longitude && lon <= b.NE.Longitude
+}
+
+// Contains reports whether the coordinates are inside the box.
+// Coordinates lying on an edge or a corner are considered inside.
+func (b BoundingBox) Contains(c Coordinates) bool {
+	return c.Latitude >= b.SW.Latitude && c.Latitude <= b.NE.Latitude && b.containsLongitude(c.Longitude)
+}
+
+// Expand returns the smallest box enclosing both b and the given coordinates.
+//
+// When the coordinates are out of the longitudinal span of the box, the box is extended on the side (east or west) resulting in the narrowest box,
+// which may cross the antimeridian.
+func (b BoundingBox) Expand(c Coordinates) BoundingBox {
+	if c.Latitude < b.SW.Latitude {
+		b.SW.Latitude = c.Latitude
+	}
+	if c.Latitude > b.NE.Latitude {
+		b.NE.Latitude = c.Latitude
+	}
+
+	if b.containsLongitude(c.Longitude) {
+		return b
+	}
+
+	west, east := b, b
+	west.SW.Longitude = c.Longitude
+	east.NE.Longitude = c.Longitude
+	if west.width() < east.width() {
+		return west
+	}
+	return east
+}
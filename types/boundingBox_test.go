@@ -0,0 +1,104 @@
+package types
+
+import "testing"
+
+// TestBoundingBox_Contains checks the inclusion of points inside, outside, on the edges and on the corners of a box
+func TestBoundingBox_Contains(t *testing.T) {
+	box := BoundingBox{
+		SW: Coordinates{Latitude: 48.8, Longitude: 2.2},
+		NE: Coordinates{Latitude: 48.9, Longitude: 2.4},
+	}
+
+	tests := []struct {
+		name     string
+		c        Coordinates
+		expected bool
+	}{
+		{"inside", Coordinates{Latitude: 48.85, Longitude: 2.3}, true},
+		{"sw_corner", box.SW, true},
+		{"ne_corner", box.NE, true},
+		{"nw_corner", Coordinates{Latitude: 48.9, Longitude: 2.2}, true},
+		{"se_corner", Coordinates{Latitude: 48.8, Longitude: 2.4}, true},
+		{"west_edge", Coordinates{Latitude: 48.85, Longitude: 2.2}, true},
+		{"north_edge", Coordinates{Latitude: 48.9, Longitude: 2.3}, true},
+		{"north", Coordinates{Latitude: 48.95, Longitude: 2.3}, false},
+		{"east", Coordinates{Latitude: 48.85, Longitude: 2.45}, false},
+		{"south_west", Coordinates{Latitude: 48.7, Longitude: 2.1}, false},
+	}
+
+	for _, test := range tests {
+		if got := box.Contains(test.c); got != test.expected {
+			t.Errorf("%s: Contains(%v) = %t, expected %t", test.name, test.c, got, test.expected)
+		}
+	}
+}
+
+// TestBoundingBox_Antimeridian checks boxes crossing the antimeridian
+func TestBoundingBox_Antimeridian(t *testing.T) {
+	// Around Fiji, from 177°E to 178°W
+	box := BoundingBox{
+		SW: Coordinates{Latitude: -19, Longitude: 177},
+		NE: Coordinates{Latitude: -16, Longitude: -178},
+	}
+
+	tests := []struct {
+		name     string
+		c        Coordinates
+		expected bool
+	}{
+		{"east_of_antimeridian", Coordinates{Latitude: -17, Longitude: 179.5}, true},
+		{"west_of_antimeridian", Coordinates{Latitude: -17, Longitude: -179.5}, true},
+		{"on_antimeridian", Coordinates{Latitude: -17, Longitude: 180}, true},
+		{"ne_corner", box.NE, true},
+		{"greenwich", Coordinates{Latitude: -17, Longitude: 0}, false},
+		{"west", Coordinates{Latitude: -17, Longitude: 170}, false},
+	}
+
+	for _, test := range tests {
+		if got := box.Contains(test.c); got != test.expected {
+			t.Errorf("%s: Contains(%v) = %t, expected %t", test.name, test.c, got, test.expected)
+		}
+	}
+}
+
+// TestNewBoundingBox checks the creation of boxes from coordinates, including across the antimeridian
+func TestNewBoundingBox(t *testing.T) {
+	if _, err := NewBoundingBox(nil); err == nil {
+		t.Errorf("expected an error when creating a box from no coordinates")
+	}
+
+	box, err := NewBoundingBox([]Coordinates{
+		{Latitude: 48.85, Longitude: 2.35},
+		{Latitude: 48.80, Longitude: 2.40},
+		{Latitude: 48.90, Longitude: 2.25},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := BoundingBox{
+		SW: Coordinates{Latitude: 48.80, Longitude: 2.25},
+		NE: Coordinates{Latitude: 48.90, Longitude: 2.40},
+	}
+	if box != expected {
+		t.Errorf("got %v, expected %v", box, expected)
+	}
+
+	// Points on each side of the antimeridian should give a narrow box crossing it, not one spanning the globe
+	box, err = NewBoundingBox([]Coordinates{
+		{Latitude: -17, Longitude: 178},
+		{Latitude: -18, Longitude: -179},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = BoundingBox{
+		SW: Coordinates{Latitude: -18, Longitude: 178},
+		NE: Coordinates{Latitude: -17, Longitude: -179},
+	}
+	if box != expected {
+		t.Errorf("got %v, expected %v", box, expected)
+	}
+	if !box.Contains(Coordinates{Latitude: -17.5, Longitude: 180}) {
+		t.Errorf("expected box %v to contain the antimeridian", box)
+	}
+}
@@ -1,9 +1,38 @@
 package types
 
+import "time"
+
 // Calendar is returned on vehicle journey message and indicates periodicity informations
 // about transport schedules.
+//
+// See http://doc.navitia.io/#calendars
 type Calendar struct {
+	ID   ID     `json:"id"`
+	Name string `json:"name"`
+
 	ActivePeriods []ActivePeriod `json:"active_periods"`
 	WeekPattern   WeekPattern    `json:"week_pattern"`
 	Exceptions    []Exception    `json:"exceptions"`
 }
+
+// ActiveOn reports whether the calendar is active on the given day.
+//
+// Exceptions take precedence, otherwise the day must be in one of the active periods and match the week pattern.
+func (c Calendar) ActiveOn(day time.Time) bool {
+	y, m, d := day.Date()
+	for _, e := range c.Exceptions {
+		if ey, em, ed := e.Datetime.Date(); ey == y && em == m && ed == d {
+			return e.Type == ExceptionAdd
+		}
+	}
+
+	if !c.WeekPattern.On(day.Weekday()) {
+		return false
+	}
+	for _, ap := range c.ActivePeriods {
+		if ap.Contains(day) {
+			return true
+		}
+	}
+	return false
+}
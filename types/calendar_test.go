@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+// TestCalendar_ActiveOn checks that exceptions take precedence over the week pattern and active periods.
+func TestCalendar_ActiveOn(t *testing.T) {
+	day := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	cal := Calendar{
+		WeekPattern: WeekPattern{Monday: true, Tuesday: true, Wednesday: true, Thursday: true, Friday: true},
+		ActivePeriods: []ActivePeriod{
+			{Begin: day(2017, time.January, 2), End: day(2017, time.July, 7)},
+		},
+		Exceptions: []Exception{
+			{Type: ExceptionRemove, Datetime: day(2017, time.May, 1)},
+			{Type: ExceptionAdd, Datetime: day(2017, time.May, 20)},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		day      time.Time
+		expected bool
+	}{
+		{"weekday", day(2017, time.May, 2), true},
+		{"weekday at noon", day(2017, time.May, 2).Add(12 * time.Hour), true},
+		{"weekend", day(2017, time.May, 6), false},
+		{"first day", day(2017, time.January, 2), true},
+		{"before the period", day(2016, time.December, 30), false},
+		{"end is exclusive", day(2017, time.July, 7), false},
+		{"removed", day(2017, time.May, 1), false},
+		{"added", day(2017, time.May, 20), true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := cal.ActiveOn(tc.day); got != tc.expected {
+				t.Errorf("ActiveOn(%s): got %t, expected %t", tc.day.Format(DateFormat), got, tc.expected)
+			}
+		})
+	}
+}
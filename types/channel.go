@@ -7,3 +7,9 @@ type Channel struct {
 	Name        string   `json:"name"`            // Name of the channel
 	Types       []string `json:"types,omitempty"` // Types ?
 }
+
+// Known content types of channels
+const (
+	ChannelContentText = "text/plain"
+	ChannelContentHTML = "text/html"
+)
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A Color is an opaque colour, as given by the API for lines and their display, such as "FFFFFF".
+// It implements color.Color.
+type Color struct {
+	R, G, B uint8
+}
+
+// ParseColor parses a colour given as a hex code, with or without a leading "#", such as "#00AA55" or "00AA55".
+// It returns an error if the code isn't a valid colour.
+func ParseColor(hex string) (Color, error) {
+	clr, err := parseColor(strings.TrimPrefix(hex, "#"))
+	if err != nil {
+		return Color{}, errors.Wrap(err, "ParseColor: invalid colour")
+	}
+	return Color{R: clr.R, G: clr.G, B: clr.B}, nil
+}
+
+// RGBA implements color.Color, the colour is always opaque.
+func (c Color) RGBA() (r, g, b, a uint32) {
+	r, g, b = uint32(c.R), uint32(c.G), uint32(c.B)
+	return r | r<<8, g | g<<8, b | b<<8, 0xffff
+}
+
+// Hex returns the hex code of the colour as given by the API, such as "00AA55".
+func (c Color) Hex() string {
+	return fmt.Sprintf("%02X%02X%02X", c.R, c.G, c.B)
+}
+
+// String returns the colour in the "#RRGGBB" form used on the web, such as "#00AA55".
+func (c Color) String() string {
+	return "#" + c.Hex()
+}
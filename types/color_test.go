@@ -0,0 +1,46 @@
+package types
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		hex      string
+		expected Color
+		valid    bool
+	}{
+		{"67328E", Color{R: 0x67, G: 0x32, B: 0x8E}, true},
+		{"#ffffff", Color{R: 0xff, G: 0xff, B: 0xff}, true},
+		{"", Color{}, false},
+		{"#FFF", Color{}, false},
+		{"GGGGGG", Color{}, false},
+	}
+
+	for _, test := range tests {
+		got, err := ParseColor(test.hex)
+		if (err == nil) != test.valid {
+			t.Errorf("%q: expected valid to be %t, got error %v", test.hex, test.valid, err)
+		}
+		if got != test.expected {
+			t.Errorf("%q: expected %v, got %v", test.hex, test.expected, got)
+		}
+	}
+}
+
+func TestColor(t *testing.T) {
+	c := Color{R: 0x67, G: 0x32, B: 0x8E}
+
+	if s := c.String(); s != "#67328E" {
+		t.Errorf("unexpected String(): %q", s)
+	}
+	if h := c.Hex(); h != "67328E" {
+		t.Errorf("unexpected Hex(): %q", h)
+	}
+
+	// Colours are opaque, unlike a color.NRGBA left with a zero alpha
+	if nrgba := color.NRGBAModel.Convert(c).(color.NRGBA); nrgba != (color.NRGBA{R: 0x67, G: 0x32, B: 0x8E, A: 0xff}) {
+		t.Errorf("unexpected conversion to NRGBA: %v", nrgba)
+	}
+}
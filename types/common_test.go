@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"encoding/json"
 	"reflect"
 	"testing"
@@ -63,3 +64,55 @@ func testUnmarshal(t *testing.T, data typeTestData, resultsType reflect.Type) {
 	t.Run("correct", sub(data.correct, true))
 	t.Run("incorrect", sub(data.incorrect, false))
 }
+
+// testRoundTrip is a helper checking that marshalling any value of the given type back to JSON doesn't lose any data.
+//
+// Every known correct file is unmarshalled, marshalled and unmarshalled again.
+// 	If the two decoded values differ, the test fails
+//	If marshalling that second value doesn't give back the same JSON, the test fails
+//
+// The original data is compacted first, so that embedded objects kept as raw JSON compare equal.
+// When deep is false, only the JSON outputs are compared.
+func testRoundTrip(t *testing.T, data typeTestData, resultsType reflect.Type, deep bool) {
+	t.Helper()
+	if len(data.correct) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	for name, datum := range data.correct {
+		datum := datum
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var compacted bytes.Buffer
+			if err := json.Compact(&compacted, datum); err != nil {
+				t.Fatalf("error while compacting the original data: %v", err)
+			}
+
+			first := reflect.New(resultsType).Interface()
+			if err := json.Unmarshal(compacted.Bytes(), first); err != nil {
+				t.Fatalf("error while unmarshalling the original data: %v", err)
+			}
+			firstJSON, err := json.Marshal(first)
+			if err != nil {
+				t.Fatalf("error while marshalling: %v", err)
+			}
+
+			second := reflect.New(resultsType).Interface()
+			if err := json.Unmarshal(firstJSON, second); err != nil {
+				t.Fatalf("error while unmarshalling the marshalled data: %v\n%s", err, firstJSON)
+			}
+			if deep && !reflect.DeepEqual(first, second) {
+				t.Errorf("values differ after a round-trip:\n\tbefore: %#v\n\tafter: %#v", first, second)
+			}
+
+			secondJSON, err := json.Marshal(second)
+			if err != nil {
+				t.Fatalf("error while marshalling a second time: %v", err)
+			}
+			if string(firstJSON) != string(secondJSON) {
+				t.Errorf("JSON differs after a round-trip:\n\tbefore: %s\n\tafter: %s", firstJSON, secondJSON)
+			}
+		})
+	}
+}
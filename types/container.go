@@ -65,6 +65,9 @@ type Container struct {
 	EmbeddedType string `json:"embedded_type"`
 	Quality      int    `json:"quality"`
 
+	// Distance to the searched position in meters, only given by proximity searches such as places nearby
+	Distance uint `json:"distance"`
+
 	embeddedJSON json.RawMessage
 
 	// embeddedObject acts as a cache, it is the only element guarded by the RWMutex
@@ -101,10 +104,10 @@ type ErrInvalidContainer struct {
 	// If the Container has a zero ID.
 	NoID bool
 
-	// If the PlaceContainer has an EmbeddedType yet non-empty embedded content.
+	// If the Container has no EmbeddedType yet non-empty embedded content.
 	NoEmbeddedType bool
 
-	// If the PlaceContainer has an unknown EmbeddedType
+	// If the Container has an unknown EmbeddedType
 	UnknownEmbeddedType bool
 }
 
@@ -113,7 +116,7 @@ func (err ErrInvalidContainer) Error() string {
 	// Count the number of anomalies
 	var anomalies uint
 
-	msg := "Error: Invalid non-empty PlaceContainer (%d anomalies):"
+	msg := "Error: Invalid non-empty Container (%d anomalies):"
 
 	if err.NoID {
 		msg += "\n\tNo ID specified"
@@ -133,7 +136,7 @@ func (err ErrInvalidContainer) Error() string {
 
 // Empty returns true if the container is empty (zero value)
 func (c *Container) Empty() bool {
-	return c.ID == "" && c.Name == "" && c.EmbeddedType == "" && c.Quality == 0 && len(c.embeddedJSON) == 0 && c.embeddedObject == nil
+	return c.ID == "" && c.Name == "" && c.EmbeddedType == "" && c.Quality == 0 && c.Distance == 0 && len(c.embeddedJSON) == 0 && c.embeddedObject == nil
 }
 
 // Check checks the validity of the Container. Returns an ErrInvalidContainer.
@@ -2,56 +2,147 @@ package types
 
 import (
 	"encoding/json"
+	"strconv"
 	"sync"
 
 	"github.com/pkg/errors"
 )
 
+// jsonContainer define the JSON implementation of Container struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+//
+// As the key of the embedded content depends on the embedded type, every known one is listed, which is much cheaper than decoding into a map.
+type jsonContainer struct {
+	// Pointers to the corresponding real values
+	ID           *ID     `json:"id"`
+	Name         *string `json:"name"`
+	EmbeddedType *string `json:"embedded_type"`
+	Quality      *int    `json:"quality"`
+
+	// Values to process
+	Distance string `json:"distance"`
+
+	// Embedded content, by embedded type, only the one of the container's type being set
+	StopArea       json.RawMessage `json:"stop_area,omitempty"`
+	POI            json.RawMessage `json:"poi,omitempty"`
+	Address        json.RawMessage `json:"address,omitempty"`
+	StopPoint      json.RawMessage `json:"stop_point,omitempty"`
+	Admin          json.RawMessage `json:"administrative_region,omitempty"`
+	Line           json.RawMessage `json:"line,omitempty"`
+	Route          json.RawMessage `json:"route,omitempty"`
+	Network        json.RawMessage `json:"network,omitempty"`
+	CommercialMode json.RawMessage `json:"commercial_mode,omitempty"`
+	Trip           json.RawMessage `json:"trip,omitempty"`
+}
+
+// embedded returns the embedded content of the given type
+func (data *jsonContainer) embedded(embeddedType string) json.RawMessage {
+	switch embeddedType {
+	case EmbeddedStopArea:
+		return data.StopArea
+	case EmbeddedPOI:
+		return data.POI
+	case EmbeddedAddress:
+		return data.Address
+	case EmbeddedStopPoint:
+		return data.StopPoint
+	case EmbeddedAdmin:
+		return data.Admin
+	case EmbeddedLine:
+		return data.Line
+	case EmbeddedRoute:
+		return data.Route
+	case EmbeddedNetwork:
+		return data.Network
+	case EmbeddedCommercialMode:
+		return data.CommercialMode
+	case EmbeddedTrip:
+		return data.Trip
+	default:
+		return nil
+	}
+}
+
+// setEmbedded sets the embedded content of the given type, the inverse of embedded
+func (data *jsonContainer) setEmbedded(embeddedType string, raw json.RawMessage) {
+	switch embeddedType {
+	case EmbeddedStopArea:
+		data.StopArea = raw
+	case EmbeddedPOI:
+		data.POI = raw
+	case EmbeddedAddress:
+		data.Address = raw
+	case EmbeddedStopPoint:
+		data.StopPoint = raw
+	case EmbeddedAdmin:
+		data.Admin = raw
+	case EmbeddedLine:
+		data.Line = raw
+	case EmbeddedRoute:
+		data.Route = raw
+	case EmbeddedNetwork:
+		data.Network = raw
+	case EmbeddedCommercialMode:
+		data.CommercialMode = raw
+	case EmbeddedTrip:
+		data.Trip = raw
+	}
+}
+
 // UnmarshalJSON satisfies the json.Unmarshaller interface
 func (c *Container) UnmarshalJSON(b []byte) error {
 	// Set up a mutex
 	c.mu = &sync.RWMutex{}
 
-	// Unmarshal into a map
-	data := map[string]json.RawMessage{}
-	err := json.Unmarshal(b, &data)
-	if err != nil {
-		return errors.Wrap(err, "Couldn't unmarshal into a map")
+	data := &jsonContainer{
+		ID:           &c.ID,
+		Name:         &c.Name,
+		EmbeddedType: &c.EmbeddedType,
+		Quality:      &c.Quality,
 	}
 
-	// Create the error generator
-	gen := unmarshalErrorMaker{"Container", b}
-
-	// From a map, extract the ID, Name & EmbeddedType
-	if id, ok := data["id"]; ok {
-		err := json.Unmarshal(id, &c.ID)
-		if err != nil {
-			return gen.err(err, "ID", "id", id, "error while unmarshalling")
-		}
-	}
-	if name, ok := data["name"]; ok {
-		err := json.Unmarshal(name, &c.Name)
-		if err != nil {
-			return gen.err(err, "Name", "name", name, "error while unmarshalling")
-		}
-	}
-	if embeddedType, ok := data["embedded_type"]; ok {
-		err := json.Unmarshal(embeddedType, &c.EmbeddedType)
-		if err != nil {
-			return gen.err(err, "EmbeddedType", "embedded_type", embeddedType, "error while unmarshalling")
-		}
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return errors.Wrap(err, "error while unmarshalling Container")
 	}
-	if quality, ok := data["quality"]; ok {
-		err := json.Unmarshal(quality, &c.Quality)
+
+	// The distance is given as a string, in meters
+	if data.Distance != "" {
+		d, err := strconv.ParseUint(data.Distance, 10, 0)
 		if err != nil {
-			return gen.err(err, "Quality", "quality", quality, "error while unmarshalling")
+			return errors.Wrapf(err, "error while parsing Container distance %q", data.Distance)
 		}
+		c.Distance = uint(d)
 	}
 
 	// Now, assign the embedded content to the Container
-	if embedded, ok := data[c.EmbeddedType]; ok {
-		c.embeddedJSON = embedded
-	}
+	c.embeddedJSON = data.embedded(c.EmbeddedType)
 
 	return nil
 }
+
+// MarshalJSON satisfies the json.Marshaler interface, writing the embedded content back under the key of its type
+func (c Container) MarshalJSON() ([]byte, error) {
+	data := &jsonContainer{
+		ID:           &c.ID,
+		Name:         &c.Name,
+		EmbeddedType: &c.EmbeddedType,
+		Quality:      &c.Quality,
+	}
+	if c.Distance != 0 {
+		data.Distance = strconv.FormatUint(uint64(c.Distance), 10)
+	}
+	data.setEmbedded(c.EmbeddedType, c.embeddedJSON)
+
+	return json.Marshal(data)
+}
+
+// orNil returns nil for an empty Container, so that a missing origin or destination isn't marshalled as an empty object
+func (c *Container) orNil() *Container {
+	if c.ID == "" && c.EmbeddedType == "" {
+		return nil
+	}
+	return c
+}
@@ -3,9 +3,16 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
+	"sync"
+
+	"github.com/twpayne/go-geom"
 )
 
+// earthRadius is the mean radius of the Earth, in meters
+const earthRadius = 6371008.8
+
 // Coordinates code for coordinates used throughout the API.
 // This is the Go representation of "Coordinates". It implements Place.
 // See http://doc.navitia.io/#standard-objects.
@@ -20,16 +27,61 @@ type jsonCoordinates struct {
 	Longitude string `json:"lon"`
 }
 
-// ID formats coordinates for use in queries as an ID.
+// jsonCoordinatesPool holds the jsonCoordinates that Coordinates are decoded through, as each place & stop has some,
+// and they would otherwise be allocated for each of them, see Coordinates.UnmarshalJSON
+var jsonCoordinatesPool = sync.Pool{
+	New: func() interface{} { return new(jsonCoordinates) },
+}
+
+// String formats coordinates in the "lon;lat" form used by Navitia in URLs and queries, without rounding them.
+func (c Coordinates) String() string {
+	return formatCoord(c.Longitude) + ";" + formatCoord(c.Latitude)
+}
+
+// ID formats coordinates for use in queries as an ID, in the "lon;lat" form, see String.
 func (c Coordinates) ID() ID {
-	return ID(fmt.Sprintf("%3.3f;%3.3f", c.Longitude, c.Latitude))
+	return ID(c.String())
+}
+
+// URI returns the "coord:lon:lat" identifier the API gives to the coordinates, such as in the ID of a Container.
+func (c Coordinates) URI() ID {
+	return ID("coord:" + formatCoord(c.Longitude) + ":" + formatCoord(c.Latitude))
+}
+
+// formatCoord formats a longitude or latitude with as many decimals as needed
+func formatCoord(x float64) string {
+	return strconv.FormatFloat(x, 'f', -1, 64)
+}
+
+// Point converts the coordinates to a go-geom point, X being the longitude and Y the latitude, as in Section.Geo.
+func (c Coordinates) Point() *geom.Point {
+	return geom.NewPointFlat(geom.XY, []float64{c.Longitude, c.Latitude})
+}
+
+// FromPoint creates Coordinates from a go-geom point, see Coordinates.Point.
+func FromPoint(p *geom.Point) Coordinates {
+	return Coordinates{Longitude: p.X(), Latitude: p.Y()}
+}
+
+// DistanceTo returns the great-circle distance to other, in meters, using the haversine formula.
+func (c Coordinates) DistanceTo(other Coordinates) float64 {
+	lat1, lat2 := c.Latitude*math.Pi/180, other.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (other.Longitude - c.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
 }
 
 // UnmarshalJSON implements json.Unmarshaller for a Coordinates
 func (c *Coordinates) UnmarshalJSON(b []byte) error {
-	var data jsonCoordinates
+	data := jsonCoordinatesPool.Get().(*jsonCoordinates)
+	defer func() {
+		*data = jsonCoordinates{}
+		jsonCoordinatesPool.Put(data)
+	}()
 
-	err := json.Unmarshal(b, &data)
+	err := json.Unmarshal(b, data)
 	if err != nil {
 		return fmt.Errorf("error while unmarshalling Coordinates struct : %w", err)
 	}
@@ -49,3 +101,11 @@ func (c *Coordinates) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Coordinates, formatting them as strings as the Navitia api does
+func (c Coordinates) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonCoordinates{
+		Latitude:  strconv.FormatFloat(c.Latitude, 'f', -1, 64),
+		Longitude: strconv.FormatFloat(c.Longitude, 'f', -1, 64),
+	})
+}
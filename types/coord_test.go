@@ -0,0 +1,53 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCoordinates_Formatting(t *testing.T) {
+	c := Coordinates{Longitude: 2.37731, Latitude: 48.847002}
+
+	if s := c.String(); s != "2.37731;48.847002" {
+		t.Errorf("unexpected String(): %q", s)
+	}
+	if id := c.ID(); id != "2.37731;48.847002" {
+		t.Errorf("unexpected ID(): %q", id)
+	}
+	if uri := c.URI(); uri != "coord:2.37731:48.847002" {
+		t.Errorf("unexpected URI(): %q", uri)
+	}
+}
+
+func TestCoordinates_DistanceTo(t *testing.T) {
+	var (
+		notreDame   = Coordinates{Longitude: 2.349902, Latitude: 48.852968}
+		bellecour   = Coordinates{Longitude: 4.832011, Latitude: 45.757814}
+		expected    = 391500.0 // meters
+		tolerance   = 1500.0
+		distance    = notreDame.DistanceTo(bellecour)
+		reverseDist = bellecour.DistanceTo(notreDame)
+	)
+
+	if math.Abs(distance-expected) > tolerance {
+		t.Errorf("expected a distance of about %.0fm, got %.0fm", expected, distance)
+	}
+	if distance != reverseDist {
+		t.Errorf("distance isn't symmetric: %f != %f", distance, reverseDist)
+	}
+	if d := notreDame.DistanceTo(notreDame); d != 0 {
+		t.Errorf("expected a null distance to itself, got %f", d)
+	}
+}
+
+func TestCoordinates_Point(t *testing.T) {
+	c := Coordinates{Longitude: 2.37731, Latitude: 48.847002}
+
+	p := c.Point()
+	if p.X() != c.Longitude || p.Y() != c.Latitude {
+		t.Errorf("unexpected point: %v", p.FlatCoords())
+	}
+	if back := FromPoint(p); back != c {
+		t.Errorf("expected %v after a round-trip, got %v", c, back)
+	}
+}
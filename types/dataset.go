@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// A Contributor is a provider of data, such as a transit agency publishing a GTFS feed.
+//
+// See http://doc.navitia.io/#contributors
+type Contributor struct {
+	ID      ID     `json:"id"`
+	Name    string `json:"name"`
+	License string `json:"license"`
+	Website string `json:"website"`
+}
+
+// A Dataset is a set of data loaded from a Contributor, such as a version of its GTFS feed.
+//
+// See http://doc.navitia.io/#datasets
+type Dataset struct {
+	ID          ID     `json:"id"`
+	Description string `json:"description"`
+
+	// System of the source data, eg "gtfs" or "ntfs"
+	System string `json:"system"`
+
+	// Validity period of the dataset
+	Start time.Time `json:"start_validation_date"`
+	End   time.Time `json:"end_validation_date"`
+
+	// RealtimeLevel is the level of the data: base schedule, adapted schedule or realtime
+	RealtimeLevel DataFreshness `json:"realtime_level"`
+
+	// Contributor the dataset comes from
+	Contributor Contributor `json:"contributor"`
+}
+
+// jsonDataset define the JSON implementation of Dataset struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonDataset struct {
+	ID            *ID            `json:"id"`
+	Description   *string        `json:"description"`
+	System        *string        `json:"system"`
+	RealtimeLevel *DataFreshness `json:"realtime_level"`
+	Contributor   *Contributor   `json:"contributor"`
+
+	// Values to process
+	Start string `json:"start_validation_date"`
+	End   string `json:"end_validation_date"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a Dataset
+func (d *Dataset) UnmarshalJSON(b []byte) error {
+	data := &jsonDataset{
+		ID:            &d.ID,
+		Description:   &d.Description,
+		System:        &d.System,
+		RealtimeLevel: &d.RealtimeLevel,
+		Contributor:   &d.Contributor,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling Dataset: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"Dataset", b}
+
+	// Process the validity period
+	d.Start, err = parseDateTime(data.Start)
+	if err != nil {
+		return gen.err(err, "Start", "start_validation_date", data.Start, "parseDateTime failed")
+	}
+	d.End, err = parseDateTime(data.End)
+	if err != nil {
+		return gen.err(err, "End", "end_validation_date", data.End, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for a Dataset
+func (d Dataset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonDataset{
+		ID:            &d.ID,
+		Description:   &d.Description,
+		System:        &d.System,
+		RealtimeLevel: &d.RealtimeLevel,
+		Contributor:   &d.Contributor,
+		Start:         formatDateTime(d.Start),
+		End:           formatDateTime(d.End),
+	})
+}
+
+// ValidAt reports whether the dataset is valid at the given time
+func (d Dataset) ValidAt(t time.Time) bool {
+	return !t.Before(d.Start) && !t.After(d.End)
+}
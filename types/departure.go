@@ -1,18 +1,19 @@
 package types
 
+// A Departure is an upcoming departure from a stop point, as listed on a departure board.
 type Departure struct {
-	DisplayInformations Display   `json:"display_informations"`
-	StopPoint           StopPoint `json:"stop_point"`
-	Route               Route     `json:"route"`
-	Links               []Link    `json:"links"`
-	StopDateTime
+	DisplayInformations Display    `json:"display_informations"` // Information to display, such as the line & direction
+	StopPoint           StopPoint  `json:"stop_point"`           // The stop point the vehicle departs from
+	Route               Route      `json:"route"`                // The route taken by the vehicle
+	Links               Links      `json:"links"`                // Links to related objects, such as the vehicle journey
+	StopDateTime        PTDateTime `json:"stop_date_time"`       // When the vehicle departs, in realtime if available, see PTDateTime.DataFreshness
 }
 
-type StopDateTime struct {
-	Links                 []Link `json:"links"`
-	ArrivalDateTime       string `json:"arrival_date_time"`
-	DepartureDateTime     string `json:"departure_date_time"`
-	BaseArrivalDateTime   string `json:"base_arrival_date_time"`
-	BaseDepartureDateTime string `json:"base_departure_date_time"`
-	DataFreshness         string `json:"data_freshness"`
-}
+// An Arrival is an upcoming arrival at a stop point, as listed on an arrival board.
+// It has the same shape as a Departure, StopDateTime.Arrival being the time of arrival.
+type Arrival Departure
+
+// A StopDateTime is the former type of Departure.StopDateTime, which now holds the date times decoded.
+//
+// Deprecated: use PTDateTime.
+type StopDateTime = PTDateTime
@@ -3,7 +3,6 @@ package types
 import (
 	"encoding/json"
 	"fmt"
-	"image/color"
 )
 
 // A Display holds informations useful to display.
@@ -14,13 +13,14 @@ type Display struct {
 	CommercialMode ID          `json:"commercial_mode"` // The commercial mode in ID Form
 	PhysicalMode   ID          `json:"physical_mode"`   // The physical mode in ID Form
 	Label          string      `json:"label"`           // The label of the object
-	Color          color.Color `json:"color"`           // Hexadecimal color of the line
-	TextColor      color.Color `json:"text_color"`      // The text color for this section
+	Color          *Color      `json:"color"`           // Color of the line, nil if not given
+	TextColor      *Color      `json:"text_color"`      // The text color for this section, nil if not given
 	Code           string      `json:"code"`            // The code of the line
 	Description    string      `json:"description"`     // Description
 	Equipments     []Equipment `json:"equipments"`      // Equipments on this object
 	Name           string      `json:"name"`            // Name of object
 	TripShortName  string      `json:"trip_short_name"` // TripShoerName short name of the current trip
+	Links          Links       `json:"links"`           // Links to related objects, such as disruptions
 }
 
 // jsonDisplay define the JSON implementation of Display struct
@@ -37,6 +37,9 @@ type jsonDisplay struct {
 	Code           *string      `json:"code"`
 	Description    *string      `json:"description"`
 	Equipments     *[]Equipment `json:"equipments"`
+	Name           *string      `json:"name"`
+	TripShortName  *string      `json:"trip_short_name"`
+	Links          *Links       `json:"links"`
 
 	// Values to process
 	Color     string `json:"color"`
@@ -57,6 +60,9 @@ func (d *Display) UnmarshalJSON(b []byte) error {
 		Code:           &d.Code,
 		Description:    &d.Description,
 		Equipments:     &d.Equipments,
+		Name:           &d.Name,
+		TripShortName:  &d.TripShortName,
+		Links:          &d.Links,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -71,19 +77,44 @@ func (d *Display) UnmarshalJSON(b []byte) error {
 	// Now process the values
 	// We expect a color string length of 6 because it should be coded in hexadecimal
 	if str := data.Color; len(str) == 6 {
-		clr, err := parseColor(str)
+		clr, err := ParseColor(str)
 		if err != nil {
-			return gen.err(err, "Color", "color", str, "error in parseColor")
+			return gen.err(err, "Color", "color", str, "error in ParseColor")
 		}
-		d.Color = clr
+		d.Color = &clr
 	}
 	if str := data.TextColor; len(str) == 6 {
-		clr, err := parseColor(str)
+		clr, err := ParseColor(str)
 		if err != nil {
-			return gen.err(err, "TextColor", "text_color", str, "error in parseColor")
+			return gen.err(err, "TextColor", "text_color", str, "error in ParseColor")
 		}
-		d.TextColor = clr
+		d.TextColor = &clr
 	}
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Display
+func (d Display) MarshalJSON() ([]byte, error) {
+	data := jsonDisplay{
+		Headsign:       &d.Headsign,
+		Network:        &d.Network,
+		Direction:      &d.Direction,
+		CommercialMode: &d.CommercialMode,
+		PhysicalMode:   &d.PhysicalMode,
+		Label:          &d.Label,
+		Code:           &d.Code,
+		Description:    &d.Description,
+		Equipments:     &d.Equipments,
+		Name:           &d.Name,
+		TripShortName:  &d.TripShortName,
+		Links:          &d.Links,
+	}
+	if d.Color != nil {
+		data.Color = d.Color.Hex()
+	}
+	if d.TextColor != nil {
+		data.TextColor = d.TextColor.Hex()
+	}
+	return json.Marshal(data)
+}
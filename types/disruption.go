@@ -21,7 +21,7 @@ const (
 	JourneyStatusReducedService = "REDUCED_SERVICE"
 
 	// Service running but with substantial delays expected.
-	JourneyStatusSignificantDelay = "SIGNIFICANT_DELAY"
+	JourneyStatusSignificantDelay = "SIGNIFICANT_DELAYS"
 
 	// Service running on alternative routes to avoid problem.
 	JourneyStatusDetour = "DETOUR"
@@ -42,6 +42,39 @@ const (
 	JourneyStatusStopMoved = "STOP_MOVED"
 )
 
+// DisruptionStatusXXX are the known states of a Disruption, see Disruption.Status
+const (
+	DisruptionStatusPast   = "past"
+	DisruptionStatusActive = "active"
+	DisruptionStatusFuture = "future"
+)
+
+// linkTypeDisruption is the type of links referencing a Disruption
+const linkTypeDisruption = "disruption"
+
+// indexDisruptions indexes the given disruptions by ID
+func indexDisruptions(disruptions []Disruption) map[ID]Disruption {
+	index := make(map[ID]Disruption, len(disruptions))
+	for _, d := range disruptions {
+		index[d.ID] = d
+	}
+	return index
+}
+
+// linkedDisruptions returns the disruptions referenced by the links, looked up in index
+func linkedDisruptions(links Links, index map[ID]Disruption) []Disruption {
+	var linked []Disruption
+	for _, link := range links {
+		if link.Type != linkTypeDisruption {
+			continue
+		}
+		if d, ok := index[link.ID]; ok {
+			linked = append(linked, d)
+		}
+	}
+	return linked
+}
+
 // A Disruption reports the specifics of a Disruption
 type Disruption struct {
 	ID ID `json:"id"` // ID of the Disruption
@@ -51,16 +84,17 @@ type Disruption struct {
 	// It can be either "Past", "Active" or "Future"
 	Status string `json:"status"`
 
-	InputDisruptionID ID               // For traceability, ID of original input disruption
-	InputImpactID     ID               // For traceability: Id of original input impact
-	Severity          Severity         `json:"severity"` // Severity gives some categorization element
-	Periods           []Period         // Dates where the current disruption is active
-	Messages          []Message        // Text to provide to the traveller
-	LastUpdated       time.Time        // Last Update of that disruption
-	Impacted          []ImpactedObject `json:"impacted_stops"` // Objects impacted
-	Cause             string           // The cause of that disruption
-	Category          string           // The category of the disruption, optional.
-	DisruptionID      string           `json:"disruption_id"`
+	InputDisruptionID ID                  // For traceability, ID of original input disruption
+	InputImpactID     ID                  // For traceability: Id of original input impact
+	Severity          Severity            `json:"severity"` // Severity gives some categorization element
+	Periods           []Period            // Dates where the current disruption is active
+	Messages          []DisruptionMessage // Text to provide to the traveller, one per channel
+	LastUpdated       time.Time           // Last Update of that disruption
+	Impacted          []ImpactedObject    `json:"impacted_stops"` // Objects impacted
+	Cause             string              // The cause of that disruption
+	Category          string              // The category of the disruption, optional.
+	Tags              []string            // Tags of the disruption, such as "rer" or "travaux", optional.
+	DisruptionID      string              `json:"disruption_id"`
 }
 
 // jsonDisruption define the JSON implementation of Disruption struct
@@ -74,10 +108,11 @@ type jsonDisruption struct {
 	InputImpactID     *ID               `json:"impact_id"`
 	Severity          *Severity         `json:"severity"`
 	Periods           *[]Period         `json:"application_periods"`
-	Messages          *[]Message        `json:"messages"`
+	Messages          flexibleSlice     `json:"messages"`
 	Impacted          *[]ImpactedObject `json:"impacted_objects"`
 	Cause             *string           `json:"cause"`
 	Category          *string           `json:"category"`
+	Tags              *[]string         `json:"tags"`
 
 	// Those we will process
 	LastUpdated string `json:"updated_at"`
@@ -100,10 +135,10 @@ func (d *Disruption) UnmarshalJSON(b []byte) error {
 		InputImpactID:     &d.InputImpactID,
 		Severity:          &d.Severity,
 		Periods:           &d.Periods,
-		Messages:          &d.Messages,
 		Impacted:          &d.Impacted,
 		Cause:             &d.Cause,
 		Category:          &d.Category,
+		Tags:              &d.Tags,
 	}
 
 	// Let's create the error generator
@@ -115,6 +150,11 @@ func (d *Disruption) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("error while unmarshalling Disruption: %w", err)
 	}
 
+	// Messages may be a single object or an array
+	if err := data.Messages.decode(&d.Messages); err != nil {
+		return gen.err(err, "Messages", "messages", string(data.Messages), "error while decoding flexible slice")
+	}
+
 	// Now we process the Update time
 	d.LastUpdated, err = parseDateTime(data.LastUpdated)
 	if err != nil {
@@ -124,3 +164,38 @@ func (d *Disruption) UnmarshalJSON(b []byte) error {
 	// Finished !
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Disruption
+func (d Disruption) MarshalJSON() ([]byte, error) {
+	messages, err := json.Marshal(d.Messages)
+	if err != nil {
+		return nil, fmt.Errorf("error while marshalling Disruption messages: %w", err)
+	}
+
+	return json.Marshal(jsonDisruption{
+		ID:                &d.ID,
+		Status:            &d.Status,
+		InputDisruptionID: &d.InputDisruptionID,
+		InputImpactID:     &d.InputImpactID,
+		Severity:          &d.Severity,
+		Periods:           &d.Periods,
+		Messages:          messages,
+		Impacted:          &d.Impacted,
+		Cause:             &d.Cause,
+		Category:          &d.Category,
+		Tags:              &d.Tags,
+		LastUpdated:       formatDateTime(d.LastUpdated),
+	})
+}
+
+// MessagesFor returns the messages of the disruption destined to channels of the given content type (eg ChannelContentHTML).
+// Messages without channel information are returned when asking for ChannelContentText.
+func (d *Disruption) MessagesFor(contentType string) []DisruptionMessage {
+	var msgs []DisruptionMessage
+	for _, m := range d.Messages {
+		if (m.Channel == nil && contentType == ChannelContentText) || (m.Channel != nil && m.Channel.ContentType == contentType) {
+			msgs = append(msgs, m)
+		}
+	}
+	return msgs
+}
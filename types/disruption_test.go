@@ -14,3 +14,34 @@ import (
 func Test_Disruption_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["disruption"], reflect.TypeOf(Disruption{}))
 }
+
+// TestDisruption_MessagesFor checks that every message channel of a disruption (and its tags) is decoded, and that messages can be picked by channel
+func TestDisruption_MessagesFor(t *testing.T) {
+	data := testData["disruption"].correct["channels.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	d := &Disruption{}
+	if err := d.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if !reflect.DeepEqual(d.Tags, []string{"metro", "travaux"}) {
+		t.Errorf("unexpected tags: %v", d.Tags)
+	}
+
+	if len(d.Messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(d.Messages))
+	}
+
+	html := d.MessagesFor(ChannelContentHTML)
+	if len(html) != 1 || html[0].Channel.Name != "web et mobile" {
+		t.Errorf("unexpected html messages: %#v", html)
+	}
+
+	text := d.MessagesFor(ChannelContentText)
+	if len(text) != 1 || text[0].Text != "Travaux : trafic interrompu entre Nation et Gare de Lyon." {
+		t.Errorf("unexpected text messages: %#v", text)
+	}
+}
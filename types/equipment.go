@@ -46,3 +46,29 @@ func (eq Equipment) Known() bool {
 	}
 	return false
 }
+
+// Equipments holds a human-readable description of each known equipment
+var Equipments = map[Equipment]string{
+	EquipmentWheelchairAccessibility: "Accessible to wheelchairs",
+	EquipmentBikeAccepted:            "Bikes accepted on board",
+	EquipmentAirConditioned:          "Air conditioned",
+	EquipmentVisualAnnouncement:      "Visual announcements of the stops",
+	EquipmentAudibleAnnouncement:     "Audible announcements of the stops",
+	EquipmentAppropriateEscort:       "Escort available for people with reduced mobility",
+	EquipmentAppropriateSignage:      "Signage adapted to people with reduced mobility",
+	EquipmentSchoolVehicle:           "School vehicle",
+	EquipmentWheelchairBoarding:      "Wheelchair boarding possible",
+	EquipmentSheltered:               "Sheltered",
+	EquipmentElevator:                "Elevator",
+	EquipmentEscalator:               "Escalator",
+	EquipmentBikeDepot:               "Bike parking",
+}
+
+// Description returns a human-readable description of the equipment, such as "Elevator".
+// Unknown equipments are described by their code.
+func (eq Equipment) Description() string {
+	if d, ok := Equipments[eq]; ok {
+		return d
+	}
+	return string(eq)
+}
@@ -0,0 +1,136 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// An EquipmentReport lists, for a line, the realtime state of the equipments of the stop areas it serves,
+// such as elevators and escalators.
+// Named "equipment_report" in the Navitia doc
+//
+// See http://doc.navitia.io/#equipment-reports
+type EquipmentReport struct {
+	Line               Line                 `json:"line"`
+	StopAreaEquipments []StopAreaEquipments `json:"stop_area_equipments"`
+}
+
+// StopAreaEquipments holds the equipments of a stop area along with their state.
+type StopAreaEquipments struct {
+	StopArea         StopArea           `json:"stop_area"`
+	EquipmentDetails []EquipmentDetails `json:"equipment_details"`
+}
+
+// Unavailable returns the equipments of the stop area which are currently out of service.
+func (sae StopAreaEquipments) Unavailable() []EquipmentDetails {
+	var out []EquipmentDetails
+	for _, ed := range sae.EquipmentDetails {
+		if ed.CurrentAvailability.Status == AvailabilityUnavailable {
+			out = append(out, ed)
+		}
+	}
+	return out
+}
+
+// EquipmentKindXXX are the known kinds of equipment in an equipment report
+const (
+	EquipmentKindElevator  = "elevator"
+	EquipmentKindEscalator = "escalator"
+)
+
+// EquipmentDetails describes a single piece of equipment and its current state.
+type EquipmentDetails struct {
+	ID   ID     `json:"id"`
+	Name string `json:"name"`
+
+	// Kind of equipment, such as EquipmentKindElevator
+	Kind string `json:"embedded_type"`
+
+	CurrentAvailability EquipmentAvailability `json:"current_availability"`
+}
+
+// AvailabilityXXX are the known statuses of an EquipmentAvailability
+const (
+	AvailabilityAvailable   = "available"
+	AvailabilityUnavailable = "unavailable"
+	AvailabilityUnknown     = "unknown"
+)
+
+// EquipmentAvailability is the state of an equipment at the time of the request.
+type EquipmentAvailability struct {
+	// Status is one of AvailabilityAvailable, AvailabilityUnavailable or AvailabilityUnknown
+	Status string
+
+	// Cause and Effect of the unavailability, if any (e.g "maintenance", "out of order")
+	Cause  string
+	Effect string
+
+	// Periods during which the status applies
+	Periods []Period
+
+	// UpdatedAt is when the status was last reported
+	UpdatedAt time.Time
+}
+
+// Available reports whether the equipment is known to be in service.
+func (ea EquipmentAvailability) Available() bool {
+	return ea.Status == AvailabilityAvailable
+}
+
+// jsonEquipmentAvailability define the JSON implementation of EquipmentAvailability struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonEquipmentAvailability struct {
+	Status  *string   `json:"status"`
+	Periods *[]Period `json:"periods"`
+
+	// Values to process
+	Cause     jsonLabel `json:"cause"`
+	Effect    jsonLabel `json:"effect"`
+	UpdatedAt string    `json:"updated_at"`
+}
+
+// jsonLabel is an object only carrying a label, as used for causes and effects
+type jsonLabel struct {
+	Label string `json:"label"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for an EquipmentAvailability
+func (ea *EquipmentAvailability) UnmarshalJSON(b []byte) error {
+	data := &jsonEquipmentAvailability{
+		Status:  &ea.Status,
+		Periods: &ea.Periods,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling EquipmentAvailability: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"EquipmentAvailability", b}
+
+	ea.Cause = data.Cause.Label
+	ea.Effect = data.Effect.Label
+
+	ea.UpdatedAt, err = parseDateTime(data.UpdatedAt)
+	if err != nil {
+		return gen.err(err, "UpdatedAt", "updated_at", data.UpdatedAt, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for an EquipmentAvailability
+func (ea EquipmentAvailability) MarshalJSON() ([]byte, error) {
+	data := jsonEquipmentAvailability{
+		Status:    &ea.Status,
+		Periods:   &ea.Periods,
+		UpdatedAt: formatDateTime(ea.UpdatedAt),
+	}
+	data.Cause.Label = ea.Cause
+	data.Effect.Label = ea.Effect
+	return json.Marshal(data)
+}
@@ -0,0 +1,22 @@
+package types
+
+import "testing"
+
+// TestEquipment_Description checks that every known equipment has a description
+func TestEquipment_Description(t *testing.T) {
+	for _, eq := range knownEquipments {
+		if !eq.Known() {
+			t.Errorf("%q: expected to be known", eq)
+		}
+		if _, ok := Equipments[eq]; !ok {
+			t.Errorf("%q: no description", eq)
+		}
+	}
+	if len(Equipments) != len(knownEquipments) {
+		t.Errorf("expected %d descriptions, got %d", len(knownEquipments), len(Equipments))
+	}
+
+	if unknown := Equipment("has_teleporter"); unknown.Known() || unknown.Description() != "has_teleporter" {
+		t.Errorf("unexpected result for an unknown equipment: known %t, description %q", unknown.Known(), unknown.Description())
+	}
+}
@@ -1,6 +1,60 @@
 package types
 
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ExceptionXXX are the known types of Exception
+const (
+	ExceptionAdd    = "add"    // The calendar is active on that day, even though its pattern says otherwise
+	ExceptionRemove = "remove" // The calendar isn't active on that day, even though its pattern says otherwise
+)
+
+// An Exception is a day on which a Calendar differs from its week pattern and active periods.
 type Exception struct {
-	Type     string `json:"type"`
+	Type     string    `json:"type"`     // Either ExceptionAdd or ExceptionRemove
+	Datetime time.Time `json:"datetime"` // The day of the exception
+}
+
+// jsonException define the JSON implementation of Exception struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonException struct {
+	Type *string `json:"type"`
+
+	// Value to process
 	Datetime string `json:"datetime"`
 }
+
+// UnmarshalJSON implements json.Unmarshaller for an Exception
+func (e *Exception) UnmarshalJSON(b []byte) error {
+	data := &jsonException{
+		Type: &e.Type,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling Exception: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"Exception", b}
+
+	e.Datetime, err = parseDateTime(data.Datetime)
+	if err != nil {
+		return gen.err(err, "Datetime", "datetime", data.Datetime, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for an Exception
+func (e Exception) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonException{
+		Type:     &e.Type,
+		Datetime: formatDate(e.Datetime),
+	})
+}
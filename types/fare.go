@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/text/currency"
@@ -11,20 +13,64 @@ import (
 type Fare struct {
 	Total currency.Amount
 	Found bool
+
+	// Links to the tickets making up the fare, see Fare.TicketIDs
+	Links Links
+
+	// value is the cost as given by the API, as currency.Amount doesn't give it back
+	value string
+}
+
+// jsonCost is the JSON representation of an amount of money
+type jsonCost struct {
+	Value    string `json:"value"`
+	Currency string `json:"currency"`
+}
+
+// jsonFare define the JSON implementation of Fare struct
+type jsonFare struct {
+	Found *bool  `json:"found"`
+	Links *Links `json:"links"`
+
+	// Journeys give their fare's total, a cost is still accepted in its place as it used to be the only key read
+	Total jsonCost  `json:"total"`
+	Cost  *jsonCost `json:"cost,omitempty"`
+}
+
+// parseCost parses an amount of money as given by the API.
+//
+// Some instances of navitia are configured to give amounts in "centime" (euro cents), those are converted to euros.
+// An empty value or currency is no amount, and the returned value is empty.
+func parseCost(c jsonCost) (amount currency.Amount, value string, err error) {
+	if c.Value == "" || c.Currency == "" {
+		return currency.Amount{}, "", nil
+	}
+
+	v, err := strconv.ParseFloat(c.Value, 64)
+	if err != nil {
+		return currency.Amount{}, "", errors.Wrap(err, "error while parsing the value via strconv.ParseFloat")
+	}
+
+	if strings.EqualFold(c.Currency, "centime") {
+		v /= 100
+		c.Value = strconv.FormatFloat(v, 'f', 2, 64)
+		c.Currency = currency.EUR.String()
+	}
+
+	unit, err := currency.ParseISO(c.Currency)
+	if err != nil {
+		return currency.Amount{}, "", errors.Wrap(err, "error while retrieving currency unit via currency.ParseISO")
+	}
+	return unit.Amount(v), c.Value, nil
 }
 
 // UnmarshalJSON implements json.Unmarshaller for a Fare
 func (f *Fare) UnmarshalJSON(b []byte) error {
 	// First let's create the analogous structure
 	// We define some of the value as pointers to the real values, allowing us to bypass copying in cases where we don't need to process the data
-	data := &struct {
-		Found *bool `json:"found"`
-		Cost  struct {
-			Value    string `json:"value"`
-			Currency string `json:"currency"`
-		} `json:"cost"`
-	}{
+	data := &jsonFare{
 		Found: &f.Found,
+		Links: &f.Links,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -36,19 +82,117 @@ func (f *Fare) UnmarshalJSON(b []byte) error {
 	gen := unmarshalErrorMaker{"Fare", b}
 
 	// Let's convert the cost now
-	// If we have no defined fare, let's skip that part
-	if data.Cost.Value == "" || data.Cost.Currency == "" {
-		return nil
+	cost, key := data.Total, "total"
+	if data.Cost != nil {
+		cost, key = *data.Cost, "cost"
 	}
 
-	// First get the currency unit
-	unit, err := currency.ParseISO(data.Cost.Currency)
+	// If we have no defined fare, that gives an empty amount
+	var err error
+	f.Total, f.value, err = parseCost(cost)
 	if err != nil {
-		return gen.err(err, "Total", "cost.currency", data.Cost.Currency, "error while retrieving currency unit via currency.ParseISO")
+		return gen.err(err, "Total", key, cost, "parseCost failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for a Fare.
+// The total is only written for fares decoded from the API, as the value of a currency.Amount can't be retrieved.
+func (f Fare) MarshalJSON() ([]byte, error) {
+	data := jsonFare{
+		Found: &f.Found,
+		Links: &f.Links,
+	}
+	if f.value != "" {
+		data.Total = jsonCost{Value: f.value, Currency: f.Total.Currency().String()}
+	}
+	return json.Marshal(data)
+}
+
+// TicketIDs returns the IDs of the tickets making up the fare, see Ticket.
+func (f Fare) TicketIDs() []ID {
+	return f.Links.idsOfType("ticket")
+}
+
+// A Ticket is a ticket to buy for a journey, such as a single ride or a pass.
+// The tickets of journeys are given along them, see the Fare of each journey for the ones it needs.
+type Ticket struct {
+	ID      ID
+	Name    string
+	Comment string
+
+	// SourceID is the ID of the ticket in the fare data of the region
+	SourceID string
+
+	// Found is false when the price of the ticket is unknown, in which case Cost is the zero value
+	Found bool
+	Cost  currency.Amount
+
+	// Links to the sections covered by the ticket, see Ticket.SectionIDs
+	Links Links
+
+	// value is the cost as given by the API, as currency.Amount doesn't give it back
+	value string
+}
+
+// jsonTicket define the JSON implementation of Ticket struct
+type jsonTicket struct {
+	ID       *ID     `json:"id"`
+	Name     *string `json:"name"`
+	Comment  *string `json:"comment"`
+	SourceID *string `json:"source_id"`
+	Found    *bool   `json:"found"`
+	Links    *Links  `json:"links"`
+
+	// Values to process
+	Cost jsonCost `json:"cost"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a Ticket
+func (t *Ticket) UnmarshalJSON(b []byte) error {
+	data := &jsonTicket{
+		ID:       &t.ID,
+		Name:     &t.Name,
+		Comment:  &t.Comment,
+		SourceID: &t.SourceID,
+		Found:    &t.Found,
+		Links:    &t.Links,
+	}
+
+	if err := json.Unmarshal(b, data); err != nil {
+		return errors.Wrap(err, "error while unmarshalling Ticket")
 	}
 
-	// Now let's create the correct amount
-	f.Total = unit.Amount(data.Cost.Value)
+	// Create the error generator
+	gen := unmarshalErrorMaker{"Ticket", b}
+
+	var err error
+	t.Cost, t.value, err = parseCost(data.Cost)
+	if err != nil {
+		return gen.err(err, "Cost", "cost", data.Cost, "parseCost failed")
+	}
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Ticket, see Fare.MarshalJSON
+func (t Ticket) MarshalJSON() ([]byte, error) {
+	data := jsonTicket{
+		ID:       &t.ID,
+		Name:     &t.Name,
+		Comment:  &t.Comment,
+		SourceID: &t.SourceID,
+		Found:    &t.Found,
+		Links:    &t.Links,
+	}
+	if t.value != "" {
+		data.Cost = jsonCost{Value: t.value, Currency: t.Cost.Currency().String()}
+	}
+	return json.Marshal(data)
+}
+
+// SectionIDs returns the IDs of the sections covered by the ticket.
+func (t Ticket) SectionIDs() []ID {
+	return t.Links.idsOfType("section")
+}
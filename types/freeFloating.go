@@ -0,0 +1,46 @@
+package types
+
+// FreeFloatingXXX are the known types of free-floating vehicles
+const (
+	FreeFloatingBike         = "BIKE"
+	FreeFloatingScooter      = "SCOOTER"
+	FreeFloatingMotorScooter = "MOTORSCOOTER"
+	FreeFloatingKickScooter  = "KICKSCOOTER"
+	FreeFloatingCar          = "CAR"
+	FreeFloatingUnknown      = "UNKNOWN"
+)
+
+// PropulsionXXX are the known propulsions of free-floating vehicles
+const (
+	PropulsionElectric         = "ELECTRIC"
+	PropulsionAssistedElectric = "ASSISTED_ELECTRIC"
+	PropulsionCombustion       = "COMBUSTION"
+	PropulsionHuman            = "HUMAN"
+)
+
+// A FreeFloating is a shared vehicle, such as a bike, a scooter or a car, that can be picked up where it is parked.
+// Named "free_floating" in the Navitia doc
+type FreeFloating struct {
+	ID       ID     `json:"id"`
+	PublicID string `json:"public_id"` // Identifier of the vehicle for the provider, such as the plate of a car
+
+	// Provider of the vehicle, such as "Lime"
+	ProviderName string `json:"provider_name"`
+
+	// Type of vehicle, such as FreeFloatingKickScooter
+	Type string `json:"type"`
+
+	// Propulsion of the vehicle, such as PropulsionElectric
+	Propulsion string `json:"propulsion"`
+
+	// Battery is the charge level of the vehicle in percents, nil if unknown or not applicable
+	Battery *int `json:"battery"`
+
+	// Deeplink to book the vehicle in the provider's app
+	Deeplink string `json:"deeplink"`
+
+	Coord Coordinates `json:"coord"`
+
+	// Distance to the requested coordinates, in meters
+	Distance float64 `json:"distance"`
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"time"
+
+	"github.com/twpayne/go-geom/encoding/geojson"
+)
+
+// GeoJSON returns the journey's path as a GeoJSON FeatureCollection, ready to be displayed on a map.
+//
+// There is one LineString feature per section with a path, see Section.GeoJSON for their properties.
+// Sections without a path, such as transfers and waiting sections, are left out.
+func (j Journey) GeoJSON() *geojson.FeatureCollection {
+	fc := &geojson.FeatureCollection{
+		Features: make([]*geojson.Feature, 0, len(j.Sections)),
+	}
+	for _, s := range j.Sections {
+		if f := s.feature(); f != nil {
+			fc.Features = append(fc.Features, f)
+		}
+	}
+	return fc
+}
+
+// GeoJSON returns the section's path as a GeoJSON FeatureCollection holding a single LineString feature.
+// If the section has no path, the collection is empty.
+//
+// The feature has the following properties, when known:
+//   - "type": the type of the section
+//   - "mode": the mode of transport, such as "walking" or the commercial mode of a public transport
+//   - "line_code": the code of the line taken
+//   - "color" & "text_color": the colours of the line, in the "#RRGGBB" form
+//   - "from" & "to": the names of the origin and destination
+//   - "duration": the duration of the section, in seconds
+func (s Section) GeoJSON() *geojson.FeatureCollection {
+	fc := &geojson.FeatureCollection{}
+	if f := s.feature(); f != nil {
+		fc.Features = []*geojson.Feature{f}
+	}
+	return fc
+}
+
+// feature returns the GeoJSON feature of a section, nil if it has no path
+func (s Section) feature() *geojson.Feature {
+	if s.Geo == nil {
+		return nil
+	}
+
+	props := map[string]interface{}{
+		"type":     string(s.Type),
+		"duration": int64(s.Duration / time.Second),
+	}
+	setProp := func(key string, value string) {
+		if value != "" {
+			props[key] = value
+		}
+	}
+
+	mode := s.Mode
+	if mode == "" {
+		mode = string(s.Display.CommercialMode)
+	}
+	setProp("mode", mode)
+	setProp("line_code", s.Display.Code)
+	if c := s.Display.Color; c != nil {
+		props["color"] = c.String()
+	}
+	if c := s.Display.TextColor; c != nil {
+		props["text_color"] = c.String()
+	}
+	setProp("from", s.From.Name)
+	setProp("to", s.To.Name)
+
+	return &geojson.Feature{
+		ID:         string(s.ID),
+		Geometry:   s.Geo,
+		Properties: props,
+	}
+}
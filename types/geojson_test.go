@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJourney_GeoJSON(t *testing.T) {
+	data := testData["journey"].correct["a0.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	var withPath int
+	for _, s := range j.Sections {
+		if s.Geo != nil {
+			withPath++
+		}
+	}
+
+	fc := j.GeoJSON()
+	if len(fc.Features) != withPath {
+		t.Fatalf("expected %d features, got %d", withPath, len(fc.Features))
+	}
+
+	b, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("error while marshalling the feature collection: %v", err)
+	}
+
+	var decoded struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Geometry struct {
+				Type string `json:"type"`
+			} `json:"geometry"`
+			Properties map[string]interface{} `json:"properties"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatalf("error while decoding the generated GeoJSON: %v", err)
+	}
+	if decoded.Type != "FeatureCollection" {
+		t.Errorf("unexpected type %q", decoded.Type)
+	}
+
+	var publicTransport bool
+	for i, f := range decoded.Features {
+		if f.Geometry.Type != "LineString" {
+			t.Errorf("feature %d: unexpected geometry %q", i, f.Geometry.Type)
+		}
+		if _, ok := f.Properties["duration"]; !ok {
+			t.Errorf("feature %d: no duration", i)
+		}
+		if f.Properties["type"] == string(SectionPublicTransport) {
+			publicTransport = true
+			if _, ok := f.Properties["line_code"]; !ok {
+				t.Errorf("feature %d: no line code for a public transport section", i)
+			}
+			if c, _ := f.Properties["color"].(string); len(c) != 7 || c[0] != '#' {
+				t.Errorf("feature %d: unexpected colour %q", i, c)
+			}
+		}
+	}
+	if !publicTransport {
+		t.Error("expected at least one public transport section")
+	}
+}
+
+func TestSection_GeoJSON_NoPath(t *testing.T) {
+	fc := Section{Type: SectionWaiting}.GeoJSON()
+	if len(fc.Features) != 0 {
+		t.Errorf("expected no feature for a section without a path, got %d", len(fc.Features))
+	}
+}
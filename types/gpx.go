@@ -0,0 +1,121 @@
+package types
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// gpxNamespace is the namespace of the GPX 1.1 schema
+const gpxNamespace = "http://www.topografix.com/GPX/1/1"
+
+// gpx is the root of a GPX 1.1 document, restricted to what is needed for a journey
+type gpx struct {
+	XMLName   xml.Name   `xml:"gpx"`
+	Namespace string     `xml:"xmlns,attr"`
+	Version   string     `xml:"version,attr"`
+	Creator   string     `xml:"creator,attr"`
+	Waypoints []gpxPoint `xml:"wpt"`
+	Track     gpxTrack   `xml:"trk"`
+}
+
+// gpxTrack is a GPX track, made of segments
+type gpxTrack struct {
+	Name     string       `xml:"name,omitempty"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+// gpxSegment is a GPX track segment
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+// gpxPoint is used for both waypoints and track points
+type gpxPoint struct {
+	Latitude  string `xml:"lat,attr"`
+	Longitude string `xml:"lon,attr"`
+	Time      string `xml:"time,omitempty"`
+	Name      string `xml:"name,omitempty"`
+}
+
+// gpxLocalTime is the format of the times which weren't localized, without offset as it is unknown
+const gpxLocalTime = "2006-01-02T15:04:05"
+
+// newGPXPoint creates a gpxPoint, the time being omitted when zero.
+// Times which were localized are given in UTC, others in local time without offset, see Journey.WriteGPX.
+func newGPXPoint(lon, lat float64, t time.Time, name string) gpxPoint {
+	p := gpxPoint{
+		Latitude:  strconv.FormatFloat(lat, 'f', -1, 64),
+		Longitude: strconv.FormatFloat(lon, 'f', -1, 64),
+		Name:      name,
+	}
+	switch {
+	case t.IsZero():
+	case localized(t):
+		p.Time = t.UTC().Format(time.RFC3339)
+	default:
+		p.Time = t.Format(gpxLocalTime)
+	}
+	return p
+}
+
+// WriteGPX writes the journey as a GPX 1.1 document to w, for use in outdoor & navigation applications.
+//
+// The journey is written as a single track, with a track segment per section with a path.
+// The first and last points of a segment are timed with the departure and arrival of the section.
+// Every stop point served along the public transport sections is added as a waypoint.
+//
+// Times are given in UTC if the journey was localized, such as with navitia.WithRegionTimezones.
+// Otherwise, as the offset of the region is unknown, they are given in local time without offset.
+func (j Journey) WriteGPX(w io.Writer) error {
+	doc := gpx{
+		Namespace: gpxNamespace,
+		Version:   "1.1",
+		Creator:   "github.com/govitia/navitia",
+	}
+	if j.From.Name != "" && j.To.Name != "" {
+		doc.Track.Name = j.From.Name + " - " + j.To.Name
+	}
+
+	for _, s := range j.Sections {
+		for _, st := range s.StopTimes {
+			// The departure is the relevant time, except at the last stop
+			t := st.PTDateTime.Departure
+			if t.IsZero() {
+				t = st.PTDateTime.Arrival
+			}
+			c := st.StopPoint.Coord
+			doc.Waypoints = append(doc.Waypoints, newGPXPoint(c.Longitude, c.Latitude, t, st.StopPoint.Name))
+		}
+
+		if s.Geo == nil || s.Geo.NumCoords() == 0 {
+			continue
+		}
+		n := s.Geo.NumCoords()
+		seg := gpxSegment{Points: make([]gpxPoint, n)}
+		for i := 0; i < n; i++ {
+			var t time.Time
+			switch i {
+			case 0:
+				t = s.Departure
+			case n - 1:
+				t = s.Arrival
+			}
+			c := s.Geo.Coord(i)
+			seg.Points[i] = newGPXPoint(c.X(), c.Y(), t, "")
+		}
+		doc.Track.Segments = append(doc.Track.Segments, seg)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return fmt.Errorf("error while writing GPX header: %w", err)
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("error while encoding journey as GPX: %w", err)
+	}
+	return nil
+}
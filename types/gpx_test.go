@@ -0,0 +1,96 @@
+package types
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+)
+
+func TestJourney_WriteGPX(t *testing.T) {
+	data := testData["journey"].correct["a0.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	var (
+		withPath  int
+		stopTimes int
+	)
+	for _, s := range j.Sections {
+		if s.Geo != nil {
+			withPath++
+		}
+		stopTimes += len(s.StopTimes)
+	}
+
+	var buf bytes.Buffer
+	if err := j.WriteGPX(&buf); err != nil {
+		t.Fatalf("error while writing GPX: %v", err)
+	}
+
+	var doc gpx
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("error while decoding the generated GPX: %v\n%s", err, buf.Bytes())
+	}
+	if doc.Version != "1.1" {
+		t.Errorf("unexpected version %q", doc.Version)
+	}
+	if len(doc.Track.Segments) != withPath {
+		t.Errorf("expected %d track segments, got %d", withPath, len(doc.Track.Segments))
+	}
+	if len(doc.Waypoints) != stopTimes {
+		t.Errorf("expected %d waypoints, got %d", stopTimes, len(doc.Waypoints))
+	}
+	for i, seg := range doc.Track.Segments {
+		if len(seg.Points) == 0 || seg.Points[0].Time == "" {
+			t.Errorf("segment %d: expected a timed first point", i)
+		}
+	}
+}
+
+// TestJourney_WriteGPX_Timezones checks that the times of a journey which wasn't localized are given without offset,
+// and that those of a localized one are in UTC
+func TestJourney_WriteGPX_Timezones(t *testing.T) {
+	data := testData["journey"].correct["a0.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	first := j.Sections[0].Departure
+
+	firstTime := func() string {
+		var buf bytes.Buffer
+		if err := j.WriteGPX(&buf); err != nil {
+			t.Fatalf("error while writing GPX: %v", err)
+		}
+		var doc gpx
+		if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+			t.Fatalf("error while decoding the generated GPX: %v", err)
+		}
+		return doc.Track.Segments[0].Points[0].Time
+	}
+
+	if got, want := firstTime(), first.Format("2006-01-02T15:04:05"); got != want {
+		t.Errorf("unexpected time of a journey which wasn't localized: got %q, want %q", got, want)
+	}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	Localize(j, paris)
+	wallClock := time.Date(first.Year(), first.Month(), first.Day(), first.Hour(), first.Minute(), first.Second(), 0, paris)
+	if got, want := firstTime(), wallClock.UTC().Format(time.RFC3339); got != want {
+		t.Errorf("unexpected time of a localized journey: got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// icalDateTime is the format of UTC date-times in iCalendar, icalLocalDateTime the one of floating local date-times
+const (
+	icalDateTime      = "20060102T150405Z"
+	icalLocalDateTime = "20060102T150405"
+)
+
+// icalTime formats the date-time of an event: as a UTC date-time if it was localized,
+// otherwise as a floating local date-time, as its offset is unknown
+func icalTime(t time.Time) string {
+	if !localized(t) {
+		return t.Format(icalLocalDateTime)
+	}
+	return t.UTC().Format(icalDateTime)
+}
+
+// icalEscaper escapes text values as required by RFC 5545
+var icalEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\n", `\n`)
+
+// icalWriter writes iCalendar content lines
+type icalWriter struct {
+	strings.Builder
+}
+
+// line writes a content line, folding it at 75 octets as required by RFC 5545
+func (w *icalWriter) line(name, value string) {
+	l := name + ":" + value
+	// Continuation lines start with a space, which counts in their length
+	for limit := 75; len(l) > limit; limit = 74 {
+		// Don't cut a multi-byte character in half
+		cut := limit
+		for cut > 0 && l[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		w.WriteString(l[:cut])
+		w.WriteString("\r\n ")
+		l = l[cut:]
+	}
+	w.WriteString(l)
+	w.WriteString("\r\n")
+}
+
+// text writes a content line with a text value, escaping it
+func (w *icalWriter) text(name, value string) {
+	if value != "" {
+		w.line(name, icalEscaper.Replace(value))
+	}
+}
+
+// ICal returns the journey as an iCalendar (RFC 5545) document, with an event per section.
+//
+// Waiting sections and sections without a departure or arrival are left out.
+// As the document is derived from the journey, its events are stamped with the journey's requested date time rather than the current time,
+// so that the same journey always gives the same document.
+//
+// The events are given in UTC if the journey was localized, such as with navitia.WithRegionTimezones.
+// Otherwise, as the offset of the region is unknown, they are given in floating local time, that is in the local time of the calendar
+// they are imported in. The stamps are always in UTC, as required by RFC 5545.
+func (j Journey) ICal() string {
+	stamp := j.Requested
+	if stamp.IsZero() {
+		stamp = j.Departure
+	}
+
+	w := &icalWriter{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", "-//govitia//navitia//EN")
+	for i, s := range j.Sections {
+		if s.Type == SectionWaiting || s.Departure.IsZero() || s.Arrival.IsZero() {
+			continue
+		}
+
+		uid := string(s.ID)
+		if uid == "" {
+			uid = j.Departure.UTC().Format(icalDateTime) + "-" + strconv.Itoa(i)
+		}
+
+		w.line("BEGIN", "VEVENT")
+		w.text("UID", uid+"@navitia")
+		w.line("DTSTAMP", stamp.UTC().Format(icalDateTime))
+		w.line("DTSTART", icalTime(s.Departure))
+		w.line("DTEND", icalTime(s.Arrival))
+		w.text("SUMMARY", s.icalSummary())
+		w.text("LOCATION", s.From.Name)
+		if s.From.Name != "" && s.To.Name != "" {
+			w.text("DESCRIPTION", s.From.Name+" → "+s.To.Name)
+		}
+		w.line("END", "VEVENT")
+	}
+	w.line("END", "VCALENDAR")
+
+	return w.String()
+}
+
+// icalSummary returns the summary of a section's event, such as "Métro 14 → Olympiades" or "walking → Gare de Lyon"
+func (s Section) icalSummary() string {
+	what := s.mode()
+	if s.Display.Code != "" {
+		what += " " + s.Display.Code
+	}
+
+	where := s.To.Name
+	if s.Display.Direction != "" {
+		where = s.Display.Direction
+	}
+	if where == "" {
+		return what
+	}
+	return what + " → " + where
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJourney_ICal(t *testing.T) {
+	data := testData["journey"].correct["a0.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	var expected int
+	for _, s := range j.Sections {
+		if s.Type != SectionWaiting && !s.Departure.IsZero() && !s.Arrival.IsZero() {
+			expected++
+		}
+	}
+
+	cal := j.ICal()
+	if !strings.HasPrefix(cal, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(cal, "END:VCALENDAR\r\n") {
+		t.Errorf("malformed calendar:\n%s", cal)
+	}
+	if n := strings.Count(cal, "BEGIN:VEVENT\r\n"); n != expected {
+		t.Errorf("expected %d events, got %d", expected, n)
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(cal, "\r\n"), "\r\n") {
+		if len(l) > 75 {
+			t.Errorf("line longer than 75 octets: %q", l)
+		}
+	}
+
+	if cal != j.ICal() {
+		t.Error("ICal isn't deterministic")
+	}
+}
+
+// TestJourney_ICal_Timezones checks that the events of a journey which wasn't localized are in floating local time,
+// and that those of a localized one are in UTC
+func TestJourney_ICal_Timezones(t *testing.T) {
+	data := testData["journey"].correct["a0.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	first := j.Sections[0].Departure
+
+	if want := "DTSTART:" + first.Format("20060102T150405") + "\r\n"; !strings.Contains(j.ICal(), want) {
+		t.Errorf("expected the floating local time %q in:\n%s", want, j.ICal())
+	}
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	Localize(j, paris)
+	wallClock := time.Date(first.Year(), first.Month(), first.Day(), first.Hour(), first.Minute(), first.Second(), 0, paris)
+	if want := "DTSTART:" + wallClock.UTC().Format("20060102T150405Z") + "\r\n"; !strings.Contains(j.ICal(), want) {
+		t.Errorf("expected the UTC time %q in:\n%s", want, j.ICal())
+	}
+}
+
+func TestICalWriter_Line(t *testing.T) {
+	w := &icalWriter{}
+	w.text("DESCRIPTION", strings.Repeat("é", 60)+"; a, b")
+
+	got := w.String()
+	if !strings.Contains(got, `\; a\, b`) {
+		t.Errorf("expected the value to be escaped, got %q", got)
+	}
+
+	// Unfolding should give back the original line
+	unfolded := strings.ReplaceAll(got, "\r\n ", "")
+	if expected := "DESCRIPTION:" + strings.Repeat("é", 60) + `\; a\, b` + "\r\n"; unfolded != expected {
+		t.Errorf("unexpected unfolded line %q", unfolded)
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n") {
+		if len(l) > 75 {
+			t.Errorf("line longer than 75 octets: %q", l)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -17,34 +19,166 @@ func (id ID) Check() error {
 	return nil
 }
 
-// typeNames stores navitia-side name of types that may appear in IDs
+// IDTypeXXX are the types of objects recognised in IDs, see ParseID
+const (
+	IDTypeNetwork        = "network"
+	IDTypeLine           = "line"
+	IDTypeRoute          = "route"
+	IDTypeStopArea       = "stop_area"
+	IDTypeStopPoint      = "stop_point"
+	IDTypeCommercialMode = "commercial_mode"
+	IDTypePhysicalMode   = "physical_mode"
+	IDTypeCompany        = "company"
+	IDTypeAdmin          = "admin"
+	IDTypePOI            = "poi"
+	IDTypePOIType        = "poi_type"
+	IDTypeAddress        = "address"
+	IDTypeCoord          = "coord"
+	IDTypeTrip           = "trip"
+	IDTypeVehicleJourney = "vehicle_journey"
+	IDTypeDisruption     = "disruption"
+)
+
+// typeNames stores navitia-side name of types that may prefix IDs, such as "stop_area" in "stop_area:RAT:SA:NATIO"
 var typeNames = map[string]bool{
-	"network":         true,
-	"line":            true,
-	"route":           true,
-	"stop_area":       true,
-	"commercial_mode": true,
-	"physical_mode":   true,
-	"company":         true,
-	"admin":           true,
-	"stop_point":      true,
-}
-
-// Type gets the type of object this ID refers to.
+	IDTypeNetwork:        true,
+	IDTypeLine:           true,
+	IDTypeRoute:          true,
+	IDTypeStopArea:       true,
+	IDTypeStopPoint:      true,
+	IDTypeCommercialMode: true,
+	IDTypePhysicalMode:   true,
+	IDTypeCompany:        true,
+	IDTypeAdmin:          true,
+	IDTypePOI:            true,
+	IDTypePOIType:        true,
+	IDTypeAddress:        true,
+	IDTypeCoord:          true,
+	IDTypeTrip:           true,
+	IDTypeVehicleJourney: true,
+	IDTypeDisruption:     true,
+}
+
+// uuidPattern matches the IDs of disruptions, which aren't prefixed but are UUIDs
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ParseID breaks an ID down into the type of the object it refers to, such as "stop_area" (see the IDTypeXXX constants),
+// and the components following it, such as ["RAT", "SA", "NATIO"] for "stop_area:RAT:SA:NATIO".
 //
-// Possible types: network, line, route, stop_area, commercial_mode, physical_mode, company, admin, stop_point.
+// IDs are parsed as follows:
+// 	- "lon;lat" IDs, given to addresses and used for coordinates in requests, are of type coord, with the longitude & latitude as components
+// 	- UUIDs are of type disruption, with the UUID as sole component
+// 	- Other IDs are prefixed by their type, followed by components separated by ":"
+//
+// Trip IDs aren't prefixed by the API, so only the ones starting with "trip:" are recognised.
+// An error is returned if the type can't be found.
+func ParseID(id ID) (typ string, components []string, err error) {
+	if err := id.Check(); err != nil {
+		return "", nil, err
+	}
+	str := string(id)
+
+	if parts := strings.Split(str, ";"); len(parts) == 2 {
+		for _, p := range parts {
+			if _, err := strconv.ParseFloat(p, 64); err != nil {
+				return "", nil, errors.Wrapf(err, "ParseID: invalid coordinates in %q", id)
+			}
+		}
+		return IDTypeCoord, parts, nil
+	}
+
+	if uuidPattern.MatchString(str) {
+		return IDTypeDisruption, []string{str}, nil
+	}
+
+	parts := strings.Split(str, ":")
+	if len(parts) < 2 || !typeNames[parts[0]] {
+		return "", nil, errors.Errorf("ParseID: can't find the type of object %q refers to", id)
+	}
+	for _, p := range parts[1:] {
+		if p == "" {
+			return "", nil, errors.Errorf("ParseID: empty component in %q", id)
+		}
+	}
+	return parts[0], parts[1:], nil
+}
+
+// Type gets the type of object this ID refers to, such as "stop_area", see ParseID.
 //
-// This is just guessing, if no type is found, type returns an empty string.
+// If no type is found, type returns an empty string.
 func (id ID) Type() string {
-	splitted := strings.Split(string(id), ":")
-	if len(splitted) == 0 {
+	typ, _, err := ParseID(id)
+	if err != nil {
 		return ""
 	}
+	return typ
+}
 
-	possible := splitted[0]
-	if typeNames[possible] {
-		return possible
+// newID creates an ID of the given type, unless the given value already is one
+func newID(typ string, value string) ID {
+	if strings.HasPrefix(value, typ+":") {
+		return ID(value)
 	}
+	return ID(typ + ":" + value)
+}
+
+// NetworkID returns the ID of a network given its value, such as "RAT" for "network:RAT".
+// Values already prefixed by the type are kept as is, as are the other XxxID constructors'.
+func NetworkID(value string) ID {
+	return newID(IDTypeNetwork, value)
+}
+
+// LineID returns the ID of a line given its value, such as "RAT:M1" for "line:RAT:M1".
+func LineID(value string) ID {
+	return newID(IDTypeLine, value)
+}
+
+// RouteID returns the ID of a route given its value, such as "RAT:M1:1" for "route:RAT:M1:1".
+func RouteID(value string) ID {
+	return newID(IDTypeRoute, value)
+}
+
+// StopAreaID returns the ID of a stop area given its value, such as "SA:8775860" for "stop_area:SA:8775860".
+func StopAreaID(value string) ID {
+	return newID(IDTypeStopArea, value)
+}
+
+// StopPointID returns the ID of a stop point given its value, such as "RAT:SP:NATIO2" for "stop_point:RAT:SP:NATIO2".
+func StopPointID(value string) ID {
+	return newID(IDTypeStopPoint, value)
+}
+
+// CommercialModeID returns the ID of a commercial mode given its value, such as "Metro" for "commercial_mode:Metro".
+func CommercialModeID(value string) ID {
+	return newID(IDTypeCommercialMode, value)
+}
+
+// PhysicalModeID returns the ID of a physical mode given its value, such as "Bus" for "physical_mode:Bus".
+func PhysicalModeID(value string) ID {
+	return newID(IDTypePhysicalMode, value)
+}
+
+// CompanyID returns the ID of a company given its value, such as "RAT:1" for "company:RAT:1".
+func CompanyID(value string) ID {
+	return newID(IDTypeCompany, value)
+}
+
+// AdminID returns the ID of an administrative region given its value, such as "fr:75056" for "admin:fr:75056".
+func AdminID(value string) ID {
+	return newID(IDTypeAdmin, value)
+}
+
+// POIID returns the ID of a point of interest given its value, such as "osm:way:85413372" for "poi:osm:way:85413372".
+func POIID(value string) ID {
+	return newID(IDTypePOI, value)
+}
+
+// VehicleJourneyID returns the ID of a vehicle journey given its value, such as "RAT:RATRM1" for "vehicle_journey:RAT:RATRM1".
+func VehicleJourneyID(value string) ID {
+	return newID(IDTypeVehicleJourney, value)
+}
 
-	return ""
+// CoordID returns the "lon;lat" ID of a position, as used to give coordinates in requests, see Coordinates.String.
+func CoordID(lon, lat float64) ID {
+	return ID(Coordinates{Latitude: lat, Longitude: lon}.String())
 }
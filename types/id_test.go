@@ -1,6 +1,9 @@
 package types
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 // TestIDCheck checks if ID.Check returns an error when given an empty ID
 func TestIDCheck(t *testing.T) {
@@ -9,3 +12,58 @@ func TestIDCheck(t *testing.T) {
 		t.Errorf("Received no error even though we expect one")
 	}
 }
+
+// TestParseID checks that IDs of each kind are broken down into their type & components
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		id         ID
+		typ        string
+		components []string
+	}{
+		{"stop_area:RAT:SA:NATIO", IDTypeStopArea, []string{"RAT", "SA", "NATIO"}},
+		{"poi:osm:way:85413372", IDTypePOI, []string{"osm", "way", "85413372"}},
+		{"admin:fr:75056", IDTypeAdmin, []string{"fr", "75056"}},
+		{"vehicle_journey:RAT:RATRM14REGA9128-1_dst_2", IDTypeVehicleJourney, []string{"RAT", "RATRM14REGA9128-1_dst_2"}},
+		{"2.2922926;48.8583736", IDTypeCoord, []string{"2.2922926", "48.8583736"}},
+		{"coord:2.292:48.858", IDTypeCoord, []string{"2.292", "48.858"}},
+		{"d7cc9b64-6c8c-11e5-b6d9-005056a40962", IDTypeDisruption, []string{"d7cc9b64-6c8c-11e5-b6d9-005056a40962"}},
+		{"trip:RATRM1REGA4213", IDTypeTrip, []string{"RATRM1REGA4213"}},
+	}
+	for _, test := range tests {
+		typ, components, err := ParseID(test.id)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.id, err)
+			continue
+		}
+		if typ != test.typ || !reflect.DeepEqual(components, test.components) {
+			t.Errorf("%s: got %s %q, expected %s %q", test.id, typ, components, test.typ, test.components)
+		}
+		if test.id.Type() != test.typ {
+			t.Errorf("%s: unexpected type %q", test.id, test.id.Type())
+		}
+	}
+
+	for _, id := range []ID{"", "RATRM1REGA4213", "unknown:1", "line:", "2.29;north"} {
+		if _, _, err := ParseID(id); err == nil {
+			t.Errorf("%q: expected an error", id)
+		}
+	}
+}
+
+// TestIDConstructors checks that IDs are built with their type, without prefixing it twice
+func TestIDConstructors(t *testing.T) {
+	tests := []struct {
+		got, expected ID
+	}{
+		{StopAreaID("SA:8775860"), "stop_area:SA:8775860"},
+		{StopAreaID("stop_area:SA:8775860"), "stop_area:SA:8775860"},
+		{LineID("RAT:M1"), "line:RAT:M1"},
+		{VehicleJourneyID("RAT:RATRM1"), "vehicle_journey:RAT:RATRM1"},
+		{CoordID(2.377, 48.847), "2.377;48.847"},
+	}
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("got %q, expected %q", test.got, test.expected)
+		}
+	}
+}
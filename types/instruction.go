@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+)
+
+// An Instruction is a turn-by-turn step of a street network section, see Section.Instructions
+type Instruction struct {
+	// Text is the instruction to display, such as "Turn left on Rue de Rivoli"
+	Text string
+
+	Turn   Turn
+	Street string
+
+	// Length & Duration of the step
+	Length   uint
+	Duration time.Duration
+
+	// Start is where the instruction applies, it is the zero value if unknown
+	Start Coordinates
+
+	// CyclePath is the kind of cycle lane along the step
+	CyclePath CyclePathType
+}
+
+// turnTexts holds the English wording of each manoeuvre, see Section.Instructions
+var turnTexts = map[Turn]string{
+	TurnStraight:    "Continue",
+	TurnSlightLeft:  "Bear left",
+	TurnLeft:        "Turn left",
+	TurnSharpLeft:   "Turn sharp left",
+	TurnSlightRight: "Bear right",
+	TurnRight:       "Turn right",
+	TurnSharpRight:  "Turn sharp right",
+	TurnUTurn:       "Make a U-turn",
+}
+
+// Instructions returns the turn-by-turn steps of a street network section, one per segment of its path.
+//
+// The instructions given by the API are used when available.
+// Otherwise they are written in English from the direction & name of the segments, such as "Turn left on Rue de Rivoli",
+// the first one being "Head on ..." as there's no previous segment to turn from.
+//
+// Sections without a path, such as public transport ones, have no instructions.
+func (s Section) Instructions() []Instruction {
+	if len(s.Path) == 0 {
+		return nil
+	}
+
+	instructions := make([]Instruction, len(s.Path))
+	for i, ps := range s.Path {
+		turn := ps.Turn()
+		if i == 0 {
+			turn = TurnStraight
+		}
+
+		text := ps.Instruction
+		if text == "" {
+			verb := turnTexts[turn]
+			if i == 0 {
+				verb = "Head"
+			}
+			text = verb
+			if ps.Name != "" {
+				text += " on " + ps.Name
+			}
+		}
+
+		instructions[i] = Instruction{
+			Text:      text,
+			Turn:      turn,
+			Street:    ps.Name,
+			Length:    ps.Length,
+			Duration:  ps.Duration,
+			Start:     ps.InstructionStart,
+			CyclePath: ps.CyclePath,
+		}
+	}
+	return instructions
+}
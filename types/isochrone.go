@@ -1,6 +1,14 @@
 package types
 
-import "github.com/paulmach/go.geojson"
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/paulmach/go.geojson"
+	"github.com/pkg/errors"
+	"github.com/twpayne/go-geom"
+)
 
 // An Isochrone is sent back by the /isochrones service, it gives you a multi-polygon geojson response which represent a same time travel zone.
 //
@@ -8,3 +16,385 @@ import "github.com/paulmach/go.geojson"
 //
 // See http://doc.navitia.io/#isochrones-currently-in-beta
 type Isochrone geojson.Geometry
+
+// An IsochroneZone is a zone reachable within a duration range, as returned by the /isochrones service.
+type IsochroneZone struct {
+	// The zone itself
+	Geo Isochrone
+
+	// The zone is reachable in more than MinDuration and less than MaxDuration
+	MinDuration time.Duration
+	MaxDuration time.Duration
+
+	// Starting point (or destination) of the isochrone
+	From Container
+	To   Container
+
+	// Requested date time
+	Requested time.Time
+}
+
+// jsonIsochroneZone define the JSON implementation of IsochroneZone struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonIsochroneZone struct {
+	Geo  *geojson.Geometry `json:"geojson"`
+	From *Container        `json:"from"`
+	To   *Container        `json:"to"`
+
+	// Values to process
+	MinDuration int64  `json:"min_duration"`
+	MaxDuration int64  `json:"max_duration"`
+	Requested   string `json:"requested_date_time"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for an IsochroneZone
+func (iz *IsochroneZone) UnmarshalJSON(b []byte) error {
+	data := &jsonIsochroneZone{
+		Geo:  (*geojson.Geometry)(&iz.Geo),
+		From: &iz.From,
+		To:   &iz.To,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling IsochroneZone: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"IsochroneZone", b}
+
+	// The durations are given in seconds
+	iz.MinDuration = time.Duration(data.MinDuration) * time.Second
+	iz.MaxDuration = time.Duration(data.MaxDuration) * time.Second
+
+	iz.Requested, err = parseDateTime(data.Requested)
+	if err != nil {
+		return gen.err(err, "Requested", "requested_date_time", data.Requested, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for an IsochroneZone
+func (iz IsochroneZone) MarshalJSON() ([]byte, error) {
+	data := jsonIsochroneZone{
+		From:        &iz.From,
+		To:          &iz.To,
+		MinDuration: int64(iz.MinDuration / time.Second),
+		MaxDuration: int64(iz.MaxDuration / time.Second),
+		Requested:   formatDateTime(iz.Requested),
+	}
+	if iz.Geo.Type != "" {
+		data.Geo = (*geojson.Geometry)(&iz.Geo)
+	}
+	return json.Marshal(data)
+}
+
+// Geom returns the isochrone as a go-geom MultiPolygon, for rendering or further processing.
+//
+// A Polygon isochrone is returned as a MultiPolygon holding a single polygon. Any other geometry type is an error.
+func (iso Isochrone) Geom() (*geom.MultiPolygon, error) {
+	var polygons [][][][]float64
+	switch iso.Type {
+	case geojson.GeometryPolygon:
+		polygons = [][][][]float64{iso.Polygon}
+	case geojson.GeometryMultiPolygon:
+		polygons = iso.MultiPolygon
+	default:
+		return nil, errors.Errorf("can't convert an isochrone of type %s to a MultiPolygon", iso.Type)
+	}
+
+	coords := make([][][]geom.Coord, len(polygons))
+	for i, polygon := range polygons {
+		coords[i] = make([][]geom.Coord, len(polygon))
+		for j, ring := range polygon {
+			coords[i][j] = make([]geom.Coord, len(ring))
+			for k, pos := range ring {
+				if len(pos) < 2 {
+					return nil, errors.Errorf("invalid position %v in polygon %d, ring %d", pos, i, j)
+				}
+				coords[i][j][k] = geom.Coord{pos[0], pos[1]}
+			}
+		}
+	}
+
+	return geom.NewMultiPolygon(geom.XY).SetCoords(coords)
+}
+
+// Clip restricts the isochrone to the given boundary, dropping the parts lying outside of it.
+//
+// Clipping is done client-side with the Sutherland–Hodgman algorithm, which needs a convex boundary (such as a rectangular study area):
+// a concave one, such as the limits of a city, is first split into convex parts, each of which the isochrone is clipped to.
+// Only the exterior ring of the boundary is taken into account. The isochrone must be a Polygon or a MultiPolygon:
+// a Polygon split into several parts becomes a MultiPolygon.
+func (iso *Isochrone) Clip(boundary *geom.Polygon) error {
+	parts, err := convexParts(boundary)
+	if err != nil {
+		return errors.Wrap(err, "invalid boundary")
+	}
+
+	switch iso.Type {
+	case geojson.GeometryPolygon:
+		switch polygons := clipPolygons([][][][]float64{iso.Polygon}, parts); len(polygons) {
+		case 0:
+			iso.Polygon = nil
+		case 1:
+			iso.Polygon = polygons[0]
+		default:
+			iso.Type, iso.Polygon, iso.MultiPolygon = geojson.GeometryMultiPolygon, nil, polygons
+		}
+	case geojson.GeometryMultiPolygon:
+		iso.MultiPolygon = clipPolygons(iso.MultiPolygon, parts)
+	default:
+		return errors.Errorf("can't clip an isochrone of type %s", iso.Type)
+	}
+
+	return nil
+}
+
+// clipPolygons clips each geojson polygon to each convex part of the boundary, dropping the empty ones
+func clipPolygons(polygons [][][][]float64, parts [][]geom.Coord) [][][][]float64 {
+	var clipped [][][][]float64
+	for _, p := range polygons {
+		for _, part := range parts {
+			if c := clipPolygon(p, part); len(c) != 0 {
+				clipped = append(clipped, c)
+			}
+		}
+	}
+	return clipped
+}
+
+// exteriorRing returns the exterior ring of the polygon, open (without the closing point) and counter-clockwise
+func exteriorRing(p *geom.Polygon) ([]geom.Coord, error) {
+	if p == nil || p.NumLinearRings() == 0 {
+		return nil, errors.New("empty polygon")
+	}
+	coords := p.LinearRing(0).Coords()
+	if n := len(coords); n > 1 && coords[0].Equal(p.Layout(), coords[n-1]) {
+		coords = coords[:n-1]
+	}
+	if len(coords) < 3 {
+		return nil, errors.Errorf("polygon has %d vertices, at least 3 are needed", len(coords))
+	}
+
+	if selfIntersecting(coords) {
+		return nil, errors.New("polygon intersects itself")
+	}
+
+	// Make it counter-clockwise
+	var area float64
+	for i := range coords {
+		a, b := coords[i], coords[(i+1)%len(coords)]
+		area += a.X()*b.Y() - b.X()*a.Y()
+	}
+	if area < 0 {
+		reversed := make([]geom.Coord, len(coords))
+		for i := range coords {
+			reversed[len(coords)-1-i] = coords[i]
+		}
+		coords = reversed
+	}
+
+	return coords, nil
+}
+
+// selfIntersecting reports whether two edges of the open ring cross each other
+func selfIntersecting(ring []geom.Coord) bool {
+	point := func(c geom.Coord) []float64 { return []float64{c.X(), c.Y()} }
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+		// Adjacent edges share a vertex, so only the following non-adjacent ones are checked
+		for j := i + 2; j < len(ring) && (i != 0 || j != len(ring)-1); j++ {
+			c, d := ring[j], ring[(j+1)%len(ring)]
+			if side(a, b, point(c))*side(a, b, point(d)) < 0 && side(c, d, point(a))*side(c, d, point(b)) < 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// convex reports whether the open counter-clockwise ring is convex: every turn must be to the left, or straight
+func convex(ring []geom.Coord) bool {
+	for i := range ring {
+		a, b, c := ring[i], ring[(i+1)%len(ring)], ring[(i+2)%len(ring)]
+		if side(a, b, []float64{c.X(), c.Y()}) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// convexParts splits the exterior ring of the polygon into convex open counter-clockwise rings covering it.
+//
+// A convex polygon is kept whole. Otherwise, it is triangulated by ear clipping, and adjacent triangles are merged
+// as long as they stay convex, as in the Hertel–Mehlhorn algorithm, so that the isochrone is cut in as few parts as possible.
+func convexParts(p *geom.Polygon) ([][]geom.Coord, error) {
+	ring, err := exteriorRing(p)
+	if err != nil {
+		return nil, err
+	}
+	if convex(ring) {
+		return [][]geom.Coord{ring}, nil
+	}
+
+	parts, err := triangulate(ring)
+	if err != nil {
+		return nil, err
+	}
+
+	// Merge the adjacent parts until none can be
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(parts) && !merged; i++ {
+			for j := i + 1; j < len(parts) && !merged; j++ {
+				if m, ok := mergeConvex(parts[i], parts[j]); ok {
+					parts[i] = m
+					parts = append(parts[:j], parts[j+1:]...)
+					merged = true
+				}
+			}
+		}
+	}
+
+	return parts, nil
+}
+
+// triangulate splits a simple open counter-clockwise ring into triangles by ear clipping.
+// It returns an error if the ring can't be, such as if it is degenerate.
+func triangulate(ring []geom.Coord) ([][]geom.Coord, error) {
+	remaining := append([]geom.Coord(nil), ring...)
+	var triangles [][]geom.Coord
+
+	for len(remaining) > 3 {
+		clipped := false
+		for i := range remaining {
+			a, b, c := remaining[(i+len(remaining)-1)%len(remaining)], remaining[i], remaining[(i+1)%len(remaining)]
+			s := side(a, c, []float64{b.X(), b.Y()})
+			if s > 0 || s < 0 && containsVertex(a, b, c, remaining) {
+				// b is a reflex vertex, or cutting its ear would cut through the ring
+				continue
+			}
+			if s < 0 {
+				triangles = append(triangles, []geom.Coord{a, b, c})
+			}
+			// A vertex lying on the line between its neighbours is dropped without making a triangle
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			clipped = true
+			break
+		}
+		if !clipped {
+			return nil, errors.New("polygon can't be triangulated")
+		}
+	}
+
+	if a, b, c := remaining[0], remaining[1], remaining[2]; side(a, b, []float64{c.X(), c.Y()}) != 0 {
+		triangles = append(triangles, remaining)
+	}
+	return triangles, nil
+}
+
+// containsVertex reports whether a vertex of the ring, other than a, b & c, lies in the counter-clockwise triangle (a, b, c) or on its edges
+func containsVertex(a, b, c geom.Coord, ring []geom.Coord) bool {
+	for _, v := range ring {
+		if v.Equal(geom.XY, a) || v.Equal(geom.XY, b) || v.Equal(geom.XY, c) {
+			continue
+		}
+		p := []float64{v.X(), v.Y()}
+		if side(a, b, p) >= 0 && side(b, c, p) >= 0 && side(c, a, p) >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeConvex merges two open counter-clockwise rings sharing an edge, if their union is convex
+func mergeConvex(a, b []geom.Coord) ([]geom.Coord, bool) {
+	for i := range a {
+		for j := range b {
+			// The shared edge goes from a[i] to a[i+1] in a, and the other way around in b
+			if !a[i].Equal(geom.XY, b[(j+1)%len(b)]) || !a[(i+1)%len(a)].Equal(geom.XY, b[j]) {
+				continue
+			}
+
+			merged := make([]geom.Coord, 0, len(a)+len(b)-2)
+			for k := 1; k <= len(a); k++ {
+				merged = append(merged, a[(i+k)%len(a)])
+			}
+			for k := 2; k < len(b); k++ {
+				merged = append(merged, b[(j+k)%len(b)])
+			}
+			return merged, convex(merged)
+		}
+	}
+	return nil, false
+}
+
+// side returns a positive value if p is to the left of the line going from a to b, negative if it is to the right, zero if on it
+func side(a, b geom.Coord, p []float64) float64 {
+	return (b.X()-a.X())*(p[1]-a.Y()) - (b.Y()-a.Y())*(p[0]-a.X())
+}
+
+// intersection returns the intersection of the segment [p, q] with the line going through a & b
+func intersection(p, q []float64, a, b geom.Coord) []float64 {
+	sp, sq := side(a, b, p), side(a, b, q)
+	t := sp / (sp - sq)
+	return []float64{p[0] + t*(q[0]-p[0]), p[1] + t*(q[1]-p[1])}
+}
+
+// clipPolygon clips each ring of a geojson polygon, dropping the rings that vanish.
+// If the exterior ring vanishes, the polygon is empty.
+func clipPolygon(polygon [][][]float64, clip []geom.Coord) [][][]float64 {
+	var clipped [][][]float64
+	for i, ring := range polygon {
+		r := clipRing(ring, clip)
+		if len(r) == 0 {
+			if i == 0 {
+				return nil
+			}
+			continue
+		}
+		clipped = append(clipped, r)
+	}
+	return clipped
+}
+
+// clipRing clips a closed geojson ring to a convex counter-clockwise ring, using the Sutherland–Hodgman algorithm.
+// It returns a closed ring, or nil if nothing remains.
+func clipRing(ring [][]float64, clip []geom.Coord) [][]float64 {
+	out := ring
+	if n := len(out); n > 1 && out[0][0] == out[n-1][0] && out[0][1] == out[n-1][1] {
+		out = out[:n-1]
+	}
+
+	for i := range clip {
+		if len(out) == 0 {
+			break
+		}
+		a, b := clip[i], clip[(i+1)%len(clip)]
+		in := out
+		out = nil
+
+		prev := in[len(in)-1]
+		for _, cur := range in {
+			curIn, prevIn := side(a, b, cur) >= 0, side(a, b, prev) >= 0
+			switch {
+			case curIn && !prevIn:
+				out = append(out, intersection(prev, cur, a, b), cur)
+			case curIn:
+				out = append(out, cur)
+			case prevIn:
+				out = append(out, intersection(prev, cur, a, b))
+			}
+			prev = cur
+		}
+	}
+
+	if len(out) < 3 {
+		return nil
+	}
+	return append(out, out[0])
+}
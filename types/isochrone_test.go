@@ -0,0 +1,135 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/go.geojson"
+	"github.com/twpayne/go-geom"
+)
+
+// circle returns a closed ring approximating a circle
+func circle(lon, lat, radius float64, n int) [][]float64 {
+	ring := make([][]float64, 0, n+1)
+	for i := 0; i < n; i++ {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		ring = append(ring, []float64{lon + radius*math.Cos(a), lat + radius*math.Sin(a)})
+	}
+	return append(ring, ring[0])
+}
+
+// TestIsochrone_Clip clips a circular isochrone against a rectangular boundary and checks the result stays within bounds
+func TestIsochrone_Clip(t *testing.T) {
+	const (
+		minLon, minLat = 2.345, 48.845
+		maxLon, maxLat = 2.365, 48.855
+		epsilon        = 1e-9
+	)
+	boundary := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{
+		{minLon, minLat}, {maxLon, minLat}, {maxLon, maxLat}, {minLon, maxLat}, {minLon, minLat},
+	}})
+
+	iso := Isochrone(*geojson.NewMultiPolygonGeometry([][][]float64{circle(2.35, 48.85, 0.01, 64)}))
+	if err := iso.Clip(boundary); err != nil {
+		t.Fatalf("error while clipping: %v", err)
+	}
+
+	if len(iso.MultiPolygon) != 1 || len(iso.MultiPolygon[0]) != 1 {
+		t.Fatalf("expected a single polygon with a single ring, got %v", iso.MultiPolygon)
+	}
+	ring := iso.MultiPolygon[0][0]
+	if len(ring) < 4 {
+		t.Fatalf("clipped ring is degenerate: %v", ring)
+	}
+
+	var touchesBoundary bool
+	for _, p := range ring {
+		if p[0] < minLon-epsilon || p[0] > maxLon+epsilon || p[1] < minLat-epsilon || p[1] > maxLat+epsilon {
+			t.Errorf("point %v lies outside of the boundary", p)
+		}
+		touchesBoundary = touchesBoundary || math.Abs(p[0]-minLon) < epsilon || math.Abs(p[0]-maxLon) < epsilon || math.Abs(p[1]-maxLat) < epsilon
+	}
+	if !touchesBoundary {
+		t.Errorf("expected the clipped isochrone to follow the boundary")
+	}
+
+	// A self-intersecting boundary can't be split into convex parts
+	bowtie := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{
+		{0, 0}, {2, 2}, {2, 0}, {1, 3}, {0, 2}, {0, 0},
+	}})
+	if err := iso.Clip(bowtie); err == nil {
+		t.Errorf("expected an error when clipping against a self-intersecting boundary")
+	}
+}
+
+// TestIsochrone_Clip_Concave clips a square isochrone against an L-shaped boundary and checks that the notch is cut out
+func TestIsochrone_Clip_Concave(t *testing.T) {
+	const epsilon = 1e-9
+	boundary := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{{
+		{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}, {0, 0},
+	}})
+	square := [][]float64{{0.5, 0.5}, {1.5, 0.5}, {1.5, 1.5}, {0.5, 1.5}, {0.5, 0.5}}
+
+	iso := Isochrone(*geojson.NewPolygonGeometry([][][]float64{square}))
+	if err := iso.Clip(boundary); err != nil {
+		t.Fatalf("error while clipping: %v", err)
+	}
+	if iso.Type != geojson.GeometryMultiPolygon || len(iso.MultiPolygon) < 2 {
+		t.Fatalf("expected the polygon to be split in a MultiPolygon, got a %s of %d polygons", iso.Type, len(iso.MultiPolygon))
+	}
+
+	var area float64
+	for _, polygon := range iso.MultiPolygon {
+		ring := polygon[0]
+		for i := 0; i < len(ring)-1; i++ {
+			p, q := ring[i], ring[i+1]
+			area += (p[0]*q[1] - q[0]*p[1]) / 2
+			if p[0] > 1+epsilon && p[1] > 1+epsilon {
+				t.Errorf("point %v lies in the notch of the boundary", p)
+			}
+		}
+	}
+	if math.Abs(area-0.75) > epsilon {
+		t.Errorf("unexpected area of the clipped isochrone: got %f, expected 0.75", area)
+	}
+}
+
+// TestIsochrone_Geom checks that both Polygon and MultiPolygon isochrones are converted to a go-geom MultiPolygon
+func TestIsochrone_Geom(t *testing.T) {
+	ring := circle(2.35, 48.85, 0.01, 16)
+	hole := circle(2.35, 48.85, 0.002, 8)
+
+	tests := []struct {
+		name     string
+		iso      Isochrone
+		polygons int
+	}{
+		{"polygon", Isochrone(*geojson.NewPolygonGeometry([][][]float64{ring, hole})), 1},
+		{"multipolygon", Isochrone(*geojson.NewMultiPolygonGeometry([][][]float64{ring, hole}, [][][]float64{circle(2.4, 48.9, 0.01, 16)})), 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mp, err := tc.iso.Geom()
+			if err != nil {
+				t.Fatalf("error while converting: %v", err)
+			}
+			if mp.NumPolygons() != tc.polygons {
+				t.Fatalf("expected %d polygons, got %d", tc.polygons, mp.NumPolygons())
+			}
+			first := mp.Polygon(0)
+			if first.NumLinearRings() != 2 {
+				t.Fatalf("expected the first polygon to have an exterior ring and a hole, got %d rings", first.NumLinearRings())
+			}
+			if got := first.LinearRing(0).Coord(0); got.X() != ring[0][0] || got.Y() != ring[0][1] {
+				t.Errorf("expected first coordinate to be %v, got %v", ring[0], got)
+			}
+		})
+	}
+
+	t.Run("point", func(t *testing.T) {
+		iso := Isochrone(*geojson.NewPointGeometry([]float64{2.35, 48.85}))
+		if _, err := iso.Geom(); err == nil {
+			t.Error("expected an error when converting a Point")
+		}
+	})
+}
@@ -46,12 +46,24 @@ type Journey struct {
 	Duration  time.Duration
 	Transfers uint
 
+	// StreetNetworkDuration is the time spent on the street network (walking, cycling, driving...)
+	StreetNetworkDuration time.Duration
+
+	// TransferDuration is the time spent walking between two public transport sections
+	TransferDuration time.Duration
+
+	// NbSections is the number of sections of the journey
+	NbSections int
+
 	Departure time.Time
 	Requested time.Time
 	Arrival   time.Time
 
 	CO2Emissions CO2Emissions
 
+	// Distances covered on the street network by each mode
+	Distances Distances
+
 	Sections []Section
 
 	From Container
@@ -63,6 +75,15 @@ type Journey struct {
 
 	// Status from the whole journey taking into acount the most disturbing information retrieved on every object used
 	Status Effect
+
+	// Tags describing the journey, such as "walking" or "ecologic"
+	Tags []string
+
+	// Links to related objects, such as notes
+	Links Links
+
+	// notes are the notes referenced by the journey itself, see ResolveNotes
+	notes []Note
 }
 
 // jsonJourney define the JSON implementation of Journey struct
@@ -72,20 +93,31 @@ type jsonJourney struct {
 	Duration  int64 `json:"duration"`
 	Transfers *uint `json:"nb_transfers"`
 
+	// These are missing from older data, in which case they are derived from the sections
+	StreetNetworkDuration *int64 `json:"sn_dur"`
+	TransferDuration      *int64 `json:"transfer_dur"`
+	NbSections            *int   `json:"nb_sections"`
+
 	Departure string `json:"departure_date_time"`
 	Requested string `json:"requested_date_time"`
 	Arrival   string `json:"arrival_date_time"`
 
+	CO2Emissions *CO2Emissions `json:"co2_emission"`
+	Distances    *Distances    `json:"distances"`
+
 	Sections *[]Section `json:"sections"`
 
-	From *Container `json:"from"`
-	To   *Container `json:"to"`
+	From *Container `json:"from,omitempty"`
+	To   *Container `json:"to,omitempty"`
 
 	Type *JourneyQualification `json:"type"`
 
 	Fare *Fare `json:"fare"`
 
-	Status *Effect `json:"status"`
+	Status *Effect   `json:"status"`
+	Tags   *[]string `json:"tags"`
+
+	Links *Links `json:"links"`
 }
 
 // CO2Emissions holds how much CO2 is emitted.
@@ -97,9 +129,20 @@ type CO2Emissions struct {
 // jsonCO2Emissions define the JSON implementation of CO2Emissions struct
 // We define some of the value as pointers to the real values,
 // allowing us to bypass copying in cases where we don't need to process the data.
+//
+// The API gives the value as a number, but it used to be a string, json.Number accepts both.
 type jsonCO2Emissions struct {
-	Unit  *string `json:"unit"`
-	Value string  `json:"value"`
+	Unit  *string     `json:"unit"`
+	Value json.Number `json:"value"`
+}
+
+// Distances holds the distances covered by each street network mode during a journey, in meters.
+type Distances struct {
+	Walking     uint `json:"walking"`
+	Bike        uint `json:"bike"`
+	Car         uint `json:"car"`
+	Ridesharing uint `json:"ridesharing"`
+	Taxi        uint `json:"taxi"`
 }
 
 // TravelerType is a Traveler's type
@@ -124,19 +167,33 @@ const (
 	TravelerInWheelchair TravelerType = "wheelchair"
 )
 
+// Known reports whether a traveler type is one of those defined by the API
+func (tt TravelerType) Known() bool {
+	switch tt {
+	case TravelerStandard, TravelerSlowWalker, TravelerFastWalker, TravelerWithLuggage, TravelerInWheelchair:
+		return true
+	default:
+		return false
+	}
+}
+
 // UnmarshalJSON implements json.Unmarshaller for a Journey.
 // Behaviour:
 //	- If "from" is empty, then don't populate the From field.
 //	- Same for "to"
 func (j *Journey) UnmarshalJSON(b []byte) error {
 	data := &jsonJourney{
-		Transfers: &j.Transfers,
-		Sections:  &j.Sections,
-		From:      &j.From,
-		To:        &j.To,
-		Type:      &j.Type,
-		Fare:      &j.Fare,
-		Status:    &j.Status,
+		Transfers:    &j.Transfers,
+		CO2Emissions: &j.CO2Emissions,
+		Distances:    &j.Distances,
+		Sections:     &j.Sections,
+		From:         &j.From,
+		To:           &j.To,
+		Type:         &j.Type,
+		Fare:         &j.Fare,
+		Status:       &j.Status,
+		Tags:         &j.Tags,
+		Links:        &j.Links,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -165,9 +222,83 @@ func (j *Journey) UnmarshalJSON(b []byte) error {
 		return gen.err(err, "Arrival", "arrival_date_time", data.Arrival, "parseDateTime failed")
 	}
 
+	// The duration breakdowns, falling back to the sections if they aren't given
+	if data.StreetNetworkDuration != nil {
+		j.StreetNetworkDuration = time.Duration(*data.StreetNetworkDuration) * time.Second
+	} else {
+		j.StreetNetworkDuration = j.sectionsDuration(SectionStreetNetwork, SectionCrowFly)
+	}
+	if data.TransferDuration != nil {
+		j.TransferDuration = time.Duration(*data.TransferDuration) * time.Second
+	} else {
+		j.TransferDuration = j.sectionsDuration(SectionTransfer)
+	}
+	if data.NbSections != nil {
+		j.NbSections = *data.NbSections
+	} else {
+		j.NbSections = len(j.Sections)
+	}
+
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler for a Journey
+func (j Journey) MarshalJSON() ([]byte, error) {
+	streetNetworkDuration := int64(j.StreetNetworkDuration / time.Second)
+	transferDuration := int64(j.TransferDuration / time.Second)
+
+	return json.Marshal(jsonJourney{
+		Duration:              int64(j.Duration / time.Second),
+		Transfers:             &j.Transfers,
+		StreetNetworkDuration: &streetNetworkDuration,
+		TransferDuration:      &transferDuration,
+		NbSections:            &j.NbSections,
+		Departure:             formatDateTime(j.Departure),
+		Requested:             formatDateTime(j.Requested),
+		Arrival:               formatDateTime(j.Arrival),
+		CO2Emissions:          &j.CO2Emissions,
+		Distances:             &j.Distances,
+		Sections:              &j.Sections,
+		From:                  j.From.orNil(),
+		To:                    j.To.orNil(),
+		Type:                  &j.Type,
+		Fare:                  &j.Fare,
+		Status:                &j.Status,
+		Tags:                  &j.Tags,
+		Links:                 &j.Links,
+	})
+}
+
+// IsDisrupted reports whether the journey is impacted by a disruption, such as delays or a detour, according to its status.
+// Additional services aren't considered as disruptions.
+func (j Journey) IsDisrupted() bool {
+	return j.Status != "" && j.Status.status() != StatusNormal
+}
+
+// HasTag reports whether the journey is tagged with the given tag, such as "ecologic".
+func (j Journey) HasTag(tag string) bool {
+	for _, t := range j.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// sectionsDuration returns the sum of the durations of the journey's sections of the given types
+func (j *Journey) sectionsDuration(kinds ...SectionType) time.Duration {
+	var d time.Duration
+	for _, s := range j.Sections {
+		for _, t := range kinds {
+			if s.Type == t {
+				d += s.Duration
+				break
+			}
+		}
+	}
+	return d
+}
+
 // UnmarshalJSON implements json.Unmarshaller for CO2Emissions
 func (c *CO2Emissions) UnmarshalJSON(b []byte) error {
 	// First let's create the analogous structure
@@ -185,7 +316,7 @@ func (c *CO2Emissions) UnmarshalJSON(b []byte) error {
 	gen := unmarshalErrorMaker{"CO2Emissions", b}
 
 	// Now parse the value
-	f, err := strconv.ParseFloat(data.Value, 64)
+	f, err := strconv.ParseFloat(string(data.Value), 64)
 	if err != nil {
 		return gen.err(err, "Value", "value", data.Value, "error in strconv.ParseFloat")
 	}
@@ -193,3 +324,43 @@ func (c *CO2Emissions) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a CO2Emissions
+func (c CO2Emissions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonCO2Emissions{
+		Unit:  &c.Unit,
+		Value: json.Number(strconv.FormatFloat(c.Value, 'f', -1, 64)),
+	})
+}
+
+// ResolveNotes attaches to the journey and its sections the notes they reference, looked up in the given notes.
+// Navitia sends the notes once at the root of the response, so this has to be called once the whole response is decoded.
+func (j *Journey) ResolveNotes(notes Notes) {
+	index := notes.index()
+	j.notes = resolveNotes(j.Links, index)
+	for i := range j.Sections {
+		j.Sections[i].notes = resolveNotes(j.Sections[i].Links, index)
+	}
+}
+
+// Notes returns the notes attached to the journey or to any of its sections, without duplicates.
+//
+// Notes are only available once resolved from the response's notes, see ResolveNotes.
+func (j Journey) Notes() []Note {
+	var notes []Note
+	seen := make(map[ID]bool)
+	add := func(ns []Note) {
+		for _, n := range ns {
+			if !seen[n.ID] {
+				seen[n.ID] = true
+				notes = append(notes, n)
+			}
+		}
+	}
+
+	add(j.notes)
+	for _, s := range j.Sections {
+		add(s.notes)
+	}
+	return notes
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 // Test_Journey_Unmarshal tests unmarshalling for Journey.
@@ -43,3 +44,98 @@ func BenchmarkJourney_UnmarshalJSON(b *testing.B) {
 		b.Run(name, runFunc)
 	}
 }
+
+// TestJourney_durations checks that the duration breakdowns are decoded, and derived from the sections when absent
+func TestJourney_durations(t *testing.T) {
+	tests := []struct {
+		file          string
+		streetNetwork time.Duration
+		transfer      time.Duration
+		sections      int
+	}{
+		{"durations.json", 837 * time.Second, 2 * time.Minute, 9},
+		{"a0.json", 837 * time.Second, 0, 9}, // No breakdown given, derived from the sections
+	}
+
+	for _, test := range tests {
+		data := testData["journey"].correct[test.file]
+		if len(data) == 0 {
+			t.Fatalf("no data for %s", test.file)
+		}
+
+		j := &Journey{}
+		if err := j.UnmarshalJSON(data); err != nil {
+			t.Fatalf("%s: error while unmarshalling: %v", test.file, err)
+		}
+
+		if j.StreetNetworkDuration != test.streetNetwork {
+			t.Errorf("%s: unexpected StreetNetworkDuration: got %s, expected %s", test.file, j.StreetNetworkDuration, test.streetNetwork)
+		}
+		if j.TransferDuration != test.transfer {
+			t.Errorf("%s: unexpected TransferDuration: got %s, expected %s", test.file, j.TransferDuration, test.transfer)
+		}
+		if j.NbSections != test.sections {
+			t.Errorf("%s: unexpected NbSections: got %d, expected %d", test.file, j.NbSections, test.sections)
+		}
+	}
+}
+
+// TestJourney_environment checks that the CO2 emissions and the distances covered by each mode are decoded
+func TestJourney_environment(t *testing.T) {
+	data := testData["journey"].correct["durations.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if expected := (CO2Emissions{Unit: "gEC", Value: 25.005}); j.CO2Emissions != expected {
+		t.Errorf("unexpected CO2 emissions: got %#v, expected %#v", j.CO2Emissions, expected)
+	}
+	if expected := (Distances{Walking: 1012}); j.Distances != expected {
+		t.Errorf("unexpected distances: got %#v, expected %#v", j.Distances, expected)
+	}
+
+	// The value used to be given as a string
+	var c CO2Emissions
+	if err := c.UnmarshalJSON([]byte(`{"unit": "gEC", "value": "12.5"}`)); err != nil || c.Value != 12.5 {
+		t.Errorf("unexpected result for a value given as a string: %#v (err: %v)", c, err)
+	}
+}
+
+// TestJourney_IsDisrupted checks that journeys are reported as disrupted according to their status, and that their tags are decoded
+func TestJourney_IsDisrupted(t *testing.T) {
+	tests := []struct {
+		status   Effect
+		expected bool
+	}{
+		{"", false},
+		{JourneyStatusAdditionalService, false},
+		{JourneyStatusSignificantDelay, true},
+		{EffectNoService, true},
+		{JourneyStatusUnknownEffect, true},
+	}
+	for _, test := range tests {
+		if got := (Journey{Status: test.status}).IsDisrupted(); got != test.expected {
+			t.Errorf("status %q: expected IsDisrupted to be %t, got %t", test.status, test.expected, got)
+		}
+	}
+
+	data := testData["journey"].correct["b1.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+	j := &Journey{}
+	if err := j.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if !reflect.DeepEqual(j.Tags, []string{"walking", "ecologic"}) || !j.HasTag("ecologic") || j.HasTag("fast") {
+		t.Errorf("unexpected tags: %v", j.Tags)
+	}
+	if j.IsDisrupted() {
+		t.Errorf("expected an undisrupted journey, got status %q", j.Status)
+	}
+}
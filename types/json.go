@@ -3,6 +3,8 @@
 package types
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -15,8 +17,44 @@ const (
 	DateTimeFormat string = "20060102T150405" // YYYYMMDDThhmmss
 	// DateFormat is when there is no time info
 	DateFormat string = "20060102"
+	// TimeFormat is when there is no date info, such as the times of a stop time
+	TimeFormat string = "150405" // hhmmss
 )
 
+// timeOfDayOrigin is the date on which times without date info are set, see parseTimeOfDay
+var timeOfDayOrigin = time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// parseTimeOfDay parses a time formatted as hhmmss, returning it on the date of timeOfDayOrigin.
+// Hours past 23 are accepted, as the API uses them for times past midnight of a service day, and give a time on the following day.
+// If the given string is empty (i.e ""), then the zero value of time.Time will be returned
+func parseTimeOfDay(str string) (time.Time, error) {
+	if str == "" {
+		return time.Time{}, nil
+	}
+	if len(str) != len(TimeFormat) {
+		return time.Time{}, errors.Errorf("parseTimeOfDay: invalid length (len=%d instead of %d)", len(str), len(TimeFormat))
+	}
+
+	var h, m, s time.Duration
+	if _, err := fmt.Sscanf(str, "%02d%02d%02d", &h, &m, &s); err != nil {
+		return time.Time{}, errors.Wrap(err, "parseTimeOfDay: error while parsing time")
+	}
+	if m > 59 || s > 59 {
+		return time.Time{}, errors.Errorf("parseTimeOfDay: invalid time %q", str)
+	}
+	return timeOfDayOrigin.Add(h*time.Hour + m*time.Minute + s*time.Second), nil
+}
+
+// formatTimeOfDay formats a time parsed by parseTimeOfDay back to hhmmss.
+// The zero value of time.Time is formatted as "".
+func formatTimeOfDay(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	d := t.Sub(timeOfDayOrigin)
+	return fmt.Sprintf("%02d%02d%02d", d/time.Hour, d%time.Hour/time.Minute, d%time.Minute/time.Second)
+}
+
 // parseDateTime parses a time formatted under iso-date-time as indicated in the Navitia api.
 // This is simply parsing a date formatted under the standard ISO 8601.
 // If the given string is empty (i.e ""), then the zero value of time.Time will be returned
@@ -42,6 +80,64 @@ func parseDateTime(datetime string) (time.Time, error) {
 	return res, err
 }
 
+// formatDateTime formats a time as the Navitia api does, the inverse of parseDateTime.
+// The zero value of time.Time is formatted as "".
+func formatDateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(DateTimeFormat)
+}
+
+// formatDate formats the date of a time as the Navitia api does for fields without time info, such as calendar periods.
+// The zero value of time.Time is formatted as "".
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(DateFormat)
+}
+
+// flexibleSlice holds the raw JSON of a field that navitia may send either as an array or as a single object.
+//
+// Navitia has historically switched some fields (such as "messages", "notes" or "links") between the two representations across versions,
+// so decoding such fields through a flexibleSlice allows us to support both.
+type flexibleSlice json.RawMessage
+
+// UnmarshalJSON implements json.Unmarshaller for a flexibleSlice, simply storing the raw data
+func (fs *flexibleSlice) UnmarshalJSON(b []byte) error {
+	*fs = append((*fs)[:0], b...)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for a flexibleSlice, writing the raw data back as is
+func (fs flexibleSlice) MarshalJSON() ([]byte, error) {
+	if len(fs) == 0 {
+		return []byte("null"), nil
+	}
+	return fs, nil
+}
+
+// decode decodes the flexibleSlice into v, which must be a pointer to a slice.
+// A single object is decoded as a slice of one element, while an empty or null flexibleSlice leaves v untouched.
+func (fs flexibleSlice) decode(v interface{}) error {
+	b := bytes.TrimSpace(fs)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return nil
+	}
+
+	// If it's a single object, wrap it in an array
+	if b[0] == '{' {
+		wrapped := make([]byte, 0, len(b)+2)
+		wrapped = append(wrapped, '[')
+		wrapped = append(wrapped, b...)
+		wrapped = append(wrapped, ']')
+		b = wrapped
+	}
+
+	return json.Unmarshal(b, v)
+}
+
 // UnmarshalError is returned when unmarshalling fails
 // It implements both error and github.com/pkg/errors's causer
 type UnmarshalError struct {
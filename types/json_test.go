@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// TestFlexibleSlice_Disruption checks that disruption messages sent as a single object or as an array decode to the same slice
+func TestFlexibleSlice_Disruption(t *testing.T) {
+	corpus := testData["disruption"].correct
+	array, object := corpus["messages_array.json"], corpus["messages_object.json"]
+	if len(array) == 0 || len(object) == 0 {
+		t.Skip("No data to test")
+	}
+
+	fromArray := &Disruption{}
+	if err := fromArray.UnmarshalJSON(array); err != nil {
+		t.Fatalf("error while unmarshalling the array representation: %v", err)
+	}
+	fromObject := &Disruption{}
+	if err := fromObject.UnmarshalJSON(object); err != nil {
+		t.Fatalf("error while unmarshalling the object representation: %v", err)
+	}
+
+	if len(fromArray.Messages) != 1 {
+		t.Fatalf("expected 1 message, got %d", len(fromArray.Messages))
+	}
+	if !reflect.DeepEqual(fromArray.Messages, fromObject.Messages) {
+		t.Errorf("messages differ:\n\tarray: %#v\n\tobject: %#v", fromArray.Messages, fromObject.Messages)
+	}
+}
+
+// TestFlexibleSlice_Links checks that links sent as a single object or as an array decode to the same slice
+func TestFlexibleSlice_Links(t *testing.T) {
+	const (
+		link   = `{"id": "disruption:1", "type": "disruption", "rel": "disruptions", "templated": false}`
+		array  = `[` + link + `]`
+		object = link
+	)
+
+	var fromArray, fromObject Links
+	if err := json.Unmarshal([]byte(array), &fromArray); err != nil {
+		t.Fatalf("error while unmarshalling the array representation: %v", err)
+	}
+	if err := json.Unmarshal([]byte(object), &fromObject); err != nil {
+		t.Fatalf("error while unmarshalling the object representation: %v", err)
+	}
+
+	expected := Links{{ID: "disruption:1", Type: "disruption", Rel: "disruptions"}}
+	if !reflect.DeepEqual(fromArray, expected) {
+		t.Errorf("unexpected links from array: %#v", fromArray)
+	}
+	if !reflect.DeepEqual(fromObject, expected) {
+		t.Errorf("unexpected links from object: %#v", fromObject)
+	}
+
+	// A null value shouldn't produce any link
+	var fromNull Links
+	if err := json.Unmarshal([]byte(`null`), &fromNull); err != nil || fromNull != nil {
+		t.Errorf("unexpected result for null: %#v (err: %v)", fromNull, err)
+	}
+}
+
+// TestMarshalJSON_RoundTrip checks that every type with a custom unmarshaller can be marshalled back without losing data
+func TestMarshalJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		category string
+		typ      reflect.Type
+		deep     bool
+	}{
+		{"container", reflect.TypeOf(Container{}), true},
+		{"disruption", reflect.TypeOf(Disruption{}), true},
+		{"journey", reflect.TypeOf(Journey{}), true},
+		{"line", reflect.TypeOf(Line{}), true},
+		{"region", reflect.TypeOf(Region{}), true},
+		{"route", reflect.TypeOf(Route{}), true},
+		{"section", reflect.TypeOf(Section{}), true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.category, func(t *testing.T) {
+			t.Parallel()
+			testRoundTrip(t, testData[test.category], test.typ, test.deep)
+		})
+	}
+}
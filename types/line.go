@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/pkg/errors"
+	"github.com/twpayne/go-geom"
 )
 
 // A Line codes for a public transit line.
@@ -18,6 +19,9 @@ type Line struct {
 	Code  string      `json:"code"`  // Code is the codename of the line
 	Color color.Color `json:"color"` // Color of the Line, eg "FFFFFF"
 
+	// TextColor is the color of the text to be displayed over Color, such as the line's code on a badge
+	TextColor color.Color `json:"text_color"`
+
 	// OpeningTime is the opening time of the line
 	OpeningTime struct {
 		Hours   uint8 `json:"hours"`
@@ -35,6 +39,15 @@ type Line struct {
 	Routes         []Route        `json:"routes"`          // Routes contains the routes of the line
 	CommercialMode CommercialMode `json:"commercial_mode"` // CommercialMode of the line
 	PhysicalModes  []PhysicalMode `json:"physical_modes"`  // PhysicalModes of the line
+	Network        Network        `json:"network"`         // Network the line belongs to
+	Links          Links          `json:"links"`           // Links to related objects, such as the disruptions affecting the line
+
+	// Geo is the shape of the line, one line string per route.
+	// It is nil when the geojson is disabled in the request.
+	Geo *geom.MultiLineString `json:"geojson"`
+
+	// disruptions are the disruptions referenced by the line, see ResolveDisruptions
+	disruptions []Disruption
 }
 
 // jsonLine define the JSON implementation of Line struct.
@@ -47,11 +60,15 @@ type jsonLine struct {
 	Routes         *[]Route        `json:"routes"`          // Routes contains the routes of the line
 	CommercialMode *CommercialMode `json:"commercial_mode"` // CommercialMode of the line
 	PhysicalModes  *[]PhysicalMode `json:"physical_modes"`  // PhysicalModes of the line
+	Network        *Network        `json:"network"`         // Network the line belongs to
+	Links          *Links          `json:"links"`           // Links to related objects
 
 	// Value to process
-	Color       string `json:"color"`        // Color of the Line, eg "FFFFFF"
-	OpeningTime string `json:"opening_time"` // OpeningTime is the opening time of the line
-	ClosingTime string `json:"closing_time"` // ClosingTime is the closing time of the line
+	Color       string               `json:"color"`        // Color of the Line, eg "FFFFFF"
+	TextColor   string               `json:"text_color"`   // TextColor of the Line, eg "000000"
+	Geo         *jsonMultiLineString `json:"geojson"`      // Geo is the shape of the line
+	OpeningTime string               `json:"opening_time"` // OpeningTime is the opening time of the line
+	ClosingTime string               `json:"closing_time"` // ClosingTime is the closing time of the line
 }
 
 // UnmarshalJSON implements json.Unmarshaller for a Line
@@ -63,6 +80,8 @@ func (l *Line) UnmarshalJSON(b []byte) error {
 		Routes:         &l.Routes,
 		CommercialMode: &l.CommercialMode,
 		PhysicalModes:  &l.PhysicalModes,
+		Network:        &l.Network,
+		Links:          &l.Links,
 	}
 
 	if err := json.Unmarshal(b, &data); err != nil {
@@ -82,6 +101,22 @@ func (l *Line) UnmarshalJSON(b []byte) error {
 		}
 		l.Color = clr
 	}
+	if str := data.TextColor; len(str) == 6 {
+		clr, err := parseColor(str)
+		if err != nil {
+			return gen.err(err, "TextColor", "text_color", str, "error in parseColor")
+		}
+		l.TextColor = clr
+	}
+
+	// The shape of the line
+	if data.Geo != nil {
+		geo, err := data.Geo.geom()
+		if err != nil {
+			return gen.err(err, "Geo", "geojson", data.Geo, "error while converting the geojson")
+		}
+		l.Geo = geo
+	}
 
 	// For OpeningTime and ClosingTime: we define a function to help us
 	parseTime := func(str string) (h, m, s uint8, err error) {
@@ -126,3 +161,94 @@ func (l *Line) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Line
+func (l Line) MarshalJSON() ([]byte, error) {
+	formatTime := func(h, m, s uint8) string {
+		return fmt.Sprintf("%02d%02d%02d", h, m, s)
+	}
+
+	return json.Marshal(jsonLine{
+		ID:             &l.ID,
+		Name:           &l.Name,
+		Code:           &l.Code,
+		Routes:         &l.Routes,
+		CommercialMode: &l.CommercialMode,
+		PhysicalModes:  &l.PhysicalModes,
+		Network:        &l.Network,
+		Links:          &l.Links,
+		Color:          formatColor(l.Color),
+		TextColor:      formatColor(l.TextColor),
+		Geo:            newJSONMultiLineString(l.Geo),
+		OpeningTime:    formatTime(l.OpeningTime.Hours, l.OpeningTime.Minutes, l.OpeningTime.Seconds),
+		ClosingTime:    formatTime(l.ClosingTime.Hours, l.ClosingTime.Minutes, l.ClosingTime.Seconds),
+	})
+}
+
+// ResolveDisruptions attaches to the line the disruptions it references, looked up in the given disruptions.
+// Navitia sends the disruptions once at the root of the response, so this has to be called once the whole response is decoded.
+func (l *Line) ResolveDisruptions(disruptions []Disruption) {
+	l.disruptions = linkedDisruptions(l.Links, indexDisruptions(disruptions))
+}
+
+// Disruptions returns the disruptions affecting the line.
+//
+// Disruptions are only available once resolved from the response's disruptions, see ResolveDisruptions.
+func (l Line) Disruptions() []Disruption {
+	return l.disruptions
+}
+
+// geojsonMultiLineString is the geojson type of a multi line string
+const geojsonMultiLineString = "MultiLineString"
+
+// jsonMultiLineString is the geojson shape of a line or a route.
+// As it is always a 2D multi line string, the coordinates are decoded directly, as for the geojson of a Section.
+type jsonMultiLineString struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+// geom converts the geojson to a go-geom MultiLineString.
+// An empty geojson, as sent for objects without a shape, gives nil.
+func (mls *jsonMultiLineString) geom() (*geom.MultiLineString, error) {
+	if mls.Type == "" && len(mls.Coordinates) == 0 {
+		return nil, nil
+	}
+	if mls.Type != geojsonMultiLineString {
+		return nil, errors.Errorf("unexpected geojson type %q, expected %q", mls.Type, geojsonMultiLineString)
+	}
+
+	var (
+		flat []float64
+		ends = make([]int, 0, len(mls.Coordinates))
+	)
+	for _, ls := range mls.Coordinates {
+		for _, c := range ls {
+			flat = append(flat, c[0], c[1])
+		}
+		ends = append(ends, len(flat))
+	}
+	return geom.NewMultiLineStringFlat(geom.XY, flat, ends), nil
+}
+
+// newJSONMultiLineString creates the geojson of a MultiLineString, the inverse of geom.
+// A nil MultiLineString gives a nil geojson.
+func newJSONMultiLineString(mls *geom.MultiLineString) *jsonMultiLineString {
+	if mls == nil {
+		return nil
+	}
+
+	data := &jsonMultiLineString{
+		Type:        geojsonMultiLineString,
+		Coordinates: make([][][2]float64, mls.NumLineStrings()),
+	}
+	for i := range data.Coordinates {
+		ls := mls.LineString(i)
+		data.Coordinates[i] = make([][2]float64, ls.NumCoords())
+		for j := range data.Coordinates[i] {
+			c := ls.Coord(j)
+			data.Coordinates[i][j] = [2]float64{c.X(), c.Y()}
+		}
+	}
+	return data
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	"sort"
+	"strings"
+)
+
+// A Status summarizes the state of a line, see SummarizeLineStatus
+type Status int
+
+// These are the statuses a line can be in, from the best to the worst
+const (
+	StatusNormal    Status = iota // No active disruption degrading the service
+	StatusDisrupted               // Service running, but degraded (delays, detours, reduced service...)
+	StatusNoService               // Service suspended
+)
+
+// String implements fmt.Stringer
+func (s Status) String() string {
+	switch s {
+	case StatusNormal:
+		return "normal"
+	case StatusDisrupted:
+		return "disrupted"
+	case StatusNoService:
+		return "no service"
+	default:
+		return "unknown"
+	}
+}
+
+// effectRanks ranks the effects of disruptions, the higher the worse.
+// Unknown effects rank between the known degraded ones and the harmless ones.
+var effectRanks = map[Effect]int{
+	JourneyStatusAdditionalService: 0,
+	JourneyStatusOtherEffect:       1,
+	JourneyStatusUnknownEffect:     1,
+	JourneyStatusStopMoved:         2,
+	JourneyStatusModifiedService:   3,
+	JourneyStatusDetour:            4,
+	JourneyStatusSignificantDelay:  5,
+	JourneyStatusReducedService:    6,
+	EffectNoService:                7,
+}
+
+// effectRank returns the rank of an effect, see effectRanks
+func effectRank(e Effect) int {
+	if r, ok := effectRanks[e]; ok {
+		return r
+	}
+	return effectRanks[JourneyStatusUnknownEffect]
+}
+
+// status returns the status of a line affected by a disruption with the given effect
+func (e Effect) status() Status {
+	switch e {
+	case EffectNoService:
+		return StatusNoService
+	case JourneyStatusAdditionalService:
+		return StatusNormal
+	default:
+		return StatusDisrupted
+	}
+}
+
+// SortBySeverity sorts the disruptions from the worst to the mildest.
+// Disruptions are ordered by effect first, then by the priority given by the agency, disruptions without priority coming last.
+func SortBySeverity(disruptions []Disruption) {
+	sort.SliceStable(disruptions, func(i, j int) bool {
+		a, b := disruptions[i].Severity, disruptions[j].Severity
+		if ra, rb := effectRank(a.Effect), effectRank(b.Effect); ra != rb {
+			return ra > rb
+		}
+		switch {
+		case a.Priority == nil:
+			return false
+		case b.Priority == nil:
+			return true
+		default:
+			return *a.Priority < *b.Priority
+		}
+	})
+}
+
+// worstDisruption returns the worst of the line's disruptions in the given state
+func worstDisruption(line Line, status string) (Disruption, bool) {
+	var matching []Disruption
+	for _, d := range line.Disruptions() {
+		if strings.EqualFold(d.Status, status) {
+			matching = append(matching, d)
+		}
+	}
+	if len(matching) == 0 {
+		return Disruption{}, false
+	}
+
+	SortBySeverity(matching)
+	return matching[0], true
+}
+
+// summary returns a short message describing the disruption: its first text message, or failing that its severity
+func (d Disruption) summary() string {
+	if msgs := d.MessagesFor(ChannelContentText); len(msgs) != 0 && msgs[0].Text != "" {
+		return msgs[0].Text
+	}
+	if d.Severity.Name != "" {
+		return d.Severity.Name
+	}
+	return string(d.Severity.Effect)
+}
+
+// SummarizeLineStatus summarizes the line's active disruptions in a single status, such as for coloring a network map,
+// along with a short message describing the worst of them.
+// If no active disruption degrades the service, it returns StatusNormal and an empty message.
+//
+// Only active disruptions are taken into account, see UpcomingLineDisruption for the future ones.
+// The line's disruptions must have been resolved beforehand, see Line.ResolveDisruptions.
+func SummarizeLineStatus(line Line) (Status, string) {
+	worst, ok := worstDisruption(line, DisruptionStatusActive)
+	if !ok {
+		return StatusNormal, ""
+	}
+
+	status := worst.Severity.Effect.status()
+	if status == StatusNormal {
+		return StatusNormal, ""
+	}
+	return status, worst.summary()
+}
+
+// UpcomingLineDisruption returns the worst future disruption of the line, if any.
+// It complements SummarizeLineStatus, which reports a line only affected by future disruptions as normal.
+func UpcomingLineDisruption(line Line) (Disruption, bool) {
+	return worstDisruption(line, DisruptionStatusFuture)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestSummarizeLineStatus checks the status & message reported for normal, delayed and interrupted lines
+func TestSummarizeLineStatus(t *testing.T) {
+	tests := []struct {
+		file    string
+		status  Status
+		message string
+	}{
+		{"normal.json", StatusNormal, ""},
+		{"delayed.json", StatusDisrupted, "Incident technique : trafic ralenti."},
+		{"no_service.json", StatusNoService, "Mouvement social : trafic interrompu."},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			data := testData["line_status"].correct[test.file]
+			if len(data) == 0 {
+				t.Skip("No data to test")
+			}
+
+			var doc struct {
+				Line        Line         `json:"line"`
+				Disruptions []Disruption `json:"disruptions"`
+			}
+			if err := json.Unmarshal(data, &doc); err != nil {
+				t.Fatalf("error while unmarshalling: %v", err)
+			}
+			doc.Line.ResolveDisruptions(doc.Disruptions)
+
+			status, message := SummarizeLineStatus(doc.Line)
+			if status != test.status {
+				t.Errorf("expected status %q, got %q", test.status, status)
+			}
+			if message != test.message {
+				t.Errorf("expected message %q, got %q", test.message, message)
+			}
+		})
+	}
+}
+
+// TestUpcomingLineDisruption checks that a line only affected by future disruptions exposes the upcoming one
+func TestUpcomingLineDisruption(t *testing.T) {
+	data := testData["line_status"].correct["normal.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	var doc struct {
+		Line        Line         `json:"line"`
+		Disruptions []Disruption `json:"disruptions"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	doc.Line.ResolveDisruptions(doc.Disruptions)
+
+	upcoming, ok := UpcomingLineDisruption(doc.Line)
+	if !ok {
+		t.Fatal("expected an upcoming disruption, got none")
+	}
+	if upcoming.ID != "future-works" {
+		t.Errorf("expected upcoming disruption %q, got %q", "future-works", upcoming.ID)
+	}
+}
+
+// TestSortBySeverity checks that disruptions are sorted by effect, then by priority
+func TestSortBySeverity(t *testing.T) {
+	one, two := 1, 2
+	disruptions := []Disruption{
+		{ID: "unprioritized delay", Severity: Severity{Effect: JourneyStatusSignificantDelay}},
+		{ID: "detour", Severity: Severity{Effect: JourneyStatusDetour, Priority: &one}},
+		{ID: "low priority delay", Severity: Severity{Effect: JourneyStatusSignificantDelay, Priority: &two}},
+		{ID: "interruption", Severity: Severity{Effect: EffectNoService, Priority: &two}},
+		{ID: "high priority delay", Severity: Severity{Effect: JourneyStatusSignificantDelay, Priority: &one}},
+	}
+	expected := []ID{"interruption", "high priority delay", "low priority delay", "unprioritized delay", "detour"}
+
+	SortBySeverity(disruptions)
+	for i, d := range disruptions {
+		if d.ID != expected[i] {
+			t.Errorf("expected %q at position %d, got %q", expected[i], i, d.ID)
+		}
+	}
+}
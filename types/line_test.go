@@ -1,6 +1,7 @@
 package types
 
 import (
+	"image/color"
 	"reflect"
 	"testing"
 )
@@ -15,6 +16,49 @@ func Test_Line_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["line"], reflect.TypeOf(Line{}))
 }
 
+// TestLine_UnmarshalJSON checks the decoding of the colors, network and shape of a line
+func TestLine_UnmarshalJSON(t *testing.T) {
+	data := testData["line"].correct["metro14.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	l := &Line{}
+	if err := l.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if c, ok := l.TextColor.(color.NRGBA); !ok || c.R != 0xff || c.G != 0xff || c.B != 0xff {
+		t.Errorf("expected a white text color, got %v", l.TextColor)
+	}
+	if l.Network.Name != "RATP" {
+		t.Errorf("unexpected network: %+v", l.Network)
+	}
+	if l.ClosingTime.Hours != 1 || l.ClosingTime.Minutes != 45 {
+		t.Errorf("unexpected closing time: %+v", l.ClosingTime)
+	}
+
+	if l.Geo == nil {
+		t.Fatal("expected the line to have a shape")
+	}
+	if n := l.Geo.NumLineStrings(); n != 2 {
+		t.Fatalf("expected 2 line strings, got %d", n)
+	}
+	if c := l.Geo.LineString(1).Coord(0); c.X() != 2.3669 || c.Y() != 48.8265 {
+		t.Errorf("unexpected first coordinate of the second line string: %v", c)
+	}
+
+	// Lines without a shape have none
+	other := testData["line"].correct["doc.json"]
+	l = &Line{}
+	if err := l.UnmarshalJSON(other); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if l.Geo != nil {
+		t.Errorf("expected no shape, got %v", l.Geo)
+	}
+}
+
 // BenchmarkLineUnmarshal benchmarks Line unmarshalling via subbenchmarks
 func BenchmarkLineUnmarshal(b *testing.B) {
 	// Get the bench data
@@ -1,8 +1,41 @@
 package types
 
+import "fmt"
+
+// A Link is a link to a related object or resource.
+//
+// Links to objects (such as a vehicle journey in a schedule, or a disruption in a section) are identified by their ID & Type,
+// while links to resources (such as the next page of results) have an Href.
 type Link struct {
+	ID        ID     `json:"id"`
 	Href      string `json:"href"`
 	Type      string `json:"type"`
 	Rel       string `json:"rel"`
 	Templated bool   `json:"templated"`
 }
+
+// Links is a list of Link.
+//
+// It can be decoded from either an array of links or a single link object.
+type Links []Link
+
+// UnmarshalJSON implements json.Unmarshaller for Links.
+// The links are decoded in place, as an intermediate slice would be allocated for each of the many objects having links.
+func (l *Links) UnmarshalJSON(b []byte) error {
+	*l = nil
+	if err := flexibleSlice(b).decode((*[]Link)(l)); err != nil {
+		return fmt.Errorf("error while unmarshalling Links: %w", err)
+	}
+	return nil
+}
+
+// idsOfType returns the IDs of the links of the given type, such as "ticket"
+func (l Links) idsOfType(typ string) []ID {
+	var ids []ID
+	for _, link := range l {
+		if link.Type == typ {
+			ids = append(ids, link.ID)
+		}
+	}
+	return ids
+}
@@ -1,7 +1,15 @@
 package types
 
-// A Message contains the text to be provided to the traveler.
-type Message struct {
+// A DisruptionMessage contains the text to be provided to the traveler.
+//
+// A Disruption usually carries one DisruptionMessage per channel (for example a plain-text one and an HTML one),
+// see Disruption.MessagesFor to pick the one suiting your media.
+type DisruptionMessage struct {
 	Text    string   `json:"text"`    // The message to bring to the traveler
 	Channel *Channel `json:"channel"` // The destination media for this Message.
 }
+
+// A Message is the former name of DisruptionMessage.
+//
+// Deprecated: use DisruptionMessage.
+type Message = DisruptionMessage
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"image/color"
 	"strconv"
 	"time"
@@ -39,13 +40,40 @@ func parseColor(str string) (color.NRGBA, error) {
 	}, nil
 }
 
+// formatColor formats a color as a hex code RRGGBB, the inverse of parseColor.
+// A nil color is formatted as "".
+func formatColor(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+
+	// parseColor leaves the alpha at zero, which a conversion would take as transparent black
+	clr, ok := c.(color.NRGBA)
+	if !ok {
+		clr = color.NRGBAModel.Convert(c).(color.NRGBA)
+	}
+	return fmt.Sprintf("%02X%02X%02X", clr.R, clr.G, clr.B)
+}
+
 const (
 	// DataFreshnessRealTime means you'll get undisrupted journeys
 	DataFreshnessRealTime DataFreshness = "realtime"
 	// DataFreshnessBaseSchedule means you can get disrupted journeys in the response.
-	DataFreshnessBaseSchedule = "base_schedule"
+	DataFreshnessBaseSchedule DataFreshness = "base_schedule"
+	// DataFreshnessAdaptedSchedule means planned disruptions are taken into account, but not realtime updates.
+	DataFreshnessAdaptedSchedule DataFreshness = "adapted_schedule"
 )
 
+// Known reports whether a data freshness is one of the DataFreshnessXXX values
+func (df DataFreshness) Known() bool {
+	switch df {
+	case DataFreshnessRealTime, DataFreshnessBaseSchedule, DataFreshnessAdaptedSchedule:
+		return true
+	default:
+		return false
+	}
+}
+
 // A PTDateTime (pt stands for “public transport”) is a complex date time object to manage the difference between stop and leaving times at a stop.
 // It is used by:
 // 	- Row in Schedule
@@ -57,6 +85,84 @@ type PTDateTime struct {
 
 	// Date/Time of arrival
 	Arrival time.Time `json:"arrival"`
+
+	// Base (scheduled) Date/Time of departure & arrival, before any realtime update.
+	// They may be zero if the API didn't provide them.
+	BaseDeparture time.Time
+	BaseArrival   time.Time
+
+	// Additional information about the date time, such as "date_time_estimated", see the AdditionalXXX constants
+	Additional []string
+
+	// Links to related objects, such as the notes applying to the date time
+	Links Links
+
+	// DataFreshness of the date time, if provided by the API
+	DataFreshness DataFreshness
+}
+
+// AdditionalXXX are the known additional informations of a PTDateTime
+const (
+	// AdditionalDateTimeEstimated: the time is estimated, as the stop is only served on demand or between timed stops
+	AdditionalDateTimeEstimated = "date_time_estimated"
+	// AdditionalPickUpOnly & AdditionalDropOffOnly: passengers may only board or alight at the stop
+	AdditionalPickUpOnly  = "pick_up_only"
+	AdditionalDropOffOnly = "drop_off_only"
+	// AdditionalOnDemandTransport: the stop is served on demand, call before travelling
+	AdditionalOnDemandTransport = "on_demand_transport"
+	// AdditionalSkippedStop: the stop isn't served anymore, following a realtime update
+	AdditionalSkippedStop = "skipped_stop"
+)
+
+// HasAdditional reports whether the date time comes with the given additional information, such as AdditionalDateTimeEstimated.
+func (ptdt PTDateTime) HasAdditional(info string) bool {
+	for _, a := range ptdt.Additional {
+		if a == info {
+			return true
+		}
+	}
+	return false
+}
+
+// Estimated reports whether the date time is an estimation rather than a scheduled time
+func (ptdt PTDateTime) Estimated() bool {
+	return ptdt.HasAdditional(AdditionalDateTimeEstimated)
+}
+
+// DepartureDelay returns the delay of the departure compared to the base schedule, negative if it is early.
+// It is zero if either time is unknown.
+func (ptdt PTDateTime) DepartureDelay() time.Duration {
+	if ptdt.Departure.IsZero() || ptdt.BaseDeparture.IsZero() {
+		return 0
+	}
+	return ptdt.Departure.Sub(ptdt.BaseDeparture)
+}
+
+// ArrivalDelay returns the delay of the arrival compared to the base schedule, see DepartureDelay.
+func (ptdt PTDateTime) ArrivalDelay() time.Duration {
+	if ptdt.Arrival.IsZero() || ptdt.BaseArrival.IsZero() {
+		return 0
+	}
+	return ptdt.Arrival.Sub(ptdt.BaseArrival)
+}
+
+// Delay returns the delay at the stop: the one of the departure, or of the arrival at the terminus where there's no departure.
+func (ptdt PTDateTime) Delay() time.Duration {
+	if !ptdt.Departure.IsZero() {
+		return ptdt.DepartureDelay()
+	}
+	return ptdt.ArrivalDelay()
+}
+
+// Skipped reports whether the stop isn't served anymore following a realtime update, that is when the API says so,
+// or when the base schedule has times but the realtime data has none.
+func (ptdt PTDateTime) Skipped() bool {
+	if ptdt.HasAdditional(AdditionalSkippedStop) {
+		return true
+	}
+	hasBase := !ptdt.BaseDeparture.IsZero() || !ptdt.BaseArrival.IsZero()
+	hasRealtime := !ptdt.Departure.IsZero() || !ptdt.Arrival.IsZero()
+	return ptdt.DataFreshness == DataFreshnessRealTime && hasBase && !hasRealtime
 }
 
 // A Code is associated to a dataset
@@ -6,4 +6,22 @@ package types
 type Network struct {
 	ID   string `json:"id"`   // ID is the identifier of the network
 	Name string `json:"name"` // Name is the name of the network
+
+	// Links to related objects, such as the disruptions affecting the network
+	Links Links `json:"links"`
+
+	// disruptions are the disruptions referenced by the network, see ResolveDisruptions
+	disruptions []Disruption
+}
+
+// ResolveDisruptions attaches to the network the disruptions it references, looked up in the given disruptions.
+func (n *Network) ResolveDisruptions(disruptions []Disruption) {
+	n.disruptions = linkedDisruptions(n.Links, indexDisruptions(disruptions))
+}
+
+// Disruptions returns the disruptions affecting the network as a whole.
+//
+// Disruptions are only available once resolved from the response's disruptions, see ResolveDisruptions.
+func (n Network) Disruptions() []Disruption {
+	return n.disruptions
 }
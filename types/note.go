@@ -0,0 +1,55 @@
+package types
+
+import "fmt"
+
+// A Note is a free-text advisory, such as "reservation required", which can be attached to journeys, sections and other objects.
+//
+// Notes are sent once at the root of a response, objects referencing them through their links.
+type Note struct {
+	ID          ID     `json:"id"`
+	Value       string `json:"value"`        // The text of the note
+	Category    string `json:"category"`     // The category of the note, such as "comment"
+	CommentType string `json:"comment_type"` // The type of comment, such as "standard" or "on_demand_transport"
+}
+
+// linkTypeNotes is the type of links referencing a Note
+const linkTypeNotes = "notes"
+
+// Notes is a list of Note.
+//
+// It can be decoded from either an array of notes or a single note object.
+type Notes []Note
+
+// UnmarshalJSON implements json.Unmarshaller for Notes
+func (n *Notes) UnmarshalJSON(b []byte) error {
+	var notes []Note
+	if err := flexibleSlice(b).decode(&notes); err != nil {
+		return fmt.Errorf("error while unmarshalling Notes: %w", err)
+	}
+	*n = notes
+	return nil
+}
+
+// index returns the notes indexed by their ID
+func (n Notes) index() map[ID]Note {
+	m := make(map[ID]Note, len(n))
+	for _, note := range n {
+		m[note.ID] = note
+	}
+	return m
+}
+
+// resolveNotes returns the notes referenced by the given links, in order.
+// Links to unknown notes are ignored.
+func resolveNotes(links Links, notes map[ID]Note) []Note {
+	var resolved []Note
+	for _, l := range links {
+		if l.Type != linkTypeNotes {
+			continue
+		}
+		if note, ok := notes[l.ID]; ok {
+			resolved = append(resolved, note)
+		}
+	}
+	return resolved
+}
@@ -17,6 +17,76 @@ type PathSegment struct {
 	// < 0 Means turning left
 	// > 0 Means turning right
 	Direction int `json:"direction"`
+
+	// ViaID is the ID of the access point this segment goes through, if any, see Section.Via
+	ViaID ID `json:"via_uri"`
+
+	// Instruction is the instruction given by the API for this segment, such as "Turn left on Rue de Rivoli", if any.
+	// InstructionStart is where it applies, it is the zero value if not given.
+	Instruction      string      `json:"instruction"`
+	InstructionStart Coordinates `json:"instruction_start_coordinate"`
+
+	// CyclePath is the kind of cycle lane along the segment, see IsCyclePath
+	CyclePath CyclePathType `json:"cycle_path_type"`
+}
+
+// A CyclePathType is the kind of cycle lane along a PathSegment
+type CyclePathType string
+
+// CyclePathXXX are the known types of cycle lanes, from the least to the most separated from the traffic
+const (
+	CyclePathNone      CyclePathType = "no_cycle_lane"
+	CyclePathShared    CyclePathType = "shared_cycle_way"
+	CyclePathDedicated CyclePathType = "dedicated_cycle_way"
+	CyclePathSeparated CyclePathType = "separated_cycle_way"
+)
+
+// A Turn is the manoeuvre to do at the start of a PathSegment, see PathSegment.Turn
+type Turn string
+
+// TurnXXX are the manoeuvres, derived from the direction of a PathSegment
+const (
+	TurnStraight    Turn = "straight"
+	TurnSlightLeft  Turn = "slight_left"
+	TurnLeft        Turn = "left"
+	TurnSharpLeft   Turn = "sharp_left"
+	TurnSlightRight Turn = "slight_right"
+	TurnRight       Turn = "right"
+	TurnSharpRight  Turn = "sharp_right"
+	TurnUTurn       Turn = "u_turn"
+)
+
+// Turn returns the manoeuvre to do to follow the segment from the previous one, classified from its Direction.
+func (ps PathSegment) Turn() Turn {
+	angle := ps.Direction
+	left := angle < 0
+	if left {
+		angle = -angle
+	}
+
+	switch {
+	case angle < 10:
+		return TurnStraight
+	case angle >= 170:
+		return TurnUTurn
+	case angle < 45 && left:
+		return TurnSlightLeft
+	case angle < 45:
+		return TurnSlightRight
+	case angle < 135 && left:
+		return TurnLeft
+	case angle < 135:
+		return TurnRight
+	case left:
+		return TurnSharpLeft
+	default:
+		return TurnSharpRight
+	}
+}
+
+// IsCyclePath reports whether the segment has a cycle lane of any kind.
+func (ps PathSegment) IsCyclePath() bool {
+	return ps.CyclePath != "" && ps.CyclePath != CyclePathNone
 }
 
 // jsonPathSegment define the JSON implementation of PathSegment struct
@@ -24,20 +94,29 @@ type PathSegment struct {
 // allowing us to bypass copying in cases where we don't need to process the data.
 type jsonPathSegment struct {
 	// Pointers to the corresponding real values
-	Length    *uint
-	Name      *string
-	Direction *int
+	Length    *uint   `json:"length"`
+	Name      *string `json:"name"`
+	Direction *int    `json:"direction"`
+	ViaID     *ID     `json:"via_uri"`
+
+	Instruction      *string        `json:"instruction,omitempty"`
+	InstructionStart *Coordinates   `json:"instruction_start_coordinate,omitempty"`
+	CyclePath        *CyclePathType `json:"cycle_path_type,omitempty"`
 
 	// Value to process
-	Duration int64
+	Duration int64 `json:"duration"`
 }
 
 // UnmarshalJSON implements json.Unmarshaller for a PathSegment
 func (ps *PathSegment) UnmarshalJSON(b []byte) error {
 	data := &jsonPathSegment{
-		Length:    &ps.Length,
-		Name:      &ps.Name,
-		Direction: &ps.Direction,
+		Length:           &ps.Length,
+		Name:             &ps.Name,
+		Direction:        &ps.Direction,
+		ViaID:            &ps.ViaID,
+		Instruction:      &ps.Instruction,
+		InstructionStart: &ps.InstructionStart,
+		CyclePath:        &ps.CyclePath,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -52,3 +131,67 @@ func (ps *PathSegment) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a PathSegment
+func (ps PathSegment) MarshalJSON() ([]byte, error) {
+	data := jsonPathSegment{
+		Length:    &ps.Length,
+		Name:      &ps.Name,
+		Direction: &ps.Direction,
+		ViaID:     &ps.ViaID,
+		Duration:  int64(ps.Duration / time.Second),
+	}
+
+	// These are only given by recent versions of the API, so they're left out when empty
+	if ps.Instruction != "" {
+		data.Instruction = &ps.Instruction
+	}
+	if ps.InstructionStart != (Coordinates{}) {
+		data.InstructionStart = &ps.InstructionStart
+	}
+	if ps.CyclePath != "" {
+		data.CyclePath = &ps.CyclePath
+	}
+	return json.Marshal(data)
+}
+
+// A PathSegmentGeo holds the properties of a segment of a section's geojson, see Section.GeoProperties
+type PathSegmentGeo struct {
+	Length   uint          // The length of the segment in meters
+	Duration time.Duration // The duration of the segment, zero if not given
+}
+
+// jsonPathSegmentGeo define the JSON implementation of PathSegmentGeo struct
+type jsonPathSegmentGeo struct {
+	// Pointers to the corresponding real values
+	Length *uint `json:"length"`
+
+	// Value to process
+	Duration int64 `json:"duration"`
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a PathSegmentGeo
+func (psg *PathSegmentGeo) UnmarshalJSON(b []byte) error {
+	data := &jsonPathSegmentGeo{
+		Length: &psg.Length,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling PathSegmentGeo: %w", err)
+	}
+
+	// As the given duration is in second, let's multiply it by one second to have the correct value
+	psg.Duration = time.Duration(data.Duration) * time.Second
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for a PathSegmentGeo
+func (psg PathSegmentGeo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonPathSegmentGeo{
+		Length:   &psg.Length,
+		Duration: int64(psg.Duration / time.Second),
+	})
+}
@@ -45,3 +45,14 @@ func (p *Period) UnmarshalJSON(b []byte) error {
 	// Finished !
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Period
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Begin string `json:"begin"`
+		End   string `json:"end"`
+	}{
+		Begin: formatDateTime(p.Begin),
+		End:   formatDateTime(p.End),
+	})
+}
@@ -1,9 +1,11 @@
 package types
 
+import "strconv"
+
 // A Place isn't something directly used by the Navitia.io api.
 //
 // However, it allows the library user to use idiomatic go when working with the library.
-// If you want a countainer, see Container
+// Places are embedded in a Container along with PT objects, see Container.Place & Container.Object.
 //
 // Place is held by these types:
 // 	- StopArea
@@ -32,7 +34,28 @@ type StopArea struct {
 	// Stop points countained in this stop area
 	StopPoints []StopPoint `json:"stop_points"`
 
+	// Codes of the stop area in other systems (GTFS, source data...)
+	Codes []Code `json:"codes"`
+
 	Timezone string `json:"timezone"`
+
+	// Links to related objects, such as the disruptions affecting the stop area
+	Links Links `json:"links"`
+
+	// disruptions are the disruptions referenced by the stop area, see ResolveDisruptions
+	disruptions []Disruption
+}
+
+// ResolveDisruptions attaches to the stop area the disruptions it references, looked up in the given disruptions.
+func (sa *StopArea) ResolveDisruptions(disruptions []Disruption) {
+	sa.disruptions = linkedDisruptions(sa.Links, indexDisruptions(disruptions))
+}
+
+// Disruptions returns the disruptions affecting the stop area.
+//
+// Disruptions are only available once resolved from the response's disruptions, see ResolveDisruptions.
+func (sa StopArea) Disruptions() []Disruption {
+	return sa.disruptions
 }
 
 // A POIType codes for the type of the point of interest
@@ -52,6 +75,29 @@ type POI struct {
 
 	// The type of the POI
 	Type POIType `json:"poi_type"`
+
+	// Coordinates of the POI
+	Coord Coordinates `json:"coord"`
+
+	// Address of the POI, if known
+	Address *Address `json:"address"`
+
+	// Administrative regions in which the POI is placed
+	Admins []Admin `json:"administrative_regions"`
+
+	// Properties of the POI, taken as-is from the data, such as "capacity" or "operator"
+	Properties map[string]string `json:"properties"`
+
+	// Stands gives the availability of a bike-share station, when the POI is one and it is requested
+	Stands *Stands `json:"stands"`
+}
+
+// Stands gives the realtime availability of a bike-share station
+type Stands struct {
+	AvailablePlaces uint   `json:"available_places"` // Free docks, where a bike can be returned
+	AvailableBikes  uint   `json:"available_bikes"`  // Bikes available for hire
+	TotalStands     uint   `json:"total_stands"`     // Total number of docks
+	Status          string `json:"status"`           // Status of the station, such as "open", "closed" or "unavailable"
 }
 
 // An Address codes for a real-world address: a point located in a street.
@@ -67,11 +113,31 @@ type Address struct {
 	// Coordinates of the address
 	Coord Coordinates `json:"coord"`
 
-	// House number of the address
+	// House number of the address, 0 if there is none
 	HouseNumber uint `json:"house_number"`
 
 	// Administrative regions of the stop area in which is placed the stop area
 	Admins []Admin `json:"administrative_regions"`
+
+	// These are only present for interpolated addresses, whose position is estimated along a street segment.
+
+	// Range of house numbers of the street segment, [0 0] if not given
+	HouseNumberRange [2]uint `json:"house_number_range"`
+
+	// Side of the street ("left" or "right"), empty if not given
+	Side string `json:"side"`
+
+	// Distance in meters along the street segment between the address and the exact point, 0 if not given
+	Distance float64 `json:"distance"`
+}
+
+// FullStreet returns the house number and the street name of the address, eg "9 Rue Abel".
+// If the address has no house number, only the street name is returned.
+func (a Address) FullStreet() string {
+	if a.HouseNumber == 0 {
+		return a.Name
+	}
+	return strconv.FormatUint(uint64(a.HouseNumber), 10) + " " + a.Name
 }
 
 // A StopPoint codes for a stop point in a line: a location where vehicles can pickup or drop off passengers.
@@ -90,18 +156,24 @@ type StopPoint struct {
 	Admins []Admin `json:"administrative_regions"`
 
 	// List of equipments of the stop point
-	Equipments []Equipment `json:"equipment"`
+	Equipments []Equipment `json:"equipments"`
 
 	// Stop Area countaining the stop point
 	StopArea *StopArea `json:"stop_area"`
 
 	CommercialModes []CommercialMode `json:"commercial_modes"`
 
-	Links []Link `json:"links"`
+	Links Links `json:"links"`
 
 	PhysicalModes []PhysicalMode `json:"physical_modes"`
 
 	FareZone FareZone `json:"fare_zone"`
+
+	// Codes of the stop point in other systems (GTFS, source data...), see Platform
+	Codes []Code `json:"codes"`
+
+	// Entrances and exits of the station leading to the stop point, when the data provides them
+	AccessPoints []AccessPoint `json:"access_points"`
 }
 
 // An Admin represents an administrative region: a region under the control/responsibility of a specific organisation.
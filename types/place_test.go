@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestAddress_interpolated checks that an interpolated address is decoded along with its segment information
+func TestAddress_interpolated(t *testing.T) {
+	data := testData["container"].correct["interpolated_address.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	c := &Container{}
+	if err := json.Unmarshal(data, c); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	obj, err := c.Object()
+	if err != nil {
+		t.Fatalf("error while retrieving object: %v", err)
+	}
+	addr, ok := obj.(*Address)
+	if !ok {
+		t.Fatalf("expected an *Address, got %T", obj)
+	}
+
+	if addr.HouseNumberRange != [2]uint{1, 21} || addr.Side != "left" || addr.Distance != 12.5 {
+		t.Errorf("unexpected interpolation information: range %v, side %q, distance %f", addr.HouseNumberRange, addr.Side, addr.Distance)
+	}
+	if got := addr.FullStreet(); got != "9 Rue Abel" {
+		t.Errorf("unexpected FullStreet: got %q", got)
+	}
+}
+
+func TestAddress_FullStreet(t *testing.T) {
+	tests := []struct {
+		addr     Address
+		expected string
+	}{
+		{Address{Name: "Rue Abel", HouseNumber: 9}, "9 Rue Abel"},
+		{Address{Name: "Avenue Greffulhe"}, "Avenue Greffulhe"},
+	}
+
+	for _, test := range tests {
+		if got := test.addr.FullStreet(); got != test.expected {
+			t.Errorf("FullStreet of %#v: got %q, expected %q", test.addr, got, test.expected)
+		}
+	}
+}
+
+// TestStopPoint_Platform checks the platform extraction from codes, labels, and its absence
+func TestStopPoint_Platform(t *testing.T) {
+	data := testData["container"].correct["rail_platform.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	c := &Container{}
+	if err := json.Unmarshal(data, c); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	obj, err := c.Object()
+	if err != nil {
+		t.Fatalf("error while retrieving object: %v", err)
+	}
+	sp, ok := obj.(*StopPoint)
+	if !ok {
+		t.Fatalf("expected a *StopPoint, got %T", obj)
+	}
+
+	tests := []struct {
+		name     string
+		sp       StopPoint
+		platform string
+		ok       bool
+	}{
+		{"codes", *sp, "11", true},
+		{"label", StopPoint{Label: "Lyon Part-Dieu - Voie K"}, "K", true},
+		{"name", StopPoint{Name: "Zürich HB Gleis 31"}, "31", true},
+		{"none", StopPoint{Name: "Nation", Label: "Nation (Paris)"}, "", false},
+	}
+
+	for _, test := range tests {
+		platform, ok := test.sp.Platform()
+		if platform != test.platform || ok != test.ok {
+			t.Errorf("%s: expected (%q, %t), got (%q, %t)", test.name, test.platform, test.ok, platform, ok)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+)
+
+// platformCodeTypes are the types of codes holding the platform of a stop point, as given by show_codes.
+// They are compared case-insensitively.
+var platformCodeTypes = [...]string{"platform_code", "platform", "quay", "track"}
+
+// platformLabel matches the platform in a label, such as "Gare de Lyon - Voie 3" or "Central Station Platform 9B"
+var platformLabel = regexp.MustCompile(`(?i)\b(?:platform|track|quay|quai|voie|gleis|binario)\s+([0-9]+[a-z]?|[a-z])\b`)
+
+// Platform returns the platform (or quay, or track) of the stop point, such as for a "board at platform 3" display.
+//
+// The platform is looked up in the stop point's codes first, which requires the request to be made with show_codes,
+// then in its label and name. If the stop point has no platform information, ok is false.
+func (sp StopPoint) Platform() (platform string, ok bool) {
+	for _, t := range platformCodeTypes {
+		for _, c := range sp.Codes {
+			if strings.EqualFold(c.Type, t) && c.Value != "" {
+				return c.Value, true
+			}
+		}
+	}
+
+	for _, s := range [...]string{sp.Label, sp.Name} {
+		if m := platformLabel.FindStringSubmatch(s); m != nil {
+			return m[1], true
+		}
+	}
+
+	return "", false
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mb0/wkt"
 	"github.com/pkg/errors"
 	"github.com/twpayne/go-geom"
+	geomwkt "github.com/twpayne/go-geom/encoding/wkt"
 )
 
 // A Region holds information about a geographical region, including its ID, name & shape.
@@ -16,6 +17,10 @@ type Region struct {
 	Name   string `json:"name"`   // Name of the region
 	Status string `json:"status"` // Status of the dataset
 
+	// Timezone of the region, as an IANA Time Zone database name such as "Europe/Paris", see Region.Location.
+	// The datetimes of the region are given in its local time.
+	Timezone string `json:"timezone"`
+
 	// Shape of the region.
 	// You can use it to check if a particular coordinate is within that MultiPolygon
 	Shape *geom.MultiPolygon `json:"shape"`
@@ -38,6 +43,8 @@ type jsonRegion struct {
 	Name   *string `json:"name"`
 	Status *string `json:"status"`
 
+	Timezone *string `json:"timezone"`
+
 	// This is mind-fuckery of the highest level.
 	// While EVERY other geojson value returned by navitia is in standard format, THIS ONE, for NO GOOD REASON is coded in wkt...
 	// See (http://en.wikipedia.org/wiki/Well-known_text).
@@ -56,10 +63,11 @@ type jsonRegion struct {
 func (r *Region) UnmarshalJSON(b []byte) error {
 	// First let's create the analogous structure
 	data := &jsonRegion{
-		ID:     &r.ID,
-		Name:   &r.Name,
-		Status: &r.Status,
-		Error:  &r.Error,
+		ID:       &r.ID,
+		Name:     &r.Name,
+		Status:   &r.Status,
+		Timezone: &r.Timezone,
+		Error:    &r.Error,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -117,6 +125,44 @@ func (r *Region) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler for a Region
+func (r Region) MarshalJSON() ([]byte, error) {
+	data := jsonRegion{
+		ID:              &r.ID,
+		Name:            &r.Name,
+		Status:          &r.Status,
+		Timezone:        &r.Timezone,
+		DatasetCreation: formatDateTime(r.DatasetCreation),
+		LastLoaded:      formatDateTime(r.LastLoaded),
+		ProductionStart: formatDate(r.ProductionStart),
+		ProductionEnd:   formatDate(r.ProductionEnd),
+		Error:           &r.Error,
+	}
+
+	if r.Shape != nil {
+		shape, err := geomwkt.Marshal(r.Shape)
+		if err != nil {
+			return nil, errors.Wrap(err, "error while marshalling the shape of a Region")
+		}
+		data.Shape = shape
+	}
+
+	return json.Marshal(data)
+}
+
+// Location returns the timezone of the region, loaded from the IANA Time Zone database, see time.LoadLocation.
+// It returns an error if the region has no known timezone.
+func (r Region) Location() (*time.Location, error) {
+	if r.Timezone == "" {
+		return nil, errors.Errorf("no timezone given for region %q", r.ID)
+	}
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unknown timezone %q for region %q", r.Timezone, r.ID)
+	}
+	return loc, nil
+}
+
 // convertWktMPtoGeomMP converts a wkt MultiPolygon to a geom MultiPolygon
 func convertWktMPtoGeomMP(in *wkt.MultiPolygon) (*geom.MultiPolygon, error) {
 	// Now let's convert it to a geom format
@@ -0,0 +1,40 @@
+package types
+
+// RidesharingInformations describes a ridesharing offer, given along ridesharing sections.
+type RidesharingInformations struct {
+	// Operator & network offering the ride, such as "Karos"
+	Operator string `json:"operator"`
+	Network  string `json:"network"`
+
+	Driver RidesharingDriver `json:"driver"`
+	Seats  RidesharingSeats  `json:"seats"`
+}
+
+// RidesharingDriver describes the driver of a ridesharing offer.
+type RidesharingDriver struct {
+	Alias  string `json:"alias"`
+	Gender string `json:"gender"`
+
+	// Image is the URL of a picture of the driver
+	Image string `json:"image"`
+
+	// Rate is the rating of the driver, out of 5, given by RateCount passengers
+	Rate      float64 `json:"rate"`
+	RateCount uint    `json:"rate_count"`
+}
+
+// RidesharingSeats holds the number of seats of a ridesharing offer.
+type RidesharingSeats struct {
+	Total     uint `json:"total"`
+	Available uint `json:"available"`
+}
+
+// A BoardingPosition is a part of a vehicle, where it is best to board for a quick connection or exit.
+type BoardingPosition string
+
+// BoardingPositionXXX are the known boarding positions, see Section.BestBoardingPositions
+const (
+	BoardingPositionFront  BoardingPosition = "FRONT"
+	BoardingPositionMiddle BoardingPosition = "MIDDLE"
+	BoardingPositionBack   BoardingPosition = "BACK"
+)
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// A RouteSchedule is the timetable of a route: a table whose columns are the vehicle journeys and whose rows are the stop points they serve.
+//
+// See http://doc.navitia.io/#route-schedules
+type RouteSchedule struct {
+	Display Display       `json:"display_informations"` // Information to display about the route
+	Table   ScheduleTable `json:"table"`                // The timetable itself
+	Links   Links         `json:"links"`                // Links to related objects (route, line...)
+}
+
+// A ScheduleTable is the table of a RouteSchedule.
+//
+// Rows[i].DateTimes[j] is the passing time at Rows[i].StopPoint of the vehicle journey described in Headers[j].
+type ScheduleTable struct {
+	Headers []ScheduleHeader `json:"headers"`
+	Rows    []ScheduleRow    `json:"rows"`
+}
+
+// A ScheduleHeader describes a column of a ScheduleTable, that is a vehicle journey.
+type ScheduleHeader struct {
+	Display    Display  `json:"display_informations"`    // Information to display about the vehicle journey
+	Additional []string `json:"additional_informations"` // Additional information about the vehicle journey, such as "odt_with_stop_time"
+	Links      Links    `json:"links"`                   // Links to related objects, such as the vehicle journey
+}
+
+// A ScheduleRow is a row of a ScheduleTable: the passing times of every vehicle journey at a stop point.
+type ScheduleRow struct {
+	StopPoint StopPoint          `json:"stop_point"`
+	DateTimes []ScheduleDateTime `json:"date_times"`
+}
+
+// A ScheduleDateTime is a cell of a ScheduleTable.
+//
+// If the vehicle journey doesn't serve the stop point, DateTime is the zero value, see Empty.
+type ScheduleDateTime struct {
+	DateTime      time.Time     // Passing time
+	Additional    []string      // Additional information about this passing time
+	Links         Links         // Links to related objects
+	DataFreshness DataFreshness // Whether this passing time is realtime or base schedule data
+}
+
+// jsonScheduleDateTime define the JSON implementation of ScheduleDateTime struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonScheduleDateTime struct {
+	Additional    *[]string      `json:"additional_informations"`
+	Links         *Links         `json:"links"`
+	DataFreshness *DataFreshness `json:"data_freshness"`
+
+	// Value to process
+	DateTime string `json:"date_time"`
+}
+
+// Empty reports whether the cell is empty, i.e the vehicle journey doesn't serve the stop point.
+func (sdt ScheduleDateTime) Empty() bool {
+	return sdt.DateTime.IsZero()
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a ScheduleDateTime
+func (sdt *ScheduleDateTime) UnmarshalJSON(b []byte) error {
+	data := &jsonScheduleDateTime{
+		Additional:    &sdt.Additional,
+		Links:         &sdt.Links,
+		DataFreshness: &sdt.DataFreshness,
+	}
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling ScheduleDateTime: %w", err)
+	}
+
+	// Create the error generator
+	gen := unmarshalErrorMaker{"ScheduleDateTime", b}
+
+	// An empty cell has an empty (or null) date_time, which parseDateTime maps to the zero value
+	sdt.DateTime, err = parseDateTime(data.DateTime)
+	if err != nil {
+		return gen.err(err, "DateTime", "date_time", data.DateTime, "parseDateTime failed")
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler for a ScheduleDateTime
+func (sdt ScheduleDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonScheduleDateTime{
+		Additional:    &sdt.Additional,
+		Links:         &sdt.Links,
+		DataFreshness: &sdt.DataFreshness,
+		DateTime:      formatDateTime(sdt.DateTime),
+	})
+}
@@ -14,3 +14,29 @@ import (
 func Test_Route_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["route"], reflect.TypeOf(Route{}))
 }
+
+// TestRoute_UnmarshalJSON checks the decoding of the direction, modes and shape of a route
+func TestRoute_UnmarshalJSON(t *testing.T) {
+	data := testData["route"].correct["branch.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	r := &Route{}
+	if err := r.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if r.Frequence || r.Line.Code != "13" {
+		t.Errorf("unexpected route: %+v", r)
+	}
+	if r.Direction.ID != "stop_area:RAT:SA:COURT" {
+		t.Errorf("unexpected direction: %s", r.Direction.ID)
+	}
+	if len(r.PhysicalModes) != 1 || r.PhysicalModes[0].ID != "physical_mode:Metro" {
+		t.Errorf("unexpected physical modes: %+v", r.PhysicalModes)
+	}
+	if r.Geo == nil || r.Geo.NumLineStrings() != 1 || r.Geo.LineString(0).NumCoords() != 5 {
+		t.Errorf("unexpected shape: %v", r.Geo)
+	}
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/twpayne/go-geom"
-	"github.com/twpayne/go-geom/encoding/geojson"
 )
 
 // A Section holds information about a specific section
@@ -20,10 +19,42 @@ type Section struct {
 	Arrival    time.Time        // Arrival time
 	Duration   time.Duration    // Duration of travel
 	Path       []PathSegment    // The path taken by this section
-	Geo        *geom.LineString // The path in geojson format
+	Geo        *geom.LineString // The path in geojson format, see GeoProperties
 	StopTimes  []StopTime       // List of the stop times of this section
 	Display    Display          // Information to display
 	Additional []PTMethod       // Additional informations, from what I can see this is always a PTMethod
+	Links      Links            // Links to related objects, such as the vehicle journey or notes
+	Vias       []Via            // Access points through which the section enters or leaves a station, see Via
+
+	// Base (scheduled) departure & arrival times, before any realtime update.
+	// Only given for public transport sections, they are zero otherwise.
+	// See Delay.
+	BaseDeparture time.Time
+	BaseArrival   time.Time
+
+	// CO2Emissions of the section
+	CO2Emissions CO2Emissions
+
+	// GeoProperties holds the properties given along Geo, such as the length & duration of its segments.
+	//
+	// When there's one element per segment of Geo, GeoProperties[i] describes the segment going from Geo.Coord(i) to Geo.Coord(i+1).
+	// The API may also give a single element describing Geo as a whole, so check its length against Geo.NumCoords()-1 before relying on it.
+	// It isn't aligned with Path, whose segments are ways spanning any number of coordinates.
+	GeoProperties []PathSegmentGeo
+
+	// notes are the notes referenced by the section, see ResolveNotes
+	notes []Note
+
+	// RidesharingInformations describes the ride offered in a ridesharing section.
+	// RidesharingJourneys are the journeys of the ride itself, from the pick up to the drop off.
+	RidesharingInformations RidesharingInformations
+	RidesharingJourneys     []Journey
+
+	// BestBoardingPositions are where it is best to board the vehicle, for a quick connection or exit at the destination
+	BestBoardingPositions []BoardingPosition
+
+	// freshness is the freshness of the section as given by the API, see DataFreshness
+	freshness DataFreshness
 }
 
 // jsonSection define the JSON implementation of Section struct
@@ -33,21 +64,42 @@ type jsonSection struct {
 	// Pointers to the corresponding real values
 	Type       *SectionType   `json:"type"`
 	ID         *ID            `json:"id"`
-	From       *Container     `json:"from"`
-	To         *Container     `json:"to"`
+	From       *Container     `json:"from,omitempty"`
+	To         *Container     `json:"to,omitempty"`
 	Mode       *string        `json:"mode"`
 	StopTimes  *[]StopTime    `json:"stop_date_times"`
 	Display    *Display       `json:"display_informations"`
 	Additional *[]PTMethod    `json:"additional_informations"`
 	Path       *[]PathSegment `json:"path"`
+	Links      *Links         `json:"links"`
+	Freshness  *DataFreshness `json:"data_freshness"`
+	Vias       *[]Via         `json:"vias"`
+	CO2        *CO2Emissions  `json:"co2_emission"`
+
+	RidesharingInformations *RidesharingInformations `json:"ridesharing_informations,omitempty"`
+	RidesharingJourneys     *[]Journey               `json:"ridesharing_journeys,omitempty"`
+	BestBoardingPositions   *[]BoardingPosition      `json:"best_boarding_positions,omitempty"`
 
 	// Values to process
-	Departure string            `json:"departure_date_time"`
-	Arrival   string            `json:"arrival_date_time"`
-	Duration  int64             `json:"duration"`
-	Geo       *geojson.Geometry `json:"geojson"`
+	Departure     string          `json:"departure_date_time"`
+	Arrival       string          `json:"arrival_date_time"`
+	BaseDeparture string          `json:"base_departure_date_time"`
+	BaseArrival   string          `json:"base_arrival_date_time"`
+	Duration      int64           `json:"duration"`
+	Geo           *jsonSectionGeo `json:"geojson"`
+}
+
+// jsonSectionGeo is the geojson of a section, along with its properties.
+// As it is always a 2D line string, the coordinates are decoded directly, which is much cheaper than going through geojson.Geometry.
+type jsonSectionGeo struct {
+	Type        string           `json:"type"`
+	Coordinates *[][2]float64    `json:"coordinates"`
+	Properties  []PathSegmentGeo `json:"properties"`
 }
 
+// geojsonLineString is the geojson type of a line string
+const geojsonLineString = "LineString"
+
 // A SectionType codifies the type of section that can be encountered
 type SectionType string
 
@@ -107,14 +159,53 @@ var SectionTypes = map[SectionType]string{
 // A StopTime stores info about a stop in a route: when the vehicle comes in, when it comes out, and what stop it is.
 type StopTime struct {
 	// The PTDateTime of the stop, this stores the info about the arrival & departure
-	PTDateTime       PTDateTime
-	StopPoint        StopPoint `json:"stop_point"` // The stop point in question
-	DropOffAllowed   bool      `json:"drop_off_allowed"`
-	UTCDepartureTime string    `json:"utc_departure_time"`
-	Headsign         string    `json:"headsign"`
-	UTCArrivalTime   string    `json:"utc_arrival_time"`
-	PickupAllowed    bool      `json:"pickup_allowed"`
-	DepartureTime    string    `json:"departure_time"`
+	PTDateTime     PTDateTime `json:"-"`
+	StopPoint      StopPoint  `json:"stop_point"` // The stop point in question
+	DropOffAllowed bool       `json:"drop_off_allowed"`
+	Headsign       string     `json:"headsign"`
+	PickupAllowed  bool       `json:"pickup_allowed"`
+
+	// DepartureTime & ArrivalTime are the times of day of the stop, in the timezone of the coverage,
+	// while their UTC counterparts are in UTC.
+	// As the API gives no date, they are on the first day of year 0 (or the day after, for times past midnight):
+	// use DepartureOn & ArrivalOn to get them on a given day.
+	// They are the zero value when not given.
+	DepartureTime    time.Time `json:"-"`
+	ArrivalTime      time.Time `json:"-"`
+	UTCDepartureTime time.Time `json:"-"`
+	UTCArrivalTime   time.Time `json:"-"`
+
+	// Raw holds the times as given by the API
+	Raw StopTimeRaw `json:"-"`
+}
+
+// StopTimeRaw holds the times of a StopTime as given by the API, formatted as hhmmss (see TimeFormat).
+type StopTimeRaw struct {
+	DepartureTime    string `json:"departure_time"`
+	ArrivalTime      string `json:"arrival_time"`
+	UTCDepartureTime string `json:"utc_departure_time"`
+	UTCArrivalTime   string `json:"utc_arrival_time"`
+}
+
+// DepartureOn returns the departure time of the stop on the given day, in the location of day.
+// The day should be in the timezone of the coverage, as DepartureTime is.
+// It returns the zero value if there is no departure time.
+func (st StopTime) DepartureOn(day time.Time) time.Time {
+	return timeOfDayOn(st.DepartureTime, day)
+}
+
+// ArrivalOn returns the arrival time of the stop on the given day, see DepartureOn.
+func (st StopTime) ArrivalOn(day time.Time) time.Time {
+	return timeOfDayOn(st.ArrivalTime, day)
+}
+
+// timeOfDayOn sets a time parsed by parseTimeOfDay on the given day
+func timeOfDayOn(t time.Time, day time.Time) time.Time {
+	if t.IsZero() {
+		return time.Time{}
+	}
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return midnight.Add(t.Sub(timeOfDayOrigin))
 }
 
 // A PTMethod is a Public Transportation method: it can be regular, estimated times or ODT (on-demand transport)
@@ -156,6 +247,14 @@ func (s *Section) UnmarshalJSON(b []byte) error {
 		Additional: &s.Additional,
 		StopTimes:  &s.StopTimes,
 		Path:       &s.Path,
+		Links:      &s.Links,
+		Freshness:  &s.freshness,
+		Vias:       &s.Vias,
+		CO2:        &s.CO2Emissions,
+
+		RidesharingInformations: &s.RidesharingInformations,
+		RidesharingJourneys:     &s.RidesharingJourneys,
+		BestBoardingPositions:   &s.BestBoardingPositions,
 	}
 
 	// Now unmarshall the raw data into the analogous structure
@@ -176,6 +275,14 @@ func (s *Section) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return gen.err(err, "Arrival", "arrival_date_time", data.Arrival, "parseDateTime failed")
 	}
+	s.BaseDeparture, err = parseDateTime(data.BaseDeparture)
+	if err != nil {
+		return gen.err(err, "BaseDeparture", "base_departure_date_time", data.BaseDeparture, "parseDateTime failed")
+	}
+	s.BaseArrival, err = parseDateTime(data.BaseArrival)
+	if err != nil {
+		return gen.err(err, "BaseArrival", "base_arrival_date_time", data.BaseArrival, "parseDateTime failed")
+	}
 
 	// As the given duration is in second, let's multiply it by one second to have the correct value
 	s.Duration = time.Duration(data.Duration) * time.Second
@@ -187,41 +294,228 @@ func (s *Section) UnmarshalJSON(b []byte) error {
 			return gen.err(nil, "Geo", "geojson", data.Geo, "Geo.Coordinates is nil, can't continue as that will cause a panic")
 		}
 
-		// Let's decode it
-		geot, err := data.Geo.Decode()
-		if err != nil {
-			return gen.err(err, "Geo", "geojson", data.Geo, "Geo.Decode() failed")
+		// Let's check the type
+		if data.Geo.Type != geojsonLineString {
+			return gen.err(nil, "Geo", "geojson", data.Geo, "Geo type assertion failed!")
 		}
-		// And let's assert the type
-		geo, ok := geot.(*geom.LineString)
-		if !ok {
-			return gen.err(err, "Geo", "geojson", data.Geo, "Geo type assertion failed!")
+
+		// Now let's flatten the coordinates and assign them
+		coords := *data.Geo.Coordinates
+		flat := make([]float64, 0, 2*len(coords))
+		for _, c := range coords {
+			flat = append(flat, c[0], c[1])
 		}
-		// Now let's assign it
-		s.Geo = geo
+		s.Geo = geom.NewLineStringFlat(geom.XY, flat)
+		s.GeoProperties = data.Geo.Properties
 	}
 
 	return nil
 }
 
-// UnmarshalJSON implements json.Unmarshaller for a PTDateTime
-func (ptdt *PTDateTime) UnmarshalJSON(b []byte) error {
-	// First let's create the analogous structure
+// MarshalJSON implements json.Marshaler for a Section
+func (s Section) MarshalJSON() ([]byte, error) {
+	data := jsonSection{
+		Type:          &s.Type,
+		ID:            &s.ID,
+		From:          s.From.orNil(),
+		To:            s.To.orNil(),
+		Mode:          &s.Mode,
+		StopTimes:     &s.StopTimes,
+		Display:       &s.Display,
+		Additional:    &s.Additional,
+		Path:          &s.Path,
+		Links:         &s.Links,
+		Freshness:     &s.freshness,
+		Vias:          &s.Vias,
+		CO2:           &s.CO2Emissions,
+		Departure:     formatDateTime(s.Departure),
+		Arrival:       formatDateTime(s.Arrival),
+		BaseDeparture: formatDateTime(s.BaseDeparture),
+		BaseArrival:   formatDateTime(s.BaseArrival),
+		Duration:      int64(s.Duration / time.Second),
+	}
+
+	// These are only given for some sections, so they're left out when empty
+	if s.RidesharingInformations != (RidesharingInformations{}) {
+		data.RidesharingInformations = &s.RidesharingInformations
+	}
+	if len(s.RidesharingJourneys) != 0 {
+		data.RidesharingJourneys = &s.RidesharingJourneys
+	}
+	if len(s.BestBoardingPositions) != 0 {
+		data.BestBoardingPositions = &s.BestBoardingPositions
+	}
+
+	if s.Geo != nil {
+		coords := make([][2]float64, s.Geo.NumCoords())
+		for i := range coords {
+			c := s.Geo.Coord(i)
+			coords[i] = [2]float64{c.X(), c.Y()}
+		}
+		data.Geo = &jsonSectionGeo{
+			Type:        geojsonLineString,
+			Coordinates: &coords,
+			Properties:  s.GeoProperties,
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// Delay returns how late the section arrives compared to its base schedule, negative if it arrives early.
+// It is zero if the section has no base schedule, such as street network, transfer & waiting sections.
+func (s Section) Delay() time.Duration {
+	if s.BaseArrival.IsZero() || s.Arrival.IsZero() {
+		return 0
+	}
+	return s.Arrival.Sub(s.BaseArrival)
+}
+
+// Notes returns the notes attached to the section, such as "reservation required".
+//
+// Notes are only available once resolved from the response's notes, see Journey.ResolveNotes.
+func (s Section) Notes() []Note {
+	return s.notes
+}
+
+// Via returns the access point the given path segment of the section goes through, with its pathway.
+//
+// ok is false if the segment doesn't go through an access point, or if the section doesn't describe it.
+func (s Section) Via(ps PathSegment) (via Via, ok bool) {
+	if ps.ViaID == "" {
+		return Via{}, false
+	}
+	for _, v := range s.Vias {
+		if v.ID == ps.ViaID || v.AccessPoint.ID == ps.ViaID {
+			return v, true
+		}
+	}
+	return Via{}, false
+}
+
+// linkTypeTrip is the type of links referencing a Trip
+const linkTypeTrip = "trip"
+
+// TripID returns the ID of the trip of a public transport section, allowing it to be matched with external feeds keyed by trip id (such as GTFS-RT).
+//
+// The trip is taken from the section's links, if there is none (as for walking or crow fly sections), ok is false.
+func (s Section) TripID() (id ID, ok bool) {
+	for _, l := range s.Links {
+		if l.Type == linkTypeTrip && l.ID != "" {
+			return l.ID, true
+		}
+	}
+	return "", false
+}
+
+// linkTypeVehicleJourney is the type of links referencing a VehicleJourney
+const linkTypeVehicleJourney = "vehicle_journey"
+
+// VehicleJourneyID returns the ID of the vehicle journey of a public transport section, so that it can be inspected with the vehicle_journeys API.
+//
+// As for TripID, it is taken from the section's links, and ok is false if there is none.
+func (s Section) VehicleJourneyID() (id ID, ok bool) {
+	for _, l := range s.Links {
+		if l.Type == linkTypeVehicleJourney && l.ID != "" {
+			return l.ID, true
+		}
+	}
+	return "", false
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a StopTime.
+// The date times are at the same level as the other fields, so the PTDateTime is decoded from the same object, in a single pass.
+func (st *StopTime) UnmarshalJSON(b []byte) error {
+	// stopTime has the same fields as StopTime but not its methods, avoiding infinite recursion
+	type stopTime StopTime
 	data := &struct {
-		Departure string `json:"departure_date_time"`
-		Arrival   string `json:"arrival_date_time"`
-	}{}
+		*stopTime
+		*StopTimeRaw
+		jsonPTDateTime
+	}{
+		stopTime:       (*stopTime)(st),
+		StopTimeRaw:    &st.Raw,
+		jsonPTDateTime: newJSONPTDateTime(&st.PTDateTime),
+	}
 
 	// Now unmarshall the raw data into the analogous structure
-	err := json.Unmarshal(b, data)
-	if err != nil {
-		return fmt.Errorf("error while unmarshalling PTDateTime: %w", err)
+	if err := json.Unmarshal(b, data); err != nil {
+		return fmt.Errorf("error while unmarshalling StopTime: %w", err)
 	}
 
 	// Create the error generator
-	gen := unmarshalErrorMaker{"PTDateTime", b}
+	gen := unmarshalErrorMaker{"StopTime", b}
+
+	// Parse the times
+	times := []struct {
+		field, key string
+		raw        string
+		dst        *time.Time
+	}{
+		{"DepartureTime", "departure_time", st.Raw.DepartureTime, &st.DepartureTime},
+		{"ArrivalTime", "arrival_time", st.Raw.ArrivalTime, &st.ArrivalTime},
+		{"UTCDepartureTime", "utc_departure_time", st.Raw.UTCDepartureTime, &st.UTCDepartureTime},
+		{"UTCArrivalTime", "utc_arrival_time", st.Raw.UTCArrivalTime, &st.UTCArrivalTime},
+	}
+	for _, t := range times {
+		parsed, err := parseTimeOfDay(t.raw)
+		if err != nil {
+			return gen.err(err, t.field, t.key, t.raw, "parseTimeOfDay failed")
+		}
+		*t.dst = parsed
+	}
 
-	// Now we use parseDateTime
+	return data.jsonPTDateTime.process(&st.PTDateTime, gen)
+}
+
+// MarshalJSON implements json.Marshaler for a StopTime.
+// The times are written from the parsed ones, which give back the values given by the API.
+func (st StopTime) MarshalJSON() ([]byte, error) {
+	type stopTime StopTime
+	return json.Marshal(struct {
+		stopTime
+		StopTimeRaw
+		jsonPTDateTime
+	}{
+		stopTime: stopTime(st),
+		StopTimeRaw: StopTimeRaw{
+			DepartureTime:    formatTimeOfDay(st.DepartureTime),
+			ArrivalTime:      formatTimeOfDay(st.ArrivalTime),
+			UTCDepartureTime: formatTimeOfDay(st.UTCDepartureTime),
+			UTCArrivalTime:   formatTimeOfDay(st.UTCArrivalTime),
+		},
+		jsonPTDateTime: st.PTDateTime.json(),
+	})
+}
+
+// jsonPTDateTime define the JSON implementation of PTDateTime struct
+// We define some of the value as pointers to the real values,
+// allowing us to bypass copying in cases where we don't need to process the data.
+type jsonPTDateTime struct {
+	// Pointers to the corresponding real values
+	Additional    *[]string      `json:"additional_informations"`
+	Links         *Links         `json:"links"`
+	DataFreshness *DataFreshness `json:"data_freshness"`
+
+	// Values to process
+	Departure     string `json:"departure_date_time"`
+	Arrival       string `json:"arrival_date_time"`
+	BaseDeparture string `json:"base_departure_date_time"`
+	BaseArrival   string `json:"base_arrival_date_time"`
+}
+
+// newJSONPTDateTime creates a jsonPTDateTime pointing to the values of ptdt
+func newJSONPTDateTime(ptdt *PTDateTime) jsonPTDateTime {
+	return jsonPTDateTime{
+		Additional:    &ptdt.Additional,
+		Links:         &ptdt.Links,
+		DataFreshness: &ptdt.DataFreshness,
+	}
+}
+
+// process parses the date times into ptdt
+func (data *jsonPTDateTime) process(ptdt *PTDateTime, gen unmarshalErrorMaker) error {
+	var err error
 	ptdt.Departure, err = parseDateTime(data.Departure)
 	if err != nil {
 		return gen.err(err, "Departure", "departure_date_time", data.Departure, "parseDateTime failed")
@@ -230,6 +524,101 @@ func (ptdt *PTDateTime) UnmarshalJSON(b []byte) error {
 	if err != nil {
 		return gen.err(err, "Arrival", "arrival_date_time", data.Arrival, "parseDateTime failed")
 	}
+	ptdt.BaseDeparture, err = parseDateTime(data.BaseDeparture)
+	if err != nil {
+		return gen.err(err, "BaseDeparture", "base_departure_date_time", data.BaseDeparture, "parseDateTime failed")
+	}
+	ptdt.BaseArrival, err = parseDateTime(data.BaseArrival)
+	if err != nil {
+		return gen.err(err, "BaseArrival", "base_arrival_date_time", data.BaseArrival, "parseDateTime failed")
+	}
 
 	return nil
 }
+
+// json returns the JSON representation of a PTDateTime, the inverse of process
+func (ptdt PTDateTime) json() jsonPTDateTime {
+	return jsonPTDateTime{
+		Additional:    &ptdt.Additional,
+		Links:         &ptdt.Links,
+		DataFreshness: &ptdt.DataFreshness,
+		Departure:     formatDateTime(ptdt.Departure),
+		Arrival:       formatDateTime(ptdt.Arrival),
+		BaseDeparture: formatDateTime(ptdt.BaseDeparture),
+		BaseArrival:   formatDateTime(ptdt.BaseArrival),
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaller for a PTDateTime
+func (ptdt *PTDateTime) UnmarshalJSON(b []byte) error {
+	data := newJSONPTDateTime(ptdt)
+
+	// Now unmarshall the raw data into the analogous structure
+	err := json.Unmarshal(b, &data)
+	if err != nil {
+		return fmt.Errorf("error while unmarshalling PTDateTime: %w", err)
+	}
+
+	// Now we use parseDateTime
+	return data.process(ptdt, unmarshalErrorMaker{"PTDateTime", b})
+}
+
+// MarshalJSON implements json.Marshaler for a PTDateTime
+func (ptdt PTDateTime) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ptdt.json())
+}
+
+// freshnessRank orders the data freshnesses from the most conservative to the most up-to-date
+var freshnessRank = map[DataFreshness]int{
+	DataFreshnessBaseSchedule:    0,
+	DataFreshnessAdaptedSchedule: 1,
+	DataFreshnessRealTime:        2,
+}
+
+// freshness returns the freshness of the date time: the one given by the API if any,
+// otherwise realtime if it differs from the base schedule, otherwise an empty DataFreshness as it can't be known.
+func (ptdt PTDateTime) freshness() DataFreshness {
+	switch {
+	case ptdt.DataFreshness != "":
+		return ptdt.DataFreshness
+	case !ptdt.BaseDeparture.IsZero() && !ptdt.BaseDeparture.Equal(ptdt.Departure),
+		!ptdt.BaseArrival.IsZero() && !ptdt.BaseArrival.Equal(ptdt.Arrival):
+		return DataFreshnessRealTime
+	default:
+		return ""
+	}
+}
+
+// DataFreshness reports whether the times displayed for a public transport section come from realtime data or from the base schedule.
+//
+// Each stop time is inspected: the freshness given by the API is used, and if there is none, a time differing from its base schedule is realtime.
+// Otherwise, the freshness of the section as a whole is used, defaulting to the base schedule.
+// When stop times are mixed (some realtime, some not), the most conservative value is returned, so that a section is only
+// reported as realtime if all of its times are.
+//
+// For sections other than public transport ones, it returns an empty DataFreshness.
+func (s Section) DataFreshness() DataFreshness {
+	if s.Type != SectionPublicTransport && s.Type != SectionOnDemandTransport {
+		return ""
+	}
+
+	fallback := s.freshness
+	if _, ok := freshnessRank[fallback]; !ok {
+		fallback = DataFreshnessBaseSchedule
+	}
+	if len(s.StopTimes) == 0 {
+		return fallback
+	}
+
+	result := DataFreshnessRealTime
+	for _, st := range s.StopTimes {
+		f := st.PTDateTime.freshness()
+		if _, ok := freshnessRank[f]; !ok {
+			f = fallback
+		}
+		if freshnessRank[f] < freshnessRank[result] {
+			result = f
+		}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"reflect"
 	"testing"
+	"time"
 )
 
 // Test_Section_Unmarshal tests unmarshalling for Section.
@@ -14,3 +15,374 @@ import (
 func Test_Section_Unmarshal(t *testing.T) {
 	testUnmarshal(t, testData["section"], reflect.TypeOf(Section{}))
 }
+
+// TestSection_DataFreshness checks that a partially realtime section is reported with the most conservative freshness
+func TestSection_DataFreshness(t *testing.T) {
+	data := testData["section"].correct["partially_realtime.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	// The second stop time has no freshness given, but is delayed
+	if f := s.StopTimes[1].PTDateTime.freshness(); f != DataFreshnessRealTime {
+		t.Errorf("expected a delayed stop time to be realtime, got %q", f)
+	}
+
+	if f := s.DataFreshness(); f != DataFreshnessBaseSchedule {
+		t.Errorf("expected a partially realtime section to be reported as %q, got %q", DataFreshnessBaseSchedule, f)
+	}
+
+	// Once every stop time is realtime, so is the section
+	for i := range s.StopTimes {
+		s.StopTimes[i].PTDateTime.DataFreshness = DataFreshnessRealTime
+	}
+	if f := s.DataFreshness(); f != DataFreshnessRealTime {
+		t.Errorf("expected a fully realtime section to be reported as %q, got %q", DataFreshnessRealTime, f)
+	}
+
+	// Non public transport sections have no freshness
+	if f := (Section{Type: SectionWaiting}).DataFreshness(); f != "" {
+		t.Errorf("expected no freshness for a waiting section, got %q", f)
+	}
+}
+
+// TestSection_TripID checks that the trip & vehicle journey ids of a public transport section are extracted, and that other sections have none
+func TestSection_TripID(t *testing.T) {
+	data := testData["section"].correct["trip.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if id, ok := s.TripID(); !ok || id != "RATRM14REGA9128" {
+		t.Errorf("unexpected trip id: %q (ok: %t)", id, ok)
+	}
+	if id, ok := s.VehicleJourneyID(); !ok || id != "vehicle_journey:RAT:RATRM14REGA9128-1_dst_2" {
+		t.Errorf("unexpected vehicle journey id: %q (ok: %t)", id, ok)
+	}
+
+	if id, ok := (Section{Type: SectionStreetNetwork, Mode: ModeWalking}).TripID(); ok {
+		t.Errorf("expected no trip id for a walking section, got %q", id)
+	}
+	if id, ok := (Section{Type: SectionStreetNetwork, Mode: ModeWalking}).VehicleJourneyID(); ok {
+		t.Errorf("expected no vehicle journey id for a walking section, got %q", id)
+	}
+}
+
+// TestSection_GeoProperties checks that the per-segment properties of a street network section's geojson are preserved
+func TestSection_GeoProperties(t *testing.T) {
+	data := testData["section"].correct["street_network.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if s.Geo == nil {
+		t.Fatal("expected a geometry, got none")
+	}
+	if n := s.Geo.NumCoords() - 1; len(s.GeoProperties) != n {
+		t.Fatalf("expected one property per segment (%d), got %d", n, len(s.GeoProperties))
+	}
+
+	expected := []PathSegmentGeo{
+		{Length: 58, Duration: 45 * time.Second},
+		{Length: 121, Duration: 211 * time.Second},
+	}
+	if !reflect.DeepEqual(s.GeoProperties, expected) {
+		t.Errorf("unexpected properties: got %v, expected %v", s.GeoProperties, expected)
+	}
+}
+
+// TestSection_Via checks that the path segments going through an access point are matched with its pathway
+func TestSection_Via(t *testing.T) {
+	data := testData["section"].correct["access_point.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if len(s.Path) != 2 {
+		t.Fatalf("expected 2 path segments, got %d", len(s.Path))
+	}
+	if via, ok := s.Via(s.Path[0]); ok {
+		t.Errorf("expected the first segment not to go through an access point, got %q", via.ID)
+	}
+
+	via, ok := s.Via(s.Path[1])
+	if !ok {
+		t.Fatal("expected the last segment to go through an access point")
+	}
+	if via.AccessPoint.Coord.Latitude != 48.841512 {
+		t.Errorf("unexpected access point coordinates: %v", via.AccessPoint.Coord)
+	}
+	expected := Pathway{
+		IsEntrance:           true,
+		Mode:                 PathwayModeEscalator,
+		Length:               85,
+		TraversalTime:        160 * time.Second,
+		MinWidth:             1.2,
+		SignpostedAs:         "Grandes lignes",
+		ReversedSignpostedAs: "Sortie Vaugirard",
+	}
+	if via.Pathway != expected {
+		t.Errorf("unexpected pathway: got %+v, expected %+v", via.Pathway, expected)
+	}
+}
+
+// TestSection_Ridesharing checks that the ridesharing offer and the best boarding positions of a section are decoded
+func TestSection_Ridesharing(t *testing.T) {
+	data := testData["section"].correct["ridesharing.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	expected := RidesharingInformations{
+		Operator: "karos",
+		Network:  "Karos",
+		Driver: RidesharingDriver{
+			Alias:     "Jean-Michel",
+			Gender:    "male",
+			Image:     "https://example.com/jean-michel.png",
+			Rate:      4.5,
+			RateCount: 12,
+		},
+		Seats: RidesharingSeats{Total: 4, Available: 2},
+	}
+	if s.RidesharingInformations != expected {
+		t.Errorf("unexpected ridesharing informations:\n\tgot %#v\n\texpected %#v", s.RidesharingInformations, expected)
+	}
+	if len(s.RidesharingJourneys) != 1 || s.RidesharingJourneys[0].Duration != 35*time.Minute {
+		t.Errorf("unexpected ridesharing journeys: %#v", s.RidesharingJourneys)
+	}
+	if !reflect.DeepEqual(s.BestBoardingPositions, []BoardingPosition{BoardingPositionFront, BoardingPositionMiddle}) {
+		t.Errorf("unexpected best boarding positions: %v", s.BestBoardingPositions)
+	}
+}
+
+// TestSection_Delay checks that the delay of a section is computed from its base schedule, and that its CO2 emissions are decoded
+func TestSection_Delay(t *testing.T) {
+	base := time.Date(2017, time.April, 13, 13, 58, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		section  Section
+		expected time.Duration
+	}{
+		{"late", Section{BaseArrival: base, Arrival: base.Add(3 * time.Minute)}, 3 * time.Minute},
+		{"early", Section{BaseArrival: base, Arrival: base.Add(-time.Minute)}, -time.Minute},
+		{"no base schedule", Section{Arrival: base}, 0},
+	}
+	for _, test := range tests {
+		if got := test.section.Delay(); got != test.expected {
+			t.Errorf("%s: expected a delay of %s, got %s", test.name, test.expected, got)
+		}
+	}
+
+	data := testData["section"].correct["trip.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+	if expected := (CO2Emissions{Unit: "gEC", Value: 7.5}); s.CO2Emissions != expected {
+		t.Errorf("unexpected CO2 emissions: got %#v, expected %#v", s.CO2Emissions, expected)
+	}
+}
+
+// TestSection_Instructions checks the turn-by-turn steps of a street network section, with and without instructions from the API
+func TestSection_Instructions(t *testing.T) {
+	const segment = `{
+		"length": 230,
+		"name": "Rue de Rivoli",
+		"duration": 60,
+		"direction": -90,
+		"instruction": "Tournez à gauche sur Rue de Rivoli",
+		"instruction_start_coordinate": {"lat": "48.8566", "lon": "2.3522"},
+		"cycle_path_type": "separated_cycle_way"
+	}`
+
+	var ps PathSegment
+	if err := ps.UnmarshalJSON([]byte(segment)); err != nil {
+		t.Fatalf("error while unmarshalling the path segment: %v", err)
+	}
+	if ps.Turn() != TurnLeft || !ps.IsCyclePath() || ps.InstructionStart != (Coordinates{Longitude: 2.3522, Latitude: 48.8566}) {
+		t.Errorf("unexpected path segment: %#v", ps)
+	}
+
+	s := Section{Type: SectionStreetNetwork, Path: []PathSegment{
+		{Name: "Boulevard de Sébastopol", Length: 120, Duration: time.Minute, Direction: 0},
+		{Name: "Rue Rambuteau", Length: 80, Direction: 30},
+		{Name: "", Length: 10, Direction: 175},
+		ps,
+	}}
+	expected := []string{
+		"Head on Boulevard de Sébastopol",
+		"Bear right on Rue Rambuteau",
+		"Make a U-turn",
+		"Tournez à gauche sur Rue de Rivoli",
+	}
+
+	instructions := s.Instructions()
+	if len(instructions) != len(expected) {
+		t.Fatalf("expected %d instructions, got %d", len(expected), len(instructions))
+	}
+	for i, text := range expected {
+		if instructions[i].Text != text {
+			t.Errorf("instruction %d: expected %q, got %q", i, text, instructions[i].Text)
+		}
+	}
+	if last := instructions[3]; last.Turn != TurnLeft || last.Length != 230 || last.CyclePath != CyclePathSeparated {
+		t.Errorf("unexpected last instruction: %#v", last)
+	}
+
+	if instructions := (Section{Type: SectionPublicTransport}).Instructions(); instructions != nil {
+		t.Errorf("expected no instructions for a section without a path, got %#v", instructions)
+	}
+}
+
+// TestSection_Display checks that the display informations of a section are decoded, colours included
+func TestSection_Display(t *testing.T) {
+	data := testData["section"].correct["trip.json"]
+	if len(data) == 0 {
+		t.Skip("No data to test")
+	}
+
+	s := &Section{}
+	if err := s.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	d := s.Display
+	if d.Color == nil || d.Color.Hex() != "67328E" {
+		t.Errorf("unexpected colour: %v", d.Color)
+	}
+	if d.TextColor == nil || *d.TextColor != (Color{R: 0xff, G: 0xff, B: 0xff}) {
+		t.Errorf("unexpected text colour: %v", d.TextColor)
+	}
+	if d.Links == nil {
+		t.Error("expected the links to be decoded")
+	}
+
+	if (Display{}).Color != nil {
+		t.Error("expected no colour by default")
+	}
+}
+
+// TestStopTime_Times checks the parsing of the times of a stop time, including ones past midnight
+func TestStopTime_Times(t *testing.T) {
+	data := []byte(`{"departure_time":"235500","arrival_time":"243000","utc_departure_time":"215500","headsign":"Gare"}`)
+	st := &StopTime{}
+	if err := st.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if st.Raw.ArrivalTime != "243000" {
+		t.Errorf("unexpected raw arrival time: %q", st.Raw.ArrivalTime)
+	}
+	if !st.UTCArrivalTime.IsZero() {
+		t.Errorf("expected no UTC arrival time, got %s", st.UTCArrivalTime)
+	}
+
+	loc := time.FixedZone("CEST", 2*60*60)
+	day := time.Date(2017, time.April, 13, 10, 0, 0, 0, loc)
+	if got, expected := st.DepartureOn(day), time.Date(2017, time.April, 13, 23, 55, 0, 0, loc); !got.Equal(expected) {
+		t.Errorf("unexpected departure: got %s, expected %s", got, expected)
+	}
+	if got, expected := st.ArrivalOn(day), time.Date(2017, time.April, 14, 0, 30, 0, 0, loc); !got.Equal(expected) {
+		t.Errorf("unexpected arrival: got %s, expected %s", got, expected)
+	}
+	if got := st.UTCDepartureTime.Sub(st.DepartureTime); got != -2*time.Hour {
+		t.Errorf("unexpected offset between local & UTC departure: %s", got)
+	}
+
+	// The times are written back as given
+	b, err := st.MarshalJSON()
+	if err != nil {
+		t.Fatalf("error while marshalling: %v", err)
+	}
+	back := &StopTime{}
+	if err := back.UnmarshalJSON(b); err != nil {
+		t.Fatalf("error while unmarshalling the marshalled stop time: %v", err)
+	}
+	if back.Raw != st.Raw {
+		t.Errorf("times not kept through marshalling: got %+v, expected %+v", back.Raw, st.Raw)
+	}
+
+	if err := (&StopTime{}).UnmarshalJSON([]byte(`{"departure_time":"086100"}`)); err == nil {
+		t.Error("expected an error for an invalid time")
+	}
+}
+
+// TestPTDateTime checks the decoding of a date time with its realtime info, and the delay & skipped stop helpers
+func TestPTDateTime(t *testing.T) {
+	data := []byte(`{
+		"departure_date_time": "20170413T082700", "base_departure_date_time": "20170413T082500",
+		"arrival_date_time": "20170413T082600", "base_arrival_date_time": "20170413T082400",
+		"additional_informations": ["date_time_estimated"],
+		"links": [{"type": "notes", "id": "note:1", "rel": "notes"}],
+		"data_freshness": "realtime"
+	}`)
+	ptdt := &PTDateTime{}
+	if err := ptdt.UnmarshalJSON(data); err != nil {
+		t.Fatalf("error while unmarshalling: %v", err)
+	}
+
+	if len(ptdt.Links) != 1 || ptdt.Links[0].ID != "note:1" {
+		t.Errorf("unexpected links: %+v", ptdt.Links)
+	}
+	if !ptdt.Estimated() {
+		t.Error("expected the date time to be estimated")
+	}
+	if d := ptdt.DepartureDelay(); d != 2*time.Minute {
+		t.Errorf("unexpected departure delay: %s", d)
+	}
+	if d := ptdt.Delay(); d != 2*time.Minute {
+		t.Errorf("unexpected delay: %s", d)
+	}
+	if ptdt.Skipped() {
+		t.Error("expected the stop not to be skipped")
+	}
+
+	tests := []struct {
+		name    string
+		ptdt    PTDateTime
+		delay   time.Duration
+		skipped bool
+	}{
+		{"terminus", PTDateTime{Arrival: ptdt.Arrival, BaseArrival: ptdt.BaseArrival}, 2 * time.Minute, false},
+		{"no base schedule", PTDateTime{Departure: ptdt.Departure}, 0, false},
+		{"deleted", PTDateTime{BaseDeparture: ptdt.BaseDeparture, DataFreshness: DataFreshnessRealTime}, 0, true},
+		{"skipped", PTDateTime{Additional: []string{AdditionalSkippedStop}}, 0, true},
+	}
+	for _, test := range tests {
+		if d := test.ptdt.Delay(); d != test.delay {
+			t.Errorf("%s: expected a delay of %s, got %s", test.name, test.delay, d)
+		}
+		if s := test.ptdt.Skipped(); s != test.skipped {
+			t.Errorf("%s: expected skipped to be %t, got %t", test.name, test.skipped, s)
+		}
+	}
+}
@@ -39,9 +39,8 @@ func (s *Severity) UnmarshalJSON(b []byte) error {
 	// First let's create the analogous structure
 	// We define some of the value as pointers to the real values, allowing us to bypass copying in cases where we don't need to process the data
 	data := &jsonSeverity{
-		Name:     &s.Name,
-		Priority: s.Priority,
-		Effect:   &s.Effect,
+		Name:   &s.Name,
+		Effect: &s.Effect,
 	}
 
 	// Let's create the error generator
@@ -53,6 +52,9 @@ func (s *Severity) UnmarshalJSON(b []byte) error {
 		return fmt.Errorf("error while unmarshalling Severity: %w", err)
 	}
 
+	// Priority is a pointer as it may be null, so it can't be decoded in place
+	s.Priority = data.Priority
+
 	// Process the color
 	if str := data.Color; len(str) == 6 {
 		clr, err := parseColor(str)
@@ -64,3 +66,13 @@ func (s *Severity) UnmarshalJSON(b []byte) error {
 
 	return nil
 }
+
+// MarshalJSON implements json.Marshaler for a Severity
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonSeverity{
+		Name:     &s.Name,
+		Priority: s.Priority,
+		Effect:   &s.Effect,
+		Color:    formatColor(s.Color),
+	})
+}
@@ -0,0 +1,15 @@
+package types
+
+// A StopSchedule is the timetable of a route at a stop point: the list of the times at which its vehicles pass there.
+//
+// See http://doc.navitia.io/#stop-schedules
+type StopSchedule struct {
+	Display   Display            `json:"display_informations"` // Information to display about the route
+	StopPoint StopPoint          `json:"stop_point"`           // The stop point of the schedule
+	Route     Route              `json:"route"`                // The route of the schedule
+	DateTimes []ScheduleDateTime `json:"date_times"`           // The passing times, each with its data freshness
+	Links     Links              `json:"links"`                // Links to related objects (route, line...)
+
+	// Additional information about the schedule, such as "no_departure_this_day" or "terminus"
+	Additional string `json:"additional_informations"`
+}
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"strings"
+)
+
+// A SummaryLocale holds the words & formats used to summarise journeys and sections, see Journey.LocalizedSummary.
+type SummaryLocale struct {
+	// TimeFormat is the layout of the times, as used by time.Time.Format
+	TimeFormat string
+
+	// Departure is written before the origin of a journey, such as "Departure"
+	Departure string
+
+	// Direction is written before the direction of a public transport, such as "dir."
+	Direction string
+
+	// Mode returns the name of a street network mode, such as "walking" or "bike".
+	// If nil, or if it returns an empty string, the mode is written as given by the API.
+	Mode func(mode string) string
+}
+
+var (
+	// SummaryLocaleEN summarises journeys in English, it is used by Journey.Summary and Section.Summary
+	SummaryLocaleEN = SummaryLocale{
+		TimeFormat: "15:04",
+		Departure:  "Departure",
+		Direction:  "dir.",
+	}
+
+	// SummaryLocaleFR summarises journeys in French
+	SummaryLocaleFR = SummaryLocale{
+		TimeFormat: "15:04",
+		Departure:  "Départ",
+		Direction:  "dir.",
+		Mode: func(mode string) string {
+			return frenchModes[mode]
+		},
+	}
+
+	// frenchModes holds the French names of the street network modes
+	frenchModes = map[string]string{
+		"walking":     "à pied",
+		"bike":        "à vélo",
+		"bss":         "en vélo en libre-service",
+		"car":         "en voiture",
+		"ridesharing": "en covoiturage",
+		"taxi":        "en taxi",
+	}
+)
+
+// summarised reports whether a section is part of a journey's summary:
+// waiting, transfer and teleportation sections are left out.
+func (s Section) summarised() bool {
+	switch s.Type {
+	case SectionPublicTransport, SectionOnDemandTransport:
+		return true
+	case SectionStreetNetwork:
+		return s.Duration > 0
+	default:
+		return false
+	}
+}
+
+// step returns how a section is travelled, such as "RER A dir. Poissy" or "walking"
+func (s Section) step(l SummaryLocale) string {
+	if s.Type != SectionPublicTransport && s.Type != SectionOnDemandTransport {
+		if l.Mode != nil {
+			if m := l.Mode(s.Mode); m != "" {
+				return m
+			}
+		}
+		return s.Mode
+	}
+
+	var parts []string
+	for _, p := range []string{string(s.Display.CommercialMode), s.Display.Code} {
+		if p != "" {
+			parts = append(parts, p)
+		}
+	}
+	if s.Display.Direction != "" {
+		parts = append(parts, l.Direction, s.Display.Direction)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Summary returns a human-readable summary of the section in English, such as "08:12 Gare de Lyon → RER A dir. Poissy → 08:41 La Défense".
+// See LocalizedSummary for other languages.
+func (s Section) Summary() string {
+	return s.LocalizedSummary(SummaryLocaleEN)
+}
+
+// LocalizedSummary is the same as Summary, using the given locale.
+func (s Section) LocalizedSummary(l SummaryLocale) string {
+	return s.Departure.Format(l.TimeFormat) + " " + s.From.Name +
+		" → " + s.step(l) +
+		" → " + s.Arrival.Format(l.TimeFormat) + " " + s.To.Name
+}
+
+// Summary returns a human-readable summary of the journey in English,
+// such as "08:12 Departure Gare de Lyon → RER A dir. Poissy → 08:41 La Défense".
+// Waiting, transfer and teleportation sections are left out.
+// See LocalizedSummary for other languages.
+func (j Journey) Summary() string {
+	return j.LocalizedSummary(SummaryLocaleEN)
+}
+
+// LocalizedSummary is the same as Summary, using the given locale.
+func (j Journey) LocalizedSummary(l SummaryLocale) string {
+	var b strings.Builder
+	for _, s := range j.Sections {
+		if !s.summarised() {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString(s.Departure.Format(l.TimeFormat) + " " + l.Departure + " " + s.From.Name)
+		}
+		b.WriteString(" → " + s.step(l) + " → " + s.Arrival.Format(l.TimeFormat) + " " + s.To.Name)
+	}
+	return b.String()
+}
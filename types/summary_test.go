@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJourney_Summary(t *testing.T) {
+	at := func(h, m int) time.Time { return time.Date(2017, time.April, 13, h, m, 0, 0, time.UTC) }
+	j := Journey{Sections: []Section{
+		{
+			Type:      SectionStreetNetwork,
+			Mode:      "walking",
+			From:      Container{Name: "10 Rue de Lyon"},
+			To:        Container{Name: "Gare de Lyon"},
+			Departure: at(8, 5),
+			Arrival:   at(8, 10),
+			Duration:  5 * time.Minute,
+		},
+		{Type: SectionWaiting, Departure: at(8, 10), Arrival: at(8, 12), Duration: 2 * time.Minute},
+		{
+			Type:      SectionPublicTransport,
+			From:      Container{Name: "Gare de Lyon"},
+			To:        Container{Name: "La Défense"},
+			Departure: at(8, 12),
+			Arrival:   at(8, 41),
+			Duration:  29 * time.Minute,
+			Display:   Display{CommercialMode: "RER", Code: "A", Direction: "Poissy"},
+		},
+		{Type: SectionCrowFly, Mode: "walking", Departure: at(8, 41), Arrival: at(8, 41)},
+	}}
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"journey", j.Summary(), "08:05 Departure 10 Rue de Lyon → walking → 08:10 Gare de Lyon → RER A dir. Poissy → 08:41 La Défense"},
+		{"journey (fr)", j.LocalizedSummary(SummaryLocaleFR), "08:05 Départ 10 Rue de Lyon → à pied → 08:10 Gare de Lyon → RER A dir. Poissy → 08:41 La Défense"},
+		{"section", j.Sections[2].Summary(), "08:12 Gare de Lyon → RER A dir. Poissy → 08:41 La Défense"},
+		{"empty", Journey{}.Summary(), ""},
+	}
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("%s: expected %q, got %q", test.name, test.expected, test.got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
+// timeType is the reflect.Type of time.Time, see Localize
+var timeType = reflect.TypeOf(time.Time{})
+
+// Localize sets the datetimes decoded in v in the given location, such as the timezone of the region they were requested in.
+// v must be a pointer to the decoded values, such as a *Journey or a *[]Departure.
+//
+// Navitia gives its datetimes in the local time of the coverage, without any offset,
+// so they are decoded as UTC: Localize keeps their wall clock, but in the given location.
+// Fields named UTCXxx, times of day (see StopTime) and the zero time are left untouched, as are the unexported fields,
+// so the objects embedded in a Container are not localized.
+//
+// Localizing values more than once, or in another location than UTC, has no effect: only times in UTC are localized.
+func Localize(v interface{}, loc *time.Location) {
+	if loc == nil || loc == time.UTC {
+		return
+	}
+	localize(reflect.ValueOf(v), loc, map[uintptr]bool{})
+}
+
+// localize sets the times in v in loc, visited holds the pointers already walked through
+func localize(v reflect.Value, loc *time.Location, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() || visited[v.Pointer()] {
+			return
+		}
+		visited[v.Pointer()] = true
+		localize(v.Elem(), loc, visited)
+	case reflect.Interface:
+		if !v.IsNil() {
+			localize(v.Elem(), loc, visited)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			localize(v.Index(i), loc, visited)
+		}
+	case reflect.Map:
+		// Map values aren't addressable, so only the ones holding pointers can be localized
+		iter := v.MapRange()
+		for iter.Next() {
+			localize(iter.Value(), loc, visited)
+		}
+	case reflect.Struct:
+		if v.Type() == timeType {
+			if v.CanSet() {
+				v.Set(reflect.ValueOf(inLocation(v.Interface().(time.Time), loc)))
+			}
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath != "" || strings.HasPrefix(f.Name, "UTC") {
+				continue
+			}
+			localize(v.Field(i), loc, visited)
+		}
+	}
+}
+
+// inLocation returns the time with the same wall clock in loc, if it was decoded from a datetime given by the API
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	if t.IsZero() || t.Location() != time.UTC || t.Year() == timeOfDayOrigin.Year() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// localized reports whether a datetime given by the API was localized, see Localize.
+// If it wasn't, its location is UTC but its wall clock is the local time of the region, whose offset is unknown.
+func localized(t time.Time) bool {
+	return t.Location() != time.UTC
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLocalize checks that only the datetimes given in local time by the API are localized
+func TestLocalize(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	departure := time.Date(2017, time.April, 13, 8, 25, 0, 0, time.UTC)
+	utc := time.Date(2017, time.April, 13, 6, 25, 0, 0, time.UTC)
+	timeOfDay, _ := parseTimeOfDay("082500")
+
+	j := &Journey{
+		Departure: departure,
+		Sections: []Section{{
+			Departure: departure,
+			StopTimes: []StopTime{{DepartureTime: timeOfDay, UTCDepartureTime: utc}},
+		}},
+	}
+	Localize(j, loc)
+
+	expected := time.Date(2017, time.April, 13, 8, 25, 0, 0, loc)
+	if j.Departure != expected || j.Sections[0].Departure != expected {
+		t.Errorf("expected departures at %s, got %s and %s", expected, j.Departure, j.Sections[0].Departure)
+	}
+	if st := j.Sections[0].StopTimes[0]; st.DepartureTime != timeOfDay || st.UTCDepartureTime != utc {
+		t.Errorf("expected the stop time to be left untouched, got %s and %s", st.DepartureTime, st.UTCDepartureTime)
+	}
+	if !j.Arrival.IsZero() {
+		t.Errorf("expected the zero time to be left untouched, got %s", j.Arrival)
+	}
+
+	// Localizing again doesn't shift the times
+	Localize(j, time.FixedZone("UTC-5", -5*60*60))
+	if j.Departure != expected {
+		t.Errorf("expected the departure to be left at %s, got %s", expected, j.Departure)
+	}
+}
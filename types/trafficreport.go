@@ -3,8 +3,10 @@ package types
 // A TrafficReport made of a network, an array of lines and an array of stop_areas.
 // Named "traffic_report" in the Navitia doc
 //
+// Each of them links to the disruptions affecting it, which are sent once at the root of the response:
+// call ResolveDisruptions to attach them.
+//
 // See http://doc.navitia.io/#traffic-reports
-// TODO: Add the internal links
 type TrafficReport struct {
 	// Main object (network) and links within its own disruptions
 	Network Network `json:"network"`
@@ -15,3 +17,17 @@ type TrafficReport struct {
 	// List of all disrupted StopAreas from the network
 	StopAreas []StopArea `json:"stop_areas"`
 }
+
+// ResolveDisruptions attaches to the network, lines and stop areas of the report the disruptions they reference,
+// looked up in the given disruptions.
+func (tr *TrafficReport) ResolveDisruptions(disruptions []Disruption) {
+	index := indexDisruptions(disruptions)
+
+	tr.Network.disruptions = linkedDisruptions(tr.Network.Links, index)
+	for i := range tr.Lines {
+		tr.Lines[i].disruptions = linkedDisruptions(tr.Lines[i].Links, index)
+	}
+	for i := range tr.StopAreas {
+		tr.StopAreas[i].disruptions = linkedDisruptions(tr.StopAreas[i].Links, index)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"testing"
 
 	"github.com/pkg/errors"
 )
@@ -16,6 +17,7 @@ var (
 )
 
 func init() {
+	testing.Init()
 	flag.Parse()
 	// If the given path is absolute, then use it as-is
 	if filepath.IsAbs(*testDataPathFlag) {
@@ -13,3 +13,15 @@ type VehicleJourney struct {
 	Headsign        string          `json:"headsign"`
 	Trip            Trip            `json:"trip"`
 }
+
+// ResolveDisruptions replaces the disruptions of the vehicle journey, which are only references, by the full disruptions
+// looked up in the given disruptions.
+// Navitia sends the disruptions once at the root of the response, so this has to be called once the whole response is decoded.
+func (vj *VehicleJourney) ResolveDisruptions(disruptions []Disruption) {
+	index := indexDisruptions(disruptions)
+	for i, d := range vj.Disruptions {
+		if full, ok := index[d.ID]; ok {
+			vj.Disruptions[i] = full
+		}
+	}
+}
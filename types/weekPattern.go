@@ -1,5 +1,8 @@
 package types
 
+import "time"
+
+// A WeekPattern gives the days of the week on which a Calendar is active.
 type WeekPattern struct {
 	Monday    bool `json:"monday"`
 	Tuesday   bool `json:"tuesday"`
@@ -9,3 +12,25 @@ type WeekPattern struct {
 	Sunday    bool `json:"sunday"`
 	Saturday  bool `json:"saturday"`
 }
+
+// On reports whether the pattern includes the given day of the week.
+func (wp WeekPattern) On(day time.Weekday) bool {
+	switch day {
+	case time.Monday:
+		return wp.Monday
+	case time.Tuesday:
+		return wp.Tuesday
+	case time.Wednesday:
+		return wp.Wednesday
+	case time.Thursday:
+		return wp.Thursday
+	case time.Friday:
+		return wp.Friday
+	case time.Saturday:
+		return wp.Saturday
+	case time.Sunday:
+		return wp.Sunday
+	default:
+		return false
+	}
+}
@@ -0,0 +1,199 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/govitia/navitia/types"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// AddParams adds the fields of a request struct tagged with `param:"name[,options]"` to the request, such as `param:"max_duration,seconds"`,
+// so that the parameters of a request are declared along its fields.
+// Untagged fields are left out, except for embedded structs whose fields are added as if they were the parent's.
+//
+// Zero values are left out, and each element of a slice is added under the same name, such as forbidden_uris[].
+// Pointers are for parameters whose zero value is meaningful, such as a depth of 0: they are left out if nil,
+// and otherwise added as the value they point to, even if zero.
+// Values are given as:
+//   - time.Time: a date time (YYYYMMDDThhmmss) on its wall clock, see InLocation, or a date (YYYYMMDD) with the "date" option
+//   - time.Duration: a number of seconds, which must be asked for with the "seconds" option
+//   - bool: "true", or with the "negate" option the inverted value, added even if false, such as for disable_geojson
+//   - types implementing fmt.Stringer, such as types.Coordinates: their String method
+//   - strings, integers & floats (with 3 decimals): as is
+//
+// Struct fields with the "inline" option are added as embedded ones are.
+// It returns an error if a tagged field can't be added, such as a duration without the "seconds" option.
+func (rb RequestBuilder) AddParams(req interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(req))
+	if v.Kind() != reflect.Struct {
+		return errors.Errorf("AddParams: expected a struct, got %s", v.Type())
+	}
+	return rb.addStruct(v)
+}
+
+// addStruct adds the tagged fields of v, see AddParams
+func (rb RequestBuilder) addStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, tagged := f.Tag.Lookup("param")
+		name, opts := parseParamTag(tag)
+
+		if (f.Anonymous && !tagged || opts.has("inline")) && f.Type.Kind() == reflect.Struct {
+			if err := rb.addStruct(v.Field(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if !tagged || name == "-" {
+			continue
+		}
+		if f.PkgPath != "" {
+			return errors.Errorf("AddParams: field %s.%s is unexported", t, f.Name)
+		}
+
+		if err := rb.addParam(name, v.Field(i), opts); err != nil {
+			return errors.Wrapf(err, "AddParams: can't add field %s.%s as %q", t, f.Name, name)
+		}
+	}
+	return nil
+}
+
+// InLocation returns a copy of req, a request struct tagged as for AddParams, with its date times in loc,
+// as the API takes them in the local time of the region, without any offset: such as 10:00 UTC given as 12:00 for Europe/Paris in summer.
+// Dates, with the "date" option, and the zero time are left as is, as is req if it isn't a struct.
+func InLocation(req interface{}, loc *time.Location) interface{} {
+	v := reflect.ValueOf(req)
+	if loc == nil || v.Kind() != reflect.Struct {
+		return req
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	inLocation(c, loc)
+	return c.Interface()
+}
+
+// inLocation sets the tagged date times of the struct v in loc, see InLocation
+func inLocation(v reflect.Value, loc *time.Location) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f, fv := t.Field(i), v.Field(i)
+		tag, tagged := f.Tag.Lookup("param")
+		_, opts := parseParamTag(tag)
+
+		switch {
+		case !fv.CanSet():
+		case f.Type == timeType:
+			if date := fv.Interface().(time.Time); tagged && !opts.has("date") && !date.IsZero() {
+				fv.Set(reflect.ValueOf(date.In(loc)))
+			}
+		case (f.Anonymous && !tagged || opts.has("inline")) && f.Type.Kind() == reflect.Struct:
+			inLocation(fv, loc)
+		}
+	}
+}
+
+// paramOptions are the comma-separated options of a param tag, such as "seconds" or "date,inline"
+type paramOptions string
+
+// has reports whether the option is set
+func (opts paramOptions) has(opt string) bool {
+	for s := string(opts); s != ""; {
+		o := s
+		if i := strings.IndexByte(s, ','); i >= 0 {
+			o, s = s[:i], s[i+1:]
+		} else {
+			s = ""
+		}
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// parseParamTag splits a param tag into the name of the parameter and its options.
+// It doesn't allocate, as it is done for each field of each request.
+func parseParamTag(tag string) (name string, opts paramOptions) {
+	if i := strings.IndexByte(tag, ','); i >= 0 {
+		return tag[:i], paramOptions(tag[i+1:])
+	}
+	return tag, ""
+}
+
+// addParam adds a single value under the given name, see AddParams
+func (rb RequestBuilder) addParam(name string, v reflect.Value, opts paramOptions) error {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		return rb.addValue(name, v.Elem(), opts, true)
+	}
+	return rb.addValue(name, v, opts, false)
+}
+
+// addValue adds a value under the given name, leaving it out if it is zero, unless set
+func (rb RequestBuilder) addValue(name string, v reflect.Value, opts paramOptions, set bool) error {
+	switch t := v.Type(); {
+	case t == durationType:
+		if !opts.has("seconds") {
+			return errors.New("durations must be given in seconds, with the \"seconds\" option")
+		}
+		if seconds := int64(time.Duration(v.Int()) / time.Second); set || seconds != 0 {
+			rb.params.Add(name, strconv.FormatInt(seconds, 10))
+		}
+	case t == timeType:
+		date := v.Interface().(time.Time)
+		if opts.has("date") && !date.IsZero() {
+			rb.params.Add(name, date.Format(types.DateFormat))
+		} else {
+			rb.AddDateTime(name, date)
+		}
+	case t.Kind() == reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := rb.addParam(name, v.Index(i), opts); err != nil {
+				return err
+			}
+		}
+	case t.Kind() == reflect.Bool:
+		if opts.has("negate") {
+			rb.params.Add(name, strconv.FormatBool(!v.Bool()))
+		} else if set || v.Bool() {
+			rb.params.Add(name, strconv.FormatBool(v.Bool()))
+		}
+	case t.Implements(stringerType):
+		if set || !v.IsZero() {
+			rb.params.Add(name, v.Interface().(fmt.Stringer).String())
+		}
+	case t.Kind() == reflect.String:
+		if set || v.String() != "" {
+			rb.params.Add(name, v.String())
+		}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		if set || v.Int() != 0 {
+			rb.params.Add(name, strconv.FormatInt(v.Int(), 10))
+		}
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uint64:
+		if set || v.Uint() != 0 {
+			rb.params.Add(name, strconv.FormatUint(v.Uint(), 10))
+		}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		if set || v.Float() != 0 {
+			rb.params.Add(name, strconv.FormatFloat(v.Float(), 'f', 3, 64))
+		}
+	default:
+		return errors.Errorf("unsupported type %s", t)
+	}
+	return nil
+}
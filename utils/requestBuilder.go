@@ -18,19 +18,25 @@ func NewRequestBuilder() RequestBuilder {
 	return RequestBuilder{params: &url.Values{}}
 }
 
-// AddUInt add an unigned integer to the request.
+// AddUInt add an unigned integer to the request. Add nothing if the given amount is 0.
 func (rb RequestBuilder) AddUInt(key string, amount uint) {
-	rb.params.Add(key, strconv.FormatUint(uint64(amount), 10))
+	if amount != 0 {
+		rb.params.Add(key, strconv.FormatUint(uint64(amount), 10))
+	}
 }
 
-// AddUInt add a signed integer to the request.
+// AddInt add a signed integer to the request. Add nothing if the given amount is 0.
 func (rb RequestBuilder) AddInt(key string, amount int) {
-	rb.params.Add(key, strconv.FormatInt(int64(amount), 10))
+	if amount != 0 {
+		rb.params.Add(key, strconv.FormatInt(int64(amount), 10))
+	}
 }
 
-// AddUInt add a floating point number to the request.
+// AddFloat64 add a floating point number to the request. Add nothing if the given amount is 0.
 func (rb RequestBuilder) AddFloat64(key string, amount float64) {
-	rb.params.Add(key, strconv.FormatFloat(amount, 'f', 3, 64))
+	if amount != 0 {
+		rb.params.Add(key, strconv.FormatFloat(amount, 'f', 3, 64))
+	}
 }
 
 // AddString add a string to the request.
@@ -8,70 +8,75 @@ import (
 	"github.com/govitia/navitia/utils"
 )
 
-// JourneyResults contains the results of a Journey request
+// VehicleJourneyResults contains the results of a VehicleJourneys request
 //
-// Warning: types.Journey.From / types.Journey.To aren't guaranteed to be filled.
-// Based on very basic inspection, it seems they aren't filled when there are sections...
+// The disruptions of each vehicle journey are resolved from Disruptions once the results are received.
 type VehicleJourneyResults struct {
 	VehicleJourneys []types.VehicleJourney `json:"vehicle_journeys"`
 
 	Disruptions []types.Disruption `json:"disruptions"`
 
-	Paging Paging `json:"links"`
+	Paging     Paging     `json:"links"`
+	Pagination Pagination `json:"pagination"`
 
 	Logging `json:"-"`
 
 	session *Session
 }
 
-// Count returns the number of results available in a JourneyResults
+// Count returns the number of results available in a VehicleJourneyResults
 func (jr *VehicleJourneyResults) Count() int {
 	return len(jr.VehicleJourneys)
 }
 
+// TotalAvailable returns the total number of vehicle journeys available across all pages.
+func (jr *VehicleJourneyResults) TotalAvailable() int {
+	return jr.Pagination.total(len(jr.VehicleJourneys))
+}
+
 // VehicleJourneyRequest contain the parameters needed to make a Journey request
 type VehicleJourneyRequest struct {
 	ID types.ID
 	// There must be at least one From or To parameter defined
 	// When used with just one of them, the resulting Journey won't have a populated Sections field.
-	From types.ID
-	To   types.ID
+	From types.ID `param:"from"`
+	To   types.ID `param:"to"`
 
 	// When do you want to depart ? Or is DateIsArrival when do you want to arrive at your destination.
-	Date          time.Time
+	Date          time.Time `param:"datetime"`
 	DateIsArrival bool
 
 	// The traveller's type
-	Traveler types.TravelerType
+	Traveler types.TravelerType `param:"traveler_type"`
 
 	// Define the freshness of data to use to compute journeys
-	Freshness types.DataFreshness
+	Freshness types.DataFreshness `param:"data_freshness"`
 
 	// Forbidden public transport objects
-	Forbidden []types.ID
+	Forbidden []types.ID `param:"forbidden_uris[]"`
 
 	// Allowed public transport objects
 	// Note: This counstraint intersects with Forbidden
-	Allowed []types.ID
+	Allowed []types.ID `param:"allowed_id[]"`
 
 	// Force the first section mode if it isn't a public transport mode
 	// Note: The parameter is inclusive, not exclusive. As such if you want to forbid a mode
 	// you have to include all modes except that one.
-	FirstSectionModes []string
+	FirstSectionModes []string `param:"first_section_mode[]"`
 
 	// Same, but for the last section
-	LastSectionModes []string
+	LastSectionModes []string `param:"last_section_mode[]"`
 
 	// MaxDurationToPT is the maximum allowed duration to reach the public transport.
 	// Use this to limit the walking/biking part.
-	MaxDurationToPT time.Duration
+	MaxDurationToPT time.Duration `param:"max_duration_to_pt,seconds"`
 
 	// These four following parameters set the speed of each mode (Walking, Bike, BSS & car)
 	// In meters per second
-	WalkingSpeed   float64
-	BikeSpeed      float64
-	BikeShareSpeed float64
-	CarSpeed       float64
+	WalkingSpeed   float64 `param:"walking_speed"`
+	BikeSpeed      float64 `param:"bike_speed"`
+	BikeShareSpeed float64 `param:"bss_speed"`
+	CarSpeed       float64 `param:"car_speed"`
 
 	// Minimum and maximum amounts of journeys suggested
 	MinJourneys uint
@@ -81,56 +86,53 @@ type VehicleJourneyRequest struct {
 	// Note: if Count=0 then it isn't taken into account
 	Count uint
 
-	// Maximum number of transfers in each journey
-	MaxTransfers uint
+	// Depth of the embedded objects, if set (default 1)
+	Depth *uint `param:"depth"`
+
+	// Maximum number of transfers in each journey, if set: 0 only gives direct public transport journeys
+	MaxTransfers *uint `param:"max_nb_transfers"`
 
 	// Maximum duration of a trip
-	MaxDuration time.Duration // To seconds
+	MaxDuration time.Duration `param:"max_duration,seconds"`
 
 	// Wheelchair restricts the answer to accessible public transports
-	Wheelchair bool
+	Wheelchair bool `param:"wheelchair"`
 
 	// Headsign If given, add a filter on the vehicle journeys that has the
 	// given value as headsign (on vehicle journey itself or at a stop time).
-	Headsign string
+	Headsign string `param:"headsign"`
 
 	// Since If given, filter on a period, optional.
-	Since time.Time
+	Since time.Time `param:"since"`
 	// Until, like Since, filter on a period, optional too.
-	Until time.Time
+	Until time.Time `param:"until"`
+}
+
+// Forbid adds the given public transport objects to Forbidden, see JourneyRequest.Forbid
+func (req *VehicleJourneyRequest) Forbid(ids ...types.ID) error {
+	return addFilters(&req.Forbidden, req.Allowed, ids)
+}
+
+// AllowOnly adds the given public transport objects to Allowed, see JourneyRequest.AllowOnly
+func (req *VehicleJourneyRequest) AllowOnly(ids ...types.ID) error {
+	return addFilters(&req.Allowed, req.Forbidden, ids)
 }
 
 // toURL formats a journey request to url
 // Should be refactored using a switch statement
 func (req VehicleJourneyRequest) toURL() (url.Values, error) {
-	rb := utils.NewRequestBuilder()
-
-	// Encode the from and to
-	rb.AddString("from", string(req.From))
-	rb.AddString("to", string(req.To))
-
-	if !req.Date.IsZero() {
-		rb.AddDateTime("datetime", req.Date)
-		if req.DateIsArrival {
-			rb.AddString("datetime_represents", "arrival")
-		}
+	if err := checkEnums(req.Traveler, req.Freshness); err != nil {
+		return nil, err
 	}
 
-	rb.AddString("traveler_type", string(req.Traveler))
-	rb.AddString("data_freshness", string(req.Freshness))
-	rb.AddIDSlice("forbidden_uris[]", req.Forbidden)
-	rb.AddIDSlice("allowed_id[]", req.Allowed)
-	rb.AddMode("first_section_mode[]", req.FirstSectionModes)
-	rb.AddMode("last_section_mode[]", req.LastSectionModes)
-
-	// max_duration_to_pt
-	rb.AddInt("max_duration_to_pt", int(req.MaxDurationToPT/time.Second))
+	rb := utils.NewRequestBuilder()
+	if err := rb.AddParams(req); err != nil {
+		return nil, err
+	}
 
-	// walking_speed, bike_speed, bss_speed & car_speed
-	rb.AddFloat64("walking_speed", req.WalkingSpeed)
-	rb.AddFloat64("bike_speed", req.BikeSpeed)
-	rb.AddFloat64("bss_speed", req.BikeShareSpeed)
-	rb.AddFloat64("car_speed", req.CarSpeed)
+	if !req.Date.IsZero() && req.DateIsArrival {
+		rb.AddString("datetime_represents", "arrival")
+	}
 
 	// If count is defined don't bother with the minimimal and maximum amount of items to return
 	if count := req.Count; count != 0 {
@@ -140,22 +142,5 @@ func (req VehicleJourneyRequest) toURL() (url.Values, error) {
 		rb.AddUInt("max_nb_journeys", req.MaxJourneys)
 	}
 
-	// max_nb_transfers
-	rb.AddUInt("max_nb_transfers", req.MaxTransfers)
-
-	// max_duration
-	rb.AddInt("max_duration", int(req.MaxDuration/time.Second))
-
-	// headsign
-	rb.AddString("headsign", req.Headsign)
-
-	// wheelchair
-	if req.Wheelchair {
-		rb.AddString("wheelchair", "true")
-	}
-
-	rb.AddDateTime("since", req.Since)
-	rb.AddDateTime("until", req.Until)
-
 	return rb.Values(), nil
 }
@@ -0,0 +1,78 @@
+package navitia
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// Test_VehicleJourneyResults_Unmarshal tests unmarshalling for VehicleJourneyResults.
+//
+// This launches both a "correct" and "incorrect" subtest, allowing us to test both cases.
+// 	If we expect no errors but we get one, the test fails
+//	If we expect an error but we don't get one, the test fails
+func Test_VehicleJourneyResults_Unmarshal(t *testing.T) {
+	testUnmarshal(t, testData["vehicle_journeys"], reflect.TypeOf(VehicleJourneyResults{}))
+}
+
+// Test_Scope_VehicleJourney checks that a single vehicle journey is retrieved with its stop times and resolved disruptions,
+// and that an unknown one gives a 404 RemoteError.
+func Test_Scope_VehicleJourney(t *testing.T) {
+	fixture := testData["vehicle_journeys"].correct["m14.json"]
+	if len(fixture) == 0 {
+		t.Skip("no data provided, skipping...")
+	}
+
+	const id = "vehicle_journey:RAT:RATRM14REGA9128-1_dst_2"
+	session := newMockSession(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coverage/fr-idf/vehicle_journeys/"+id {
+			_, _ = w.Write([]byte(`{"vehicle_journeys": [], "links": []}`))
+			return
+		}
+		_, _ = w.Write(fixture)
+	}))
+
+	t.Run("found", func(t *testing.T) {
+		vj, err := session.Scope("fr-idf").VehicleJourney(context.Background(), id)
+		if err != nil {
+			t.Fatalf("error in VehicleJourney: %v", err)
+		}
+
+		if vj.Headsign != "Olympiades" || vj.Trip.ID != "RATRM14REGA9128-1" {
+			t.Errorf("unexpected vehicle journey: %s (headsign %q, trip %q)", vj.ID, vj.Headsign, vj.Trip.ID)
+		}
+		if vj.ValidityPattern.Days != "0000001111111" {
+			t.Errorf("unexpected validity pattern: %+v", vj.ValidityPattern)
+		}
+
+		if len(vj.StopTimes) != 4 {
+			t.Fatalf("expected 4 stop times, got %d", len(vj.StopTimes))
+		}
+		first, last := vj.StopTimes[0], vj.StopTimes[3]
+		if first.Raw.DepartureTime != "082500" || last.Raw.ArrivalTime != "083000" {
+			t.Errorf("unexpected times: departs at %q, arrives at %q", first.Raw.DepartureTime, last.Raw.ArrivalTime)
+		}
+		if got := first.DepartureTime.Format("15:04:05"); got != "08:25:00" {
+			t.Errorf("unexpected parsed departure time: %s", got)
+		}
+		if first.DropOffAllowed || last.PickupAllowed {
+			t.Error("expected no drop off at the first stop and no pick up at the last one")
+		}
+
+		if len(vj.Disruptions) != 1 {
+			t.Fatalf("expected 1 disruption, got %d", len(vj.Disruptions))
+		}
+		if d := vj.Disruptions[0]; d.Severity.Effect == "" || len(d.Messages) == 0 {
+			t.Errorf("expected the disruption to be resolved, got %+v", d)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := session.Scope("fr-idf").VehicleJourney(context.Background(), "vehicle_journey:unknown")
+		remote, ok := err.(*RemoteError)
+		if !ok || remote.StatusCode != http.StatusNotFound {
+			t.Errorf("expected a 404 *RemoteError, got %v", err)
+		}
+	})
+}